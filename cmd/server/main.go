@@ -1,9 +1,14 @@
 package main
 
 import (
+	"context"
 	"log"
 	"log/slog"
+	"maps"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/ai"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/config"
@@ -36,17 +41,46 @@ func main() {
 		log.Fatal("Failed to run database migrations: ", err)
 	}
 
+	// Publication dates are calculated in the team's local time zone
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		log.Fatal("Failed to load timezone: ", err)
+	}
+	db.SetLocation(location)
+
+	assignmentSlots := make(map[database.ContentType]int)
+	for contentType, slots := range cfg.AssignmentSlots {
+		assignmentSlots[database.ContentType(contentType)] = slots
+	}
+	db.SetAssignmentSlots(assignmentSlots)
+
 	questionSelector := database.NewQuestionSelector(db.DB)
+	questionSelector.SetCooldownWeeks(cfg.QuestionCooldown)
 	submissionManager := database.NewSubmissionManager(db.DB)
+	submissionManager.SetDuplicateWindow(cfg.DuplicateWindow)
 
 	// Create AI processor (AnthropicService implements the AIProcessor interface)
 	aiProcessor := ai.NewAnthropicService(cfg.AnthropicAPIKey)
+	aiProcessor.SetModel(cfg.AIModel)
+	aiProcessor.SetTemperature(cfg.AITemperature)
+	// Journalist prompts can be tuned at runtime with admin set-journalist-prompt
+	ai.SetProfileStore(db)
+	ai.SetJournalistBylines(cfg.JournalistBylines)
 
 	// Create bot with full weekly automation capabilities
-	slackBot := slack.NewBotWithWeeklyAutomation(slack.SlackConfig{
-		Token:         cfg.SlackBotToken,
-		SigningSecret: cfg.SlackSigningSecret,
-	}, questionSelector, cfg.AdminUsers, submissionManager, aiProcessor, db)
+	slackBot := slack.NewBotWithWeeklyAutomation(slackConfig(cfg), questionSelector, cfg.AdminUsers, submissionManager, aiProcessor, db)
+
+	// SIGHUP re-reads the environment and applies admin users and the other bot settings without a restart
+	if reloader, ok := slackBot.(slack.SettingsReloader); ok {
+		hangups := make(chan os.Signal, 1)
+		signal.Notify(hangups, syscall.SIGHUP)
+		go func() {
+			current := cfg
+			for range hangups {
+				current = reloadConfig(current, reloader)
+			}
+		}()
+	}
 
 	// Create template service
 	templateService, err := templates.NewTemplateService(nil)
@@ -60,9 +94,108 @@ func main() {
 	// set up routes
 	srv.SetupRoutes()
 
+	// SIGINT/SIGTERM stop the server, letting in-flight requests and AI jobs finish first
+	stops := make(chan os.Signal, 1)
+	signal.Notify(stops, syscall.SIGINT, syscall.SIGTERM)
+	stopped := make(chan struct{})
+	go func() {
+		sig := <-stops
+		logger.Info("Shutting down newsletter service", "signal", sig.String())
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Error("Graceful shutdown incomplete", "error", err)
+		}
+		close(stopped)
+	}()
+
 	// start server
 	logger.Info("Starting newsletter service")
 	if err := srv.Start(); err != nil {
 		log.Fatal("Server failed to start: ", err)
 	}
+	<-stopped
+	logger.Info("Newsletter service stopped")
+}
+
+// shutdownTimeout bounds how long a stop signal waits for requests and AI jobs to finish.
+// Container runtimes need a stop grace period at least this long to allow it.
+const shutdownTimeout = 30 * time.Second
+
+// slackConfig picks the bot settings out of the service configuration
+func slackConfig(cfg *config.Config) slack.SlackConfig {
+	// Validate has already rejected an invalid window
+	var window *slack.SubmissionWindow
+	if opens, closes, enabled, err := cfg.SubmissionWindowDays(); err == nil && enabled {
+		window = &slack.SubmissionWindow{Opens: opens, Closes: closes}
+	}
+
+	return slack.SlackConfig{
+		Token:                cfg.SlackBotToken,
+		SigningSecret:        cfg.SlackSigningSecret,
+		EditorUsers:          cfg.EditorUsers,
+		AITimeout:            cfg.AITimeout,
+		BannedWords:          cfg.BannedWords,
+		SubmissionCategories: cfg.SubmissionCategories,
+		DefaultCategory:      cfg.DefaultCategory,
+		DefaultJournalist:    cfg.DefaultJournalist,
+		MinSubmissionLength:  cfg.MinSubmissionLength,
+		MaxSubmissionLength:  cfg.MaxSubmissionLength,
+		DMReplyGracePeriod:   cfg.DMReplyGracePeriod,
+		LowPoolThreshold:     cfg.LowPoolThreshold,
+		SubmissionWindow:     window,
+		SlackMaxRetries:      cfg.SlackMaxRetries,
+		DebugErrors:          cfg.DebugErrors,
+	}
+}
+
+// reloadConfig loads the configuration again and hands the runtime settings to the bot.
+// Settings wired up at startup, such as the port and database path, keep their running
+// values with a warning. An invalid configuration is rejected and current is kept.
+func reloadConfig(current *config.Config, bot slack.SettingsReloader) *config.Config {
+	next := config.Load()
+	if err := next.Validate(); err != nil {
+		slog.Error("Ignoring config reload", "error", err)
+		return current
+	}
+
+	for _, setting := range []struct {
+		name           string
+		running, value *string
+	}{
+		{"PORT", &current.Port, &next.Port},
+		{"DATABASE_PATH", &current.DatabasePath, &next.DatabasePath},
+		{"TIMEZONE", &current.Timezone, &next.Timezone},
+		{"SLACK_BOT_TOKEN", &current.SlackBotToken, &next.SlackBotToken},
+		{"SLACK_SIGNING_SECRET", &current.SlackSigningSecret, &next.SlackSigningSecret},
+		{"ANTHROPIC_API_KEY", &current.AnthropicAPIKey, &next.AnthropicAPIKey},
+		{"AI_MODEL", &current.AIModel, &next.AIModel},
+	} {
+		if *setting.value != *setting.running {
+			slog.Warn("Setting changed but only applies after a restart", "setting", setting.name)
+			*setting.value = *setting.running
+		}
+	}
+
+	// Handed to the database, question selector and AI service at startup
+	for _, setting := range []struct {
+		name    string
+		changed bool
+		keep    func()
+	}{
+		{"LOW_POOL_BROADCAST_THRESHOLD", next.LowPoolThreshold != current.LowPoolThreshold, func() { next.LowPoolThreshold = current.LowPoolThreshold }},
+		{"ASSIGNMENT_SLOTS", !maps.Equal(next.AssignmentSlots, current.AssignmentSlots), func() { next.AssignmentSlots = current.AssignmentSlots }},
+		{"QUESTION_COOLDOWN_WEEKS", next.QuestionCooldown != current.QuestionCooldown, func() { next.QuestionCooldown = current.QuestionCooldown }},
+		{"SUBMISSION_DUPLICATE_WINDOW", next.DuplicateWindow != current.DuplicateWindow, func() { next.DuplicateWindow = current.DuplicateWindow }},
+		{"AI_TEMPERATURE", next.AITemperature != current.AITemperature, func() { next.AITemperature = current.AITemperature }},
+	} {
+		if setting.changed {
+			slog.Warn("Setting changed but only applies after a restart", "setting", setting.name)
+			setting.keep()
+		}
+	}
+
+	bot.ReloadSettings(slackConfig(next), next.AdminUsers)
+	ai.SetJournalistBylines(next.JournalistBylines)
+	return next
 }
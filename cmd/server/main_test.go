@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/config"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/slack"
+)
+
+// TDD: Reloading the config swaps admin users on the running bot but keeps startup-only settings
+func TestReloadConfig(t *testing.T) {
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb-test")
+	t.Setenv("ANTHROPIC_API_KEY", "test-key")
+	t.Setenv("ADMIN_USERS", "U111OLDADMIN")
+	t.Setenv("PORT", "8080")
+	cfg := config.Load()
+
+	db, err := database.NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	bot := slack.NewBotWithWeeklyAutomation(slackConfig(cfg), nil, cfg.AdminUsers, nil, nil, db)
+	isAdmin := func(userID string) bool {
+		t.Helper()
+		response, err := bot.HandleSlashCommand(context.Background(), slack.SlashCommand{Text: "admin help", UserID: userID})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		return !strings.Contains(response.Text, "not authorized")
+	}
+
+	if !isAdmin("U111OLDADMIN") || isAdmin("U222NEWADMIN") {
+		t.Fatal("Expected only the configured admin before reloading")
+	}
+
+	t.Setenv("ADMIN_USERS", "U222NEWADMIN")
+	t.Setenv("PORT", "9090")
+	t.Setenv("ASSIGNMENT_SLOTS", "feature=2")
+	t.Setenv("AI_TEMPERATURE", "0.2")
+	t.Setenv("LOW_POOL_BROADCAST_THRESHOLD", "5")
+	reloaded := reloadConfig(cfg, bot.(slack.SettingsReloader))
+
+	if !isAdmin("U222NEWADMIN") || isAdmin("U111OLDADMIN") {
+		t.Error("Expected the reloaded admin list to take effect")
+	}
+	if reloaded.Port != "8080" {
+		t.Errorf("Expected the running port to be kept, got %s", reloaded.Port)
+	}
+	// Settings wired into the database and AI service at startup keep their running values too
+	if reloaded.AssignmentSlots["feature"] != cfg.AssignmentSlots["feature"] || reloaded.AITemperature != cfg.AITemperature || reloaded.LowPoolThreshold != cfg.LowPoolThreshold {
+		t.Errorf("Expected startup-only settings to be kept, got slots %v, temperature %g, threshold %d",
+			reloaded.AssignmentSlots, reloaded.AITemperature, reloaded.LowPoolThreshold)
+	}
+
+	// An invalid configuration leaves everything as it was
+	t.Setenv("SLACK_BOT_TOKEN", "")
+	t.Setenv("ADMIN_USERS", "U333IGNORED")
+	if kept := reloadConfig(reloaded, bot.(slack.SettingsReloader)); kept != reloaded {
+		t.Error("Expected an invalid reload to keep the current config")
+	}
+	if isAdmin("U333IGNORED") || !isAdmin("U222NEWADMIN") {
+		t.Error("Expected an invalid reload not to change admins")
+	}
+}
+
+// TDD: An unknown default journalist type is rejected when the config is loaded
+func TestValidateDefaultJournalist(t *testing.T) {
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb-test")
+	t.Setenv("ANTHROPIC_API_KEY", "test-key")
+
+	if cfg := config.Load(); cfg.DefaultJournalist != "general" || cfg.Validate() != nil {
+		t.Errorf("Expected the general journalist by default, got %q", cfg.DefaultJournalist)
+	}
+
+	t.Setenv("DEFAULT_JOURNALIST_TYPE", "feature")
+	if cfg := config.Load(); cfg.DefaultJournalist != "feature" || cfg.Validate() != nil {
+		t.Errorf("Expected the feature journalist to be accepted, got %q", cfg.DefaultJournalist)
+	}
+
+	t.Setenv("DEFAULT_JOURNALIST_TYPE", "poetry")
+	if err := config.Load().Validate(); err == nil || !strings.Contains(err.Error(), "DEFAULT_JOURNALIST_TYPE") {
+		t.Errorf("Expected an unknown journalist type to be rejected, got %v", err)
+	}
+}
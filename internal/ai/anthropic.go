@@ -2,55 +2,75 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
 )
 
 // AnthropicService implements the AIService interface using Anthropic's Claude API
 type AnthropicService struct {
-	client     anthropic.Client
-	apiKey     string
-	maxRetries int
-	timeout    time.Duration
-	model      anthropic.Model
+	client      anthropic.Client
+	apiKey      string
+	maxRetries  int
+	retryDelay  time.Duration
+	timeout     time.Duration
+	model       anthropic.Model
+	temperature float64
 }
 
 // NewAnthropicService creates a new Anthropic AI service
 func NewAnthropicService(apiKey string) *AnthropicService {
-	client := anthropic.NewClient()
+	// Retries are handled by callAnthropicAPI so only rate limits and timeouts are retried
+	client := anthropic.NewClient(option.WithMaxRetries(0))
 
 	return &AnthropicService{
-		client:     client,
-		apiKey:     apiKey,
-		maxRetries: 3,
-		timeout:    30 * time.Second,
-		model:      anthropic.ModelClaude3_7SonnetLatest,
+		client:      client,
+		apiKey:      apiKey,
+		maxRetries:  3,
+		retryDelay:  time.Second,
+		timeout:     30 * time.Second,
+		model:       anthropic.ModelClaude3_7SonnetLatest,
+		temperature: 1.0,
 	}
 }
 
+// SetModel changes the model used for journalists without their own model; empty keeps the current one
+func (a *AnthropicService) SetModel(model string) {
+	if model != "" {
+		a.model = anthropic.Model(model)
+	}
+}
+
+// SetTemperature changes the sampling temperature used for journalists without their own temperature
+func (a *AnthropicService) SetTemperature(temperature float64) {
+	a.temperature = temperature
+}
+
 // ProcessSubmission transforms a submission into a processed article using Claude
 func (a *AnthropicService) ProcessSubmission(ctx context.Context, submission database.Submission, journalistType string) (*database.ProcessedArticle, error) {
 	// Validate journalist type
 	if !a.ValidateJournalistType(journalistType) {
-		return nil, NewProcessingError("invalid_journalist_type",
-			fmt.Sprintf("invalid journalist type: %s", journalistType), false, nil)
+		return nil, NewAIError(AIErrorUnknown,
+			fmt.Sprintf("invalid journalist type: %s", journalistType), nil)
 	}
 
 	// Get journalist profile
 	profile, err := GetJournalistProfile(journalistType)
 	if err != nil {
-		return nil, NewProcessingError("profile_error", "failed to get journalist profile", false, err)
+		return nil, NewAIError(AIErrorUnknown, "failed to get journalist profile", err)
 	}
 
 	// Build the prompt
 	prompt, err := BuildPrompt(submission.Content, journalistType)
 	if err != nil {
-		return nil, NewProcessingError("prompt_error", "failed to build prompt", false, err)
+		return nil, NewAIError(AIErrorUnknown, "failed to build prompt", err)
 	}
 
 	// Create context with timeout
@@ -58,9 +78,9 @@ func (a *AnthropicService) ProcessSubmission(ctx context.Context, submission dat
 	defer cancel()
 
 	// Call Anthropic API
-	response, err := a.callAnthropicAPI(ctx, prompt)
+	response, err := a.callAnthropicAPI(ctx, prompt, profile)
 	if err != nil {
-		return nil, err // Already wrapped as ProcessingError
+		return nil, err // Already wrapped as AIError
 	}
 
 	// Process the response
@@ -69,14 +89,14 @@ func (a *AnthropicService) ProcessSubmission(ctx context.Context, submission dat
 
 	// Validate response length
 	if wordCount > profile.MaxWords+50 { // Allow 50 word buffer
-		return nil, NewProcessingError("content_too_long",
+		return nil, NewAIError(AIErrorInvalidResponse,
 			fmt.Sprintf("generated content exceeds maximum words: %d > %d", wordCount, profile.MaxWords),
-			true, nil)
+			nil)
 	}
 
 	if len(processedContent) < 10 {
-		return nil, NewProcessingError("content_too_short",
-			"generated content is too short", true, nil)
+		return nil, NewAIError(AIErrorInvalidResponse,
+			"generated content is too short", nil)
 	}
 
 	// Create processed article
@@ -91,8 +111,12 @@ func (a *AnthropicService) ProcessSubmission(ctx context.Context, submission dat
 		WordCount:        wordCount,
 		ProcessedAt:      &now,
 		RetryCount:       0,
+		PromptTokens:     response.PromptTokens,
+		CompletionTokens: response.CompletionTokens,
 	}
 
+	logTokenUsage(submission.ID, journalistType, response)
+
 	return article, nil
 }
 
@@ -100,20 +124,20 @@ func (a *AnthropicService) ProcessSubmission(ctx context.Context, submission dat
 func (a *AnthropicService) ProcessSubmissionWithUserInfo(ctx context.Context, submission database.Submission, authorName, authorDepartment, journalistType string) (*database.ProcessedArticle, error) {
 	// Validate journalist type
 	if !a.ValidateJournalistType(journalistType) {
-		return nil, NewProcessingError("invalid_journalist_type",
-			fmt.Sprintf("invalid journalist type: %s", journalistType), false, nil)
+		return nil, NewAIError(AIErrorUnknown,
+			fmt.Sprintf("invalid journalist type: %s", journalistType), nil)
 	}
 
 	// Get journalist profile
 	profile, err := GetJournalistProfile(journalistType)
 	if err != nil {
-		return nil, NewProcessingError("profile_error", "failed to get journalist profile", false, err)
+		return nil, NewAIError(AIErrorUnknown, "failed to get journalist profile", err)
 	}
 
 	// Build the JSON prompt with user information
 	prompt, err := BuildJSONPrompt(submission.Content, authorName, authorDepartment, journalistType)
 	if err != nil {
-		return nil, NewProcessingError("prompt_error", "failed to build JSON prompt", false, err)
+		return nil, NewAIError(AIErrorUnknown, "failed to build JSON prompt", err)
 	}
 
 	// Create context with timeout
@@ -121,37 +145,80 @@ func (a *AnthropicService) ProcessSubmissionWithUserInfo(ctx context.Context, su
 	defer cancel()
 
 	// Call Anthropic API
-	response, err := a.callAnthropicAPI(ctx, prompt)
+	response, err := a.callAnthropicAPI(ctx, prompt, profile)
 	if err != nil {
-		return nil, err // Already wrapped as ProcessingError
+		return nil, err // Already wrapped as AIError
 	}
 
-	// Process the JSON response
-	processedContent := strings.TrimSpace(response.ProcessedContent)
+	article, err := articleFromResponse(submission.ID, journalistType, prompt, profile, response)
+	if err != nil {
+		return nil, err
+	}
+
+	logTokenUsage(submission.ID, journalistType, response)
+
+	return article, nil
+}
+
+// RefineArticle asks the article's journalist to revise it following an editor's feedback
+func (a *AnthropicService) RefineArticle(ctx context.Context, previous *database.ProcessedArticle, feedback string) (*database.ProcessedArticle, error) {
+	profile, err := GetJournalistProfile(previous.JournalistType)
+	if err != nil {
+		return nil, NewAIError(AIErrorUnknown, "failed to get journalist profile", err)
+	}
+
+	prompt, err := BuildRefinementPrompt(previous.ProcessedContent, feedback, previous.JournalistType)
+	if err != nil {
+		return nil, NewAIError(AIErrorUnknown, "failed to build refinement prompt", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	response, err := a.callAnthropicAPI(ctx, prompt, profile)
+	if err != nil {
+		return nil, err // Already wrapped as AIError
+	}
+
+	article, err := articleFromResponse(previous.SubmissionID, previous.JournalistType, prompt, profile, response)
+	if err != nil {
+		return nil, err
+	}
+	article.NewsletterIssueID = previous.NewsletterIssueID
+
+	logTokenUsage(previous.SubmissionID, previous.JournalistType, response)
+
+	return article, nil
+}
+
+// articleFromResponse validates the AI's JSON output and turns it into an unsaved processed article
+func articleFromResponse(submissionID int, journalistType, prompt string, profile *JournalistProfile, response *ProcessingResult) (*database.ProcessedArticle, error) {
+	// Process the JSON response, repairing code fences and stray prose before it is stored,
+	// and sign it with the journalist's byline rather than whatever name the AI came up with
+	processedContent := NormalizeByline(CleanJSONResponse(response.ProcessedContent), profile)
 
 	// Parse and validate the JSON response
 	parsedResponse, err := ParseJSONResponse(processedContent, journalistType)
 	if err != nil {
-		return nil, NewProcessingError("invalid_json_response",
-			"AI response is not valid JSON", true, err)
+		return nil, err // Already wrapped as AIError
 	}
 
 	// Validate response length
 	if parsedResponse.WordCount > profile.MaxWords+50 { // Allow 50 word buffer
-		return nil, NewProcessingError("content_too_long",
+		return nil, NewAIError(AIErrorInvalidResponse,
 			fmt.Sprintf("generated content exceeds maximum words: %d > %d", parsedResponse.WordCount, profile.MaxWords),
-			true, nil)
+			nil)
 	}
 
 	if parsedResponse.WordCount < 5 {
-		return nil, NewProcessingError("content_too_short",
-			"generated content is too short", true, nil)
+		return nil, NewAIError(AIErrorInvalidResponse,
+			"generated content is too short", nil)
 	}
 
 	// Create processed article with JSON content
 	now := time.Now()
-	article := &database.ProcessedArticle{
-		SubmissionID:     submission.ID,
+	return &database.ProcessedArticle{
+		SubmissionID:     submissionID,
 		JournalistType:   journalistType,
 		ProcessedContent: processedContent, // Store the raw JSON string
 		ProcessingPrompt: prompt,
@@ -160,9 +227,9 @@ func (a *AnthropicService) ProcessSubmissionWithUserInfo(ctx context.Context, su
 		WordCount:        parsedResponse.WordCount,
 		ProcessedAt:      &now,
 		RetryCount:       0,
-	}
-
-	return article, nil
+		PromptTokens:     response.PromptTokens,
+		CompletionTokens: response.CompletionTokens,
+	}, nil
 }
 
 // ProcessAndSaveSubmission processes a submission with AI and saves the result atomically to database
@@ -196,22 +263,75 @@ func (a *AnthropicService) ProcessAndSaveSubmission(
 		"processed_article_id", articleID,
 		"newsletter_issue_id", newsletterIssueID,
 		"journalist_type", journalistType,
-		"word_count", processedArticle.WordCount)
+		"word_count", processedArticle.WordCount,
+		"prompt_tokens", processedArticle.PromptTokens,
+		"completion_tokens", processedArticle.CompletionTokens)
 
 	return nil
 }
 
-// callAnthropicAPI makes the actual API call with proper error handling
-func (a *AnthropicService) callAnthropicAPI(ctx context.Context, prompt string) (*ProcessingResult, error) {
-	response, err := a.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     a.model,
-		MaxTokens: 1200, // Reasonable limit for newsletter articles
-		Messages: []anthropic.MessageParam{
-			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
-		},
+// GenerateIssueIntro asks Claude for a short Swedish introduction to an issue based on its headlines and theme
+func (a *AnthropicService) GenerateIssueIntro(ctx context.Context, headlines []string, theme string) (string, error) {
+	prompt, err := BuildIssueIntroPrompt(headlines, theme)
+	if err != nil {
+		return "", NewAIError(AIErrorUnknown, "failed to build issue intro prompt", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	response, err := a.callAnthropicAPI(ctx, prompt, nil)
+	if err != nil {
+		return "", err // Already wrapped as AIError
+	}
+
+	intro := strings.Trim(strings.TrimSpace(response.ProcessedContent), "\"")
+	if len(intro) < 10 {
+		return "", NewAIError(AIErrorInvalidResponse, "generated intro is too short", nil)
+	}
+
+	slog.Info("AI token usage",
+		"purpose", "issue_intro",
+		"headlines", len(headlines),
+		"model", response.Model,
+		"prompt_tokens", response.PromptTokens,
+		"completion_tokens", response.CompletionTokens,
+		"estimated_cost_usd", EstimateCostUSD(response.PromptTokens, response.CompletionTokens))
+
+	return intro, nil
+}
+
+// callAnthropicAPI makes the actual API call with proper error handling.
+// The profile's model and temperature override the service defaults; profile may be nil.
+func (a *AnthropicService) callAnthropicAPI(ctx context.Context, prompt string, profile *JournalistProfile) (*ProcessingResult, error) {
+	model, temperature := a.model, a.temperature
+	if profile != nil {
+		if profile.Model != "" {
+			model = anthropic.Model(profile.Model)
+		}
+		if profile.Temperature != nil {
+			temperature = *profile.Temperature
+		}
+	}
+
+	var response *anthropic.Message
+	err := retryAIRequest(ctx, a.maxRetries, a.retryDelay, func() error {
+		var err error
+		response, err = a.client.Messages.New(ctx, anthropic.MessageNewParams{
+			Model:       model,
+			MaxTokens:   1200, // Reasonable limit for newsletter articles
+			Temperature: anthropic.Float(temperature),
+			Messages: []anthropic.MessageParam{
+				anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
+			},
+		})
+		if err != nil {
+			return classifyAnthropicError(err)
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, a.handleAnthropicError(err)
+		return nil, err
 	}
 
 	// Extract content from response
@@ -225,7 +345,7 @@ func (a *AnthropicService) callAnthropicAPI(ctx context.Context, prompt string)
 	}
 
 	if content == "" {
-		return nil, NewProcessingError("empty_response", "received empty response from API", true, nil)
+		return nil, NewAIError(AIErrorInvalidResponse, "received empty response from API", nil)
 	}
 
 	return &ProcessingResult{
@@ -233,28 +353,83 @@ func (a *AnthropicService) callAnthropicAPI(ctx context.Context, prompt string)
 		WordCount:        countWords(content),
 		ProcessingTime:   0, // Will be calculated by caller
 		TokensUsed:       int(response.Usage.OutputTokens + response.Usage.InputTokens),
-		Model:            string(a.model),
+		PromptTokens:     int(response.Usage.InputTokens),
+		CompletionTokens: int(response.Usage.OutputTokens),
+		Model:            string(model),
 	}, nil
 }
 
-// handleAnthropicError converts Anthropic API errors to ProcessingError
-func (a *AnthropicService) handleAnthropicError(err error) *ProcessingError {
-	// Check for specific error types
-	if strings.Contains(err.Error(), "rate_limit") {
-		return NewProcessingError("rate_limit", "API rate limit exceeded", true, err)
+// logTokenUsage records the token consumption and estimated cost of a processed submission
+func logTokenUsage(submissionID int, journalistType string, result *ProcessingResult) {
+	slog.Info("AI token usage",
+		"submission_id", submissionID,
+		"journalist_type", journalistType,
+		"model", result.Model,
+		"prompt_tokens", result.PromptTokens,
+		"completion_tokens", result.CompletionTokens,
+		"estimated_cost_usd", EstimateCostUSD(result.PromptTokens, result.CompletionTokens))
+}
+
+// retryAIRequest runs fn, retrying with exponential backoff only while it fails
+// with a rate limit or timeout. A returned AIError records how many attempts were made.
+func retryAIRequest(ctx context.Context, maxRetries int, delay time.Duration, fn func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn()
+		if err == nil {
+			return nil
+		}
+
+		var aiErr *AIError
+		if errors.As(err, &aiErr) {
+			aiErr.Attempts = attempt + 1
+		}
+		if !IsRetryable(err) || attempt >= maxRetries {
+			return err
+		}
+
+		slog.Warn("Retrying AI request",
+			"attempt", attempt+1,
+			"max_retries", maxRetries,
+			"kind", ErrorKindOf(err),
+			"error", err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay << attempt):
+		}
 	}
-	if strings.Contains(err.Error(), "timeout") {
-		return NewProcessingError("timeout", "API request timed out", true, err)
+}
+
+// classifyAnthropicError converts Anthropic API errors to AIError
+func classifyAnthropicError(err error) *AIError {
+	var apiErr *anthropic.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, 529: // 529 is Anthropic's "overloaded"
+			return NewAIError(AIErrorRateLimited, "API rate limit exceeded", err)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return NewAIError(AIErrorAuth, "Authentication failed", err)
+		case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+			return NewAIError(AIErrorTimeout, "API request timed out", err)
+		}
 	}
-	if strings.Contains(err.Error(), "content_filter") {
-		return NewProcessingError("content_filter", "Content filtered by API", false, err)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewAIError(AIErrorTimeout, "API request timed out", err)
 	}
-	if strings.Contains(err.Error(), "authentication") {
-		return NewProcessingError("api_error", "Authentication failed", false, err)
+
+	// Fall back to the error text for errors that don't carry a status code
+	switch {
+	case strings.Contains(err.Error(), "rate_limit"):
+		return NewAIError(AIErrorRateLimited, "API rate limit exceeded", err)
+	case strings.Contains(err.Error(), "timeout"):
+		return NewAIError(AIErrorTimeout, "API request timed out", err)
+	case strings.Contains(err.Error(), "authentication"):
+		return NewAIError(AIErrorAuth, "Authentication failed", err)
 	}
 
-	// Generic API error
-	return NewProcessingError("api_error", "API request failed", true, err)
+	return NewAIError(AIErrorUnknown, "API request failed", err)
 }
 
 // GetAvailableJournalists returns available journalist types
@@ -0,0 +1,331 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+// newTestAnthropicService points an AnthropicService at a fake API that always
+// answers with the given status and body, and counts the requests it receives
+func newTestAnthropicService(t *testing.T, status int, body string, maxRetries int) (*AnthropicService, *int32) {
+	t.Helper()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	service := &AnthropicService{
+		client: anthropic.NewClient(
+			option.WithAPIKey("test-key"),
+			option.WithBaseURL(server.URL),
+			option.WithMaxRetries(0),
+		),
+		maxRetries: maxRetries,
+		retryDelay: time.Millisecond,
+		timeout:    5 * time.Second,
+		model:      anthropic.ModelClaude3_7SonnetLatest,
+	}
+	return service, &calls
+}
+
+// TDD: Test representative Anthropic error responses map to the right AIErrorKind
+func TestAnthropicErrorKinds(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		kind   AIErrorKind
+	}{
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			body:   `{"type":"error","error":{"type":"rate_limit_error","message":"Number of requests has exceeded your rate limit"}}`,
+			kind:   AIErrorRateLimited,
+		},
+		{
+			name:   "overloaded",
+			status: 529,
+			body:   `{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+			kind:   AIErrorRateLimited,
+		},
+		{
+			name:   "invalid api key",
+			status: http.StatusUnauthorized,
+			body:   `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`,
+			kind:   AIErrorAuth,
+		},
+		{
+			name:   "permission denied",
+			status: http.StatusForbidden,
+			body:   `{"type":"error","error":{"type":"permission_error","message":"Your API key does not have permission to use the specified resource."}}`,
+			kind:   AIErrorAuth,
+		},
+		{
+			name:   "gateway timeout",
+			status: http.StatusGatewayTimeout,
+			body:   `{"type":"error","error":{"type":"timeout_error","message":"Request timed out"}}`,
+			kind:   AIErrorTimeout,
+		},
+		{
+			name:   "invalid request",
+			status: http.StatusBadRequest,
+			body:   `{"type":"error","error":{"type":"invalid_request_error","message":"max_tokens: field required"}}`,
+			kind:   AIErrorUnknown,
+		},
+		{
+			name:   "internal server error",
+			status: http.StatusInternalServerError,
+			body:   `{"type":"error","error":{"type":"api_error","message":"Internal server error"}}`,
+			kind:   AIErrorUnknown,
+		},
+		{
+			name:   "empty content",
+			status: http.StatusOK,
+			body:   `{"id":"msg_1","type":"message","role":"assistant","model":"claude-3-7-sonnet-latest","content":[],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":0}}`,
+			kind:   AIErrorInvalidResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newTestAnthropicService(t, tt.status, tt.body, 0)
+
+			_, err := service.callAnthropicAPI(context.Background(), "prompt", nil)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+
+			var aiErr *AIError
+			if !errors.As(err, &aiErr) {
+				t.Fatalf("Expected *AIError, got %T: %v", err, err)
+			}
+			if aiErr.Kind != tt.kind {
+				t.Errorf("Expected kind %s, got %s (%v)", tt.kind, aiErr.Kind, err)
+			}
+		})
+	}
+}
+
+// TDD: Test only rate limits and timeouts are retried
+func TestAnthropicRetryOnlyTransientErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		expectedCalls int32
+	}{
+		{"rate limited is retried", http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"rate limited"}}`, 3},
+		{"timeout is retried", http.StatusGatewayTimeout, `{"type":"error","error":{"type":"timeout_error","message":"timed out"}}`, 3},
+		{"auth is not retried", http.StatusUnauthorized, `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`, 1},
+		{"unknown is not retried", http.StatusInternalServerError, `{"type":"error","error":{"type":"api_error","message":"boom"}}`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, calls := newTestAnthropicService(t, tt.status, tt.body, 2)
+
+			_, err := service.callAnthropicAPI(context.Background(), "prompt", nil)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if got := atomic.LoadInt32(calls); got != tt.expectedCalls {
+				t.Errorf("Expected %d API calls, got %d", tt.expectedCalls, got)
+			}
+			if got := AttemptsOf(fmt.Errorf("AI processing failed: %w", err)); got != int(tt.expectedCalls) {
+				t.Errorf("Expected the error to record %d attempts, got %d", tt.expectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestErrorKindOf(t *testing.T) {
+	wrapped := errors.Join(errors.New("AI processing failed"), NewAIError(AIErrorAuth, "Authentication failed", nil))
+	if kind := ErrorKindOf(wrapped); kind != AIErrorAuth {
+		t.Errorf("Expected wrapped auth error, got %s", kind)
+	}
+	if kind := ErrorKindOf(context.DeadlineExceeded); kind != AIErrorTimeout {
+		t.Errorf("Expected deadline exceeded to be a timeout, got %s", kind)
+	}
+	if kind := ErrorKindOf(errors.New("something else")); kind != AIErrorUnknown {
+		t.Errorf("Expected unknown kind, got %s", kind)
+	}
+	if IsRetryable(NewAIError(AIErrorInvalidResponse, "bad JSON", nil)) {
+		t.Error("Invalid responses should not be retryable")
+	}
+	if !NewAIError(AIErrorRateLimited, "slow down", nil).Retryable() {
+		t.Error("Rate limited errors should be retryable")
+	}
+}
+
+// TDD: Test the request carries the service's model and temperature unless the journalist overrides them
+func TestAnthropicRequestModelAndTemperature(t *testing.T) {
+	var mu sync.Mutex
+	var lastRequest struct {
+		Model       string   `json:"model"`
+		Temperature *float64 `json:"temperature"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		lastRequest.Temperature = nil
+		if err := json.NewDecoder(r.Body).Decode(&lastRequest); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_1","type":"message","role":"assistant","model":"claude-3-7-sonnet-latest","content":[{"type":"text","text":"Hej"}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":2}}`))
+	}))
+	defer server.Close()
+
+	service := NewAnthropicService("test-key")
+	service.client = anthropic.NewClient(
+		option.WithAPIKey("test-key"),
+		option.WithBaseURL(server.URL),
+		option.WithMaxRetries(0),
+	)
+	service.SetModel("claude-3-5-haiku-latest")
+	service.SetTemperature(0.8)
+
+	bodyMind, err := GetJournalistProfile("body_mind")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	feature, err := GetJournalistProfile("feature")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	customModel := &JournalistProfile{Type: "custom", Model: "claude-sonnet-4-0", Temperature: temperature(0)}
+
+	tests := []struct {
+		name        string
+		profile     *JournalistProfile
+		model       string
+		temperature float64
+	}{
+		{"no profile uses service defaults", nil, "claude-3-5-haiku-latest", 0.8},
+		{"profile without overrides uses service defaults", feature, "claude-3-5-haiku-latest", 0.8},
+		{"body_mind overrides temperature", bodyMind, "claude-3-5-haiku-latest", 0.5},
+		{"profile overrides model and zero temperature", customModel, "claude-sonnet-4-0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.callAnthropicAPI(context.Background(), "prompt", tt.profile)
+			if err != nil {
+				t.Fatalf("callAnthropicAPI() failed: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if lastRequest.Model != tt.model {
+				t.Errorf("Expected model %s in request, got %s", tt.model, lastRequest.Model)
+			}
+			if lastRequest.Temperature == nil || *lastRequest.Temperature != tt.temperature {
+				t.Errorf("Expected temperature %v in request, got %v", tt.temperature, lastRequest.Temperature)
+			}
+			if result.Model != tt.model {
+				t.Errorf("Expected result model %s, got %s", tt.model, result.Model)
+			}
+		})
+	}
+}
+
+// TDD: Test an article comes back signed with the journalist's byline, not the one the AI invented
+func TestAnthropicProcessSubmissionNormalizesByline(t *testing.T) {
+	article := `{"headline": "Nya dashboarden är här", "content": "Teamet lanserade en ny dashboard i veckan och alla är glada.", "byline": "Erik Lindqvist, Reporter"}`
+	text, err := json.Marshal(article)
+	if err != nil {
+		t.Fatalf("Failed to encode article: %v", err)
+	}
+	body := fmt.Sprintf(`{"id":"msg_1","type":"message","role":"assistant","model":"claude-3-7-sonnet-latest","content":[{"type":"text","text":%s}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":20}}`, text)
+	service, _ := newTestAnthropicService(t, http.StatusOK, body, 0)
+
+	processed, err := service.ProcessSubmissionWithUserInfo(context.Background(), database.Submission{ID: 1, Content: "We launched a dashboard"}, "Anna", "Design", "general")
+	if err != nil {
+		t.Fatalf("ProcessSubmissionWithUserInfo() failed: %v", err)
+	}
+
+	content, err := processed.ParseJSONContent()
+	if err != nil {
+		t.Fatalf("Failed to parse processed content: %v", err)
+	}
+	if content["byline"] != "Koco Kai" {
+		t.Errorf("Expected byline %q, got %v", "Koco Kai", content["byline"])
+	}
+	if content["headline"] != "Nya dashboarden är här" {
+		t.Errorf("Expected headline to be kept, got %v", content["headline"])
+	}
+}
+
+// TDD: Test RefineArticle sends the feedback to the AI and returns a new version for the same submission and issue
+func TestAnthropicRefineArticle(t *testing.T) {
+	article := `{"headline": "Dashboarden är här", "content": "Teamet lanserade en ny dashboard i veckan.", "byline": "Someone Else"}`
+	text, err := json.Marshal(article)
+	if err != nil {
+		t.Fatalf("Failed to encode article: %v", err)
+	}
+	body := fmt.Sprintf(`{"id":"msg_1","type":"message","role":"assistant","model":"claude-3-7-sonnet-latest","content":[{"type":"text","text":%s}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":20}}`, text)
+
+	// Keep the request, so the test can check what was sent
+	var request []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	service := &AnthropicService{
+		client:  anthropic.NewClient(option.WithAPIKey("test-key"), option.WithBaseURL(server.URL), option.WithMaxRetries(0)),
+		timeout: 5 * time.Second,
+		model:   anthropic.ModelClaude3_7SonnetLatest,
+	}
+
+	issueID := 7
+	previous := &database.ProcessedArticle{
+		ID:                3,
+		SubmissionID:      12,
+		NewsletterIssueID: &issueID,
+		JournalistType:    "general",
+		ProcessedContent:  `{"headline": "Nya dashboarden är här!!!", "content": "Teamet lanserade, äntligen, efter månader av väntan, en ny dashboard.", "byline": "Koco Kai"}`,
+	}
+
+	refined, err := service.RefineArticle(context.Background(), previous, "make it shorter")
+	if err != nil {
+		t.Fatalf("RefineArticle() failed: %v", err)
+	}
+
+	if !strings.Contains(string(request), "make it shorter") || !strings.Contains(refined.ProcessingPrompt, "make it shorter") {
+		t.Error("Expected the feedback to be sent in the prompt")
+	}
+	if !strings.Contains(refined.ProcessingPrompt, "Nya dashboarden är här!!!") {
+		t.Error("Expected the previous article to be sent in the prompt")
+	}
+	if refined.ID != 0 || refined.SubmissionID != 12 || refined.NewsletterIssueID == nil || *refined.NewsletterIssueID != issueID {
+		t.Errorf("Expected an unsaved version for submission 12 in issue %d, got %+v", issueID, refined)
+	}
+	content, err := refined.ParseJSONContent()
+	if err != nil {
+		t.Fatalf("Failed to parse refined content: %v", err)
+	}
+	if content["headline"] != "Dashboarden är här" || content["byline"] != "Koco Kai" {
+		t.Errorf("Expected the refined article signed by the journalist, got %v", content)
+	}
+}
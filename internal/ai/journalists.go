@@ -1,8 +1,16 @@
 package ai
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
 )
 
 // JournalistProfile defines a journalist personality with specific writing style and constraints
@@ -13,6 +21,16 @@ type JournalistProfile struct {
 	StyleInstructions string `json:"style_instructions"`
 	MaxWords          int    `json:"max_words"`
 	TemplateFormat    string `json:"template_format"`
+	Byline            string `json:"byline,omitempty"` // Signature on every article, whatever byline the AI writes
+
+	// Optional per-journalist overrides of the service's model and temperature
+	Model       string   `json:"model,omitempty"`
+	Temperature *float64 `json:"temperature,omitempty"`
+}
+
+// temperature returns a pointer for JournalistProfile.Temperature overrides
+func temperature(t float64) *float64 {
+	return &t
 }
 
 // JournalistProfiles contains all available journalist personalities
@@ -24,6 +42,7 @@ var JournalistProfiles = map[string]JournalistProfile{
 		StyleInstructions: `Write 250-300 words. Use active voice and engaging tone. Create a strong lead paragraph that hooks the reader. Focus on the small human element and why this matters to the common man. Use your famous storytelling tecniques (comedy, exagregated comparisons, made up statistics, etc). Use conversational language while maintaining professionalism. Always write in the Swedish language.`,
 		MaxWords:          300,
 		TemplateFormat:    "hero",
+		Byline:            "Kimchi Kawai",
 	},
 	"interview": {
 		Type:              "interview",
@@ -32,6 +51,7 @@ var JournalistProfiles = map[string]JournalistProfile{
 		StyleInstructions: `Format as Q&A with as many questions needed dependent on the input. Keep responses natural and conversational. Each question should build on the previous one. Total length 150-200 words. Make questions specific and engaging, not generic. If you have little to no information from the input, make your questions longer to fill out the space. Always write in the Swedish language. Always end with some sort of "tack för pratstunden" - but make it fit the tone of the interview.`,
 		MaxWords:          200,
 		TemplateFormat:    "interview",
+		Byline:            "Kramer Kent",
 	},
 	"sports": {
 		Type:              "sports",
@@ -40,6 +60,7 @@ var JournalistProfiles = map[string]JournalistProfile{
 		StyleInstructions: `Write with high energy and enthusiasm. Use appropriate sports terminology and metaphors. Include specific details about achievements or events. Keep it inclusive for non-athletes too. 150-200 words with dynamic, energetic tone.`,
 		MaxWords:          200,
 		TemplateFormat:    "column",
+		Byline:            "Staff Writer",
 	},
 	"general": {
 		Type:              "general",
@@ -48,6 +69,7 @@ var JournalistProfiles = map[string]JournalistProfile{
 		StyleInstructions: `Write clearly and concisely with professional but friendly tone. Focus on the key information and why it matters to team members. Use simple, direct language. Avoid jargon. 100-150 words maximum. Always write in the Swedish language.`,
 		MaxWords:          150,
 		TemplateFormat:    "column",
+		Byline:            "Koco Kai",
 	},
 	"body_mind": {
 		Type:              "body_mind",
@@ -56,15 +78,73 @@ var JournalistProfiles = map[string]JournalistProfile{
 		StyleInstructions: `You are tired, you've heard it all before. You just want to get this answer in as few words as possible. Be true, but very short. If there's no clear solution, try and make up a "word of wisdom" that is really hard to interpret and understand. End with an encouraging sign-off. Create a witty, ironic and relevant pseudonym for the letter writer that relates to their situation. Keep responses 150 - 200 words, hardly conversational yet wise. Always answer in the Swedish language.`,
 		MaxWords:          200,
 		TemplateFormat:    "advice",
+		Temperature:       temperature(0.5), // Lower for consistent advice columns
 	},
 }
 
-// GetJournalistProfile returns the profile for a given journalist type
+// ProfileStore provides runtime overrides for the built-in journalist profiles
+type ProfileStore interface {
+	GetJournalistPromptOverride(journalistType string) (string, bool, error)
+}
+
+var (
+	// profileStore is consulted by GetJournalistProfile before falling back to JournalistProfiles
+	profileStore ProfileStore
+	// bylineOverrides replace the built-in bylines of JournalistProfiles by journalist type
+	bylineOverrides map[string]string
+	// profileStoreMu guards profileStore and bylineOverrides, which are read by every AI request
+	profileStoreMu sync.RWMutex
+)
+
+// SetProfileStore configures where journalist prompt overrides are loaded from; nil disables overrides.
+// It is safe to call while submissions are being processed.
+func SetProfileStore(store ProfileStore) {
+	profileStoreMu.Lock()
+	defer profileStoreMu.Unlock()
+	profileStore = store
+}
+
+// SetJournalistBylines configures the byline signed on each journalist type's articles, replacing
+// the built-in one; types not in bylines keep theirs. It is safe to call while submissions are being processed.
+func SetJournalistBylines(bylines map[string]string) {
+	overrides := make(map[string]string, len(bylines))
+	for journalistType, byline := range bylines {
+		if byline = strings.TrimSpace(byline); byline != "" {
+			overrides[journalistType] = byline
+		}
+	}
+
+	profileStoreMu.Lock()
+	defer profileStoreMu.Unlock()
+	bylineOverrides = overrides
+}
+
+// GetJournalistProfile returns the profile for a journalist type, applying any stored
+// system prompt override and configured byline on top of the built-in profile
 func GetJournalistProfile(journalistType string) (*JournalistProfile, error) {
-	if profile, exists := JournalistProfiles[journalistType]; exists {
-		return &profile, nil
+	profile, exists := JournalistProfiles[journalistType]
+	if !exists {
+		return nil, fmt.Errorf("journalist type '%s' not found", journalistType)
 	}
-	return nil, fmt.Errorf("journalist type '%s' not found", journalistType)
+
+	profileStoreMu.RLock()
+	store := profileStore
+	if byline, ok := bylineOverrides[journalistType]; ok {
+		profile.Byline = byline
+	}
+	profileStoreMu.RUnlock()
+
+	if store != nil {
+		systemPrompt, found, err := store.GetJournalistPromptOverride(journalistType)
+		if err != nil {
+			slog.Warn("Failed to load journalist prompt override, using built-in profile",
+				"journalist_type", journalistType, "error", err)
+		} else if found {
+			profile.SystemPrompt = systemPrompt
+		}
+	}
+
+	return &profile, nil
 }
 
 // GetAvailableJournalistTypes returns a list of all available journalist types
@@ -112,8 +192,14 @@ func BuildJSONPrompt(submission, authorName, authorDepartment, journalistType st
 		return "", err
 	}
 
+	// The example byline is the one every article gets signed with anyway
+	byline := profile.Byline
+	if byline == "" {
+		byline = profile.Name
+	}
+
 	// Get journalist-specific JSON structure requirements
-	jsonStructure := getJSONStructureForJournalist(journalistType)
+	jsonStructure := getJSONStructureForJournalist(journalistType, byline)
 	requiredFields := GetRequiredJSONFields(journalistType)
 
 	prompt := fmt.Sprintf(`%s
@@ -148,7 +234,86 @@ Return ONLY valid JSON. No preamble, explanation, or additional text. The JSON m
 		submission,
 		jsonStructure,
 		requiredFields,
-		profile.Name,
+		byline,
+	)
+
+	return prompt, nil
+}
+
+// BuildRefinementPrompt creates a prompt asking the journalist to revise a previous article, given
+// as its JSON output, following an editor's feedback such as "make it shorter"
+func BuildRefinementPrompt(previous, feedback, journalistType string) (string, error) {
+	profile, err := GetJournalistProfile(journalistType)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(previous) == "" {
+		return "", fmt.Errorf("previous article is required")
+	}
+	if strings.TrimSpace(feedback) == "" {
+		return "", fmt.Errorf("feedback is required")
+	}
+
+	byline := profile.Byline
+	if byline == "" {
+		byline = profile.Name
+	}
+
+	jsonStructure := getJSONStructureForJournalist(journalistType, byline)
+	requiredFields := GetRequiredJSONFields(journalistType)
+
+	prompt := fmt.Sprintf(`%s
+
+%s
+
+You already wrote this article:
+%s
+
+The editor has asked for the following changes:
+"%s"
+
+Revise the article to follow the editor's feedback. Keep everything the feedback doesn't ask you to change, and do not invent new facts.
+
+CRITICAL: You MUST return your response as valid JSON in the following structure:
+%s
+
+Required fields: %v
+
+Return ONLY valid JSON. No preamble, explanation, or additional text. The JSON must be parseable and contain all required fields.`,
+		profile.SystemPrompt,
+		profile.StyleInstructions,
+		previous,
+		feedback,
+		jsonStructure,
+		requiredFields,
+	)
+
+	return prompt, nil
+}
+
+// BuildIssueIntroPrompt creates the prompt for a short Swedish "In this issue" intro from article
+// headlines, tying them to the issue's theme when it has one
+func BuildIssueIntroPrompt(headlines []string, theme string) (string, error) {
+	if len(headlines) == 0 {
+		return "", fmt.Errorf("at least one headline is required")
+	}
+
+	var list strings.Builder
+	for _, headline := range headlines {
+		list.WriteString("- " + headline + "\n")
+	}
+	if theme != "" {
+		list.WriteString(fmt.Sprintf("\nThis week's theme is %q. Tie the highlights to it where it fits naturally, without forcing it.\n", theme))
+	}
+
+	prompt := fmt.Sprintf(`You are the editor of Kumpan's internal weekly newsletter.
+
+Write a single short paragraph in Swedish (2-4 sentences) introducing this week's issue under the heading "I detta nummer". Mention the highlights so colleagues want to read on, in a warm and informal tone. Do not invent details beyond the headlines.
+
+This week's article headlines:
+%s
+Return ONLY the paragraph text. No heading, preamble, explanation, or quotation marks.`,
+		list.String(),
 	)
 
 	return prompt, nil
@@ -170,7 +335,78 @@ func GetRequiredJSONFields(journalistType string) []string {
 	}
 }
 
-// ValidateJSONResponse validates that the JSON response contains required fields
+// NormalizeByline replaces the byline of AI JSON output with the journalist's configured byline,
+// so a journalist signs every article the same way however the AI wrote it. Output is returned
+// unchanged when the journalist type has no byline field or the output isn't a JSON object.
+func NormalizeByline(jsonResponse string, profile *JournalistProfile) string {
+	if profile.Byline == "" || !slices.Contains(GetRequiredJSONFields(profile.Type), "byline") {
+		return jsonResponse
+	}
+
+	// Raw values keep every other field exactly as the AI wrote it
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(jsonResponse), &fields); err != nil || fields == nil {
+		return jsonResponse
+	}
+
+	var current string
+	if json.Unmarshal(fields["byline"], &current) == nil && current == profile.Byline {
+		return jsonResponse
+	}
+
+	byline, err := marshalWithoutHTMLEscape(profile.Byline)
+	if err != nil {
+		return jsonResponse
+	}
+	fields["byline"] = byline
+
+	normalized, err := marshalWithoutHTMLEscape(fields)
+	if err != nil {
+		return jsonResponse
+	}
+	return string(normalized)
+}
+
+// marshalWithoutHTMLEscape encodes v as JSON, leaving <, > and & as they are so article HTML stays readable
+func marshalWithoutHTMLEscape(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// trailingCommaPattern matches a comma directly before a closing brace or bracket
+var trailingCommaPattern = regexp.MustCompile(`,(\s*[}\]])`)
+
+// CleanJSONResponse repairs minor formatting issues in AI JSON output: markdown
+// code fences and prose around the object are stripped, and trailing commas are
+// removed if the object does not parse as-is
+func CleanJSONResponse(response string) string {
+	cleaned := strings.TrimSpace(response)
+
+	// Keep only the outermost object, dropping fences and surrounding prose
+	start := strings.Index(cleaned, "{")
+	end := strings.LastIndex(cleaned, "}")
+	if start == -1 || end < start {
+		return cleaned
+	}
+	cleaned = cleaned[start : end+1]
+
+	if !json.Valid([]byte(cleaned)) {
+		if repaired := trailingCommaPattern.ReplaceAllString(cleaned, "$1"); json.Valid([]byte(repaired)) {
+			return repaired
+		}
+	}
+
+	return cleaned
+}
+
+// ValidateJSONResponse validates that the JSON response contains the required fields with
+// the types the templates render: interview questions as q/a objects, everything else as strings.
+// The response must already have been passed through CleanJSONResponse.
 func ValidateJSONResponse(jsonResponse, journalistType string) error {
 	// Parse JSON
 	var parsed map[string]interface{}
@@ -181,12 +417,24 @@ func ValidateJSONResponse(jsonResponse, journalistType string) error {
 	// Check required fields
 	requiredFields := GetRequiredJSONFields(journalistType)
 	for _, field := range requiredFields {
-		if _, exists := parsed[field]; !exists {
+		value, exists := parsed[field]
+		if !exists {
 			return fmt.Errorf("missing required field: %s", field)
 		}
 
+		if field == "questions" {
+			if err := validateInterviewQuestions(jsonResponse, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("required field %s must be a string", field)
+		}
 		// Ensure field is not empty
-		if str, ok := parsed[field].(string); ok && str == "" {
+		if str == "" {
 			return fmt.Errorf("required field %s cannot be empty", field)
 		}
 	}
@@ -194,32 +442,62 @@ func ValidateJSONResponse(jsonResponse, journalistType string) error {
 	return nil
 }
 
-// getJSONStructureForJournalist returns the JSON structure description for a journalist type
-func getJSONStructureForJournalist(journalistType string) string {
+// validateInterviewQuestions checks that questions is a non-empty array of objects with
+// non-empty "q" and "a" strings. It parses the same way the templates do, so an entry
+// ParseInterviewQuestions would drop fails validation instead.
+func validateInterviewQuestions(content string, value interface{}) error {
+	entries, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("required field questions must be an array")
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("required field questions cannot be empty")
+	}
+
+	article := database.ProcessedArticle{ProcessedContent: content}
+	questions, err := article.ParseInterviewQuestions()
+	if err != nil {
+		return fmt.Errorf("invalid questions: %w", err)
+	}
+	if len(questions) != len(entries) {
+		return fmt.Errorf("every question must be an object with \"q\" and \"a\" strings")
+	}
+	for i, question := range questions {
+		if strings.TrimSpace(question.Question) == "" || strings.TrimSpace(question.Answer) == "" {
+			return fmt.Errorf("question %d must have a non-empty \"q\" and \"a\"", i+1)
+		}
+	}
+
+	return nil
+}
+
+// getJSONStructureForJournalist returns the JSON structure description for a journalist type,
+// signed with the given byline
+func getJSONStructureForJournalist(journalistType, byline string) string {
 	switch journalistType {
 	case "feature":
-		return `{
+		return fmt.Sprintf(`{
   "headline": "Catchy, engaging headline",
   "lead": "Strong opening paragraph that hooks the reader",
   "body": "Main article content with human interest angle",
-  "byline": "Kimchi Kawai"
-}`
+  "byline": %q
+}`, byline)
 	case "interview":
-		return `{
+		return fmt.Sprintf(`{
   "headline": "Interview-style headline",
   "introduction": "Brief introduction to the interview",
   "questions": [
     {"q": "Question text", "a": "Answer text"},
     {"q": "Follow-up question", "a": "Response"}
   ],
-  "byline": "Kramer Kent"
-}`
+  "byline": %q
+}`, byline)
 	case "general":
-		return `{
+		return fmt.Sprintf(`{
   "headline": "Clear, informative headline",
   "content": "Straightforward news content",
-  "byline": "Koco Kai"
-}`
+  "byline": %q
+}`, byline)
 	case "body_mind":
 		return `{
   "headline": "Kumpanens kropp & knopp",
@@ -228,10 +506,10 @@ func getJSONStructureForJournalist(journalistType string) string {
   "signoff": "Snarky but encouraging closing",
 }`
 	default:
-		return `{
+		return fmt.Sprintf(`{
   "headline": "Article headline",
   "body": "Article content",
-  "byline": "Staff Writer"
-}`
+  "byline": %q
+}`, byline)
 	}
 }
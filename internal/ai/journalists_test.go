@@ -0,0 +1,289 @@
+package ai
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+// TDD: Test a stored prompt override replaces the built-in system prompt, and absence falls back
+func TestGetJournalistProfileWithOverride(t *testing.T) {
+	db, err := database.NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	SetProfileStore(db)
+	t.Cleanup(func() { SetProfileStore(nil) })
+
+	builtIn := JournalistProfiles["feature"]
+
+	profile, err := GetJournalistProfile("feature")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	if profile.SystemPrompt != builtIn.SystemPrompt {
+		t.Error("Expected built-in system prompt when no override is stored")
+	}
+
+	override := "You are a dry, understated feature writer."
+	if err := db.SetJournalistPromptOverride("feature", override); err != nil {
+		t.Fatalf("SetJournalistPromptOverride() failed: %v", err)
+	}
+
+	profile, err = GetJournalistProfile("feature")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	if profile.SystemPrompt != override {
+		t.Errorf("Expected override system prompt, got %q", profile.SystemPrompt)
+	}
+	if profile.StyleInstructions != builtIn.StyleInstructions || profile.MaxWords != builtIn.MaxWords {
+		t.Error("Override should only replace the system prompt")
+	}
+	if JournalistProfiles["feature"].SystemPrompt != builtIn.SystemPrompt {
+		t.Error("Override must not modify the built-in profile map")
+	}
+
+	prompt, err := BuildJSONPrompt("We got a new coffee machine", "Anna", "Design", "feature")
+	if err != nil {
+		t.Fatalf("BuildJSONPrompt() failed: %v", err)
+	}
+	if !strings.Contains(prompt, override) {
+		t.Error("Expected prompt to be built from the override")
+	}
+
+	// Other types are unaffected
+	profile, err = GetJournalistProfile("general")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	if profile.SystemPrompt != JournalistProfiles["general"].SystemPrompt {
+		t.Error("Expected built-in prompt for a type without an override")
+	}
+
+	if _, err := GetJournalistProfile("poet"); err == nil {
+		t.Error("Expected unknown journalist type to fail even with a store configured")
+	}
+}
+
+// TDD: Test the issue intro prompt lists every headline and asks for Swedish output
+func TestBuildIssueIntroPrompt(t *testing.T) {
+	headlines := []string{"Nya dashboarden är här", "Fika med vår nya CTO"}
+
+	prompt, err := BuildIssueIntroPrompt(headlines, "")
+	if err != nil {
+		t.Fatalf("BuildIssueIntroPrompt() failed: %v", err)
+	}
+	for _, headline := range headlines {
+		if !strings.Contains(prompt, "- "+headline) {
+			t.Errorf("Expected prompt to list headline %q", headline)
+		}
+	}
+	if !strings.Contains(prompt, "Swedish") {
+		t.Error("Expected prompt to ask for a Swedish summary")
+	}
+	if strings.Contains(prompt, "theme") {
+		t.Error("Expected no theme in the prompt when the issue has none")
+	}
+
+	prompt, err = BuildIssueIntroPrompt(headlines, "Sommar")
+	if err != nil {
+		t.Fatalf("BuildIssueIntroPrompt() with theme failed: %v", err)
+	}
+	if !strings.Contains(prompt, `This week's theme is "Sommar"`) {
+		t.Error("Expected prompt to mention the issue's theme")
+	}
+
+	if _, err := BuildIssueIntroPrompt(nil, ""); err == nil {
+		t.Error("Expected error when there are no headlines")
+	}
+}
+
+// TDD: Test the refinement prompt carries the previous article, the editor's feedback and the JSON structure
+func TestBuildRefinementPrompt(t *testing.T) {
+	previous := `{"headline": "Nya dashboarden är här", "content": "Teamet lanserade en ny dashboard.", "byline": "Koco Kai"}`
+
+	prompt, err := BuildRefinementPrompt(previous, "make it shorter and less snarky", "general")
+	if err != nil {
+		t.Fatalf("BuildRefinementPrompt() failed: %v", err)
+	}
+	if !strings.Contains(prompt, previous) {
+		t.Error("Expected prompt to include the previous article")
+	}
+	if !strings.Contains(prompt, `"make it shorter and less snarky"`) {
+		t.Error("Expected prompt to include the editor's feedback")
+	}
+	if !strings.Contains(prompt, "Required fields: [headline content byline]") {
+		t.Error("Expected prompt to ask for the journalist's JSON fields")
+	}
+
+	if _, err := BuildRefinementPrompt(previous, "  ", "general"); err == nil {
+		t.Error("Expected error for empty feedback")
+	}
+	if _, err := BuildRefinementPrompt("", "shorter", "general"); err == nil {
+		t.Error("Expected error for an empty previous article")
+	}
+	if _, err := BuildRefinementPrompt(previous, "shorter", "poetry"); err == nil {
+		t.Error("Expected error for an unknown journalist type")
+	}
+}
+
+// staticProfileStore overrides every journalist type with the same prompt
+type staticProfileStore string
+
+func (s staticProfileStore) GetJournalistPromptOverride(string) (string, bool, error) {
+	return string(s), true, nil
+}
+
+// TDD: Test the profile store can be swapped while profiles are being read (run with -race)
+func TestSetProfileStoreConcurrentWithReads(t *testing.T) {
+	t.Cleanup(func() { SetProfileStore(nil) })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			SetProfileStore(staticProfileStore("Override"))
+			SetProfileStore(nil)
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		profile, err := GetJournalistProfile("general")
+		if err != nil {
+			t.Fatalf("GetJournalistProfile() failed: %v", err)
+		}
+		if profile.SystemPrompt != "Override" && profile.SystemPrompt != JournalistProfiles["general"].SystemPrompt {
+			t.Fatalf("Unexpected system prompt %q", profile.SystemPrompt)
+		}
+	}
+	<-done
+}
+
+// TDD: Test AI output is always signed with the journalist's canonical byline
+func TestNormalizeByline(t *testing.T) {
+	tests := []struct {
+		name           string
+		journalistType string
+		response       string
+		expected       string // Expected byline, empty when the response must be left untouched
+	}{
+		{
+			name:           "invented name is replaced",
+			journalistType: "feature",
+			response:       `{"headline": "Nya dashboarden", "lead": "Det hände.", "body": "<p>Mer & mer</p>", "byline": "Erik Lindqvist, Feature Writer"}`,
+			expected:       "Kimchi Kawai",
+		},
+		{
+			name:           "missing byline is added",
+			journalistType: "general",
+			response:       `{"headline": "Kontorsnytt", "content": "Ny kaffemaskin."}`,
+			expected:       "Koco Kai",
+		},
+		{
+			name:           "interview keeps its questions",
+			journalistType: "interview",
+			response:       `{"headline": "Samtal", "introduction": "Hej.", "questions": [{"q": "Hur?", "a": "Så."}], "byline": "Kramer K."}`,
+			expected:       "Kramer Kent",
+		},
+		{
+			name:           "canonical byline is left alone",
+			journalistType: "general",
+			response:       `{"headline": "Kontorsnytt", "content": "Ny kaffemaskin.", "byline": "Koco Kai"}`,
+		},
+		{
+			name:           "body_mind signs off instead of a byline",
+			journalistType: "body_mind",
+			response:       `{"headline": "Kropp & knopp", "question": "Varför?", "response": "Därför.", "signoff": "Trött Terapeut"}`,
+		},
+		{
+			name:           "invalid JSON is left for validation to reject",
+			journalistType: "feature",
+			response:       `{"headline": "Broken"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := GetJournalistProfile(tt.journalistType)
+			if err != nil {
+				t.Fatalf("GetJournalistProfile() failed: %v", err)
+			}
+
+			normalized := NormalizeByline(tt.response, profile)
+			if tt.expected == "" {
+				if normalized != tt.response {
+					t.Errorf("Expected response to be unchanged, got %s", normalized)
+				}
+				return
+			}
+
+			var original, fields map[string]interface{}
+			if err := json.Unmarshal([]byte(normalized), &fields); err != nil {
+				t.Fatalf("Normalized response is not valid JSON: %v\n%s", err, normalized)
+			}
+			if fields["byline"] != tt.expected {
+				t.Errorf("Expected byline %q, got %v", tt.expected, fields["byline"])
+			}
+
+			// Everything else is kept as the AI wrote it
+			json.Unmarshal([]byte(tt.response), &original)
+			delete(original, "byline")
+			delete(fields, "byline")
+			if !reflect.DeepEqual(original, fields) {
+				t.Errorf("Expected other fields to be unchanged, got %v", fields)
+			}
+			if strings.Contains(normalized, `\u003c`) || strings.Contains(normalized, `\u0026`) {
+				t.Errorf("Expected HTML in the article to stay readable, got %s", normalized)
+			}
+		})
+	}
+}
+
+// TDD: Test configured bylines replace the built-in ones in profiles, prompts and normalized output
+func TestSetJournalistBylines(t *testing.T) {
+	SetJournalistBylines(map[string]string{"feature": "Redaktionen", "general": "  "})
+	t.Cleanup(func() { SetJournalistBylines(nil) })
+
+	profile, err := GetJournalistProfile("feature")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	if profile.Byline != "Redaktionen" {
+		t.Errorf("Expected configured byline, got %q", profile.Byline)
+	}
+	if JournalistProfiles["feature"].Byline != "Kimchi Kawai" {
+		t.Error("Configured bylines must not modify the built-in profile map")
+	}
+
+	normalized := NormalizeByline(`{"headline": "H", "lead": "L", "body": "B", "byline": "Kimchi Kawai"}`, profile)
+	if !strings.Contains(normalized, `"byline":"Redaktionen"`) {
+		t.Errorf("Expected the configured byline in the article, got %s", normalized)
+	}
+
+	prompt, err := BuildJSONPrompt("We got a new coffee machine", "Anna", "Design", "feature")
+	if err != nil {
+		t.Fatalf("BuildJSONPrompt() failed: %v", err)
+	}
+	if !strings.Contains(prompt, `"byline": "Redaktionen"`) || strings.Contains(prompt, "Kimchi Kawai") || strings.Contains(prompt, "Feature Writer") {
+		t.Error("Expected the prompt examples to use only the configured byline")
+	}
+
+	// A blank byline keeps the built-in one
+	profile, err = GetJournalistProfile("general")
+	if err != nil {
+		t.Fatalf("GetJournalistProfile() failed: %v", err)
+	}
+	if profile.Byline != "Koco Kai" {
+		t.Errorf("Expected built-in byline for a blank configured one, got %q", profile.Byline)
+	}
+}
@@ -3,6 +3,7 @@ package ai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
@@ -35,57 +36,146 @@ type EnhancedAIService interface {
 	ProcessAndSaveSubmission(ctx context.Context, db *database.DB, submission database.Submission, authorName, authorDepartment, journalistType string, newsletterIssueID *int) error
 }
 
+// IntroGenerator writes the "In this issue" introduction for a newsletter issue
+type IntroGenerator interface {
+	// GenerateIssueIntro summarizes an issue's article headlines in one short Swedish paragraph,
+	// tied to the issue's theme unless it is empty
+	GenerateIssueIntro(ctx context.Context, headlines []string, theme string) (string, error)
+}
+
+// ArticleRefiner revises an existing article following an editor's feedback
+type ArticleRefiner interface {
+	// RefineArticle rewrites a processed article with the same journalist, following feedback such as
+	// "make it shorter". The returned article is a new, unsaved version for the same submission.
+	RefineArticle(ctx context.Context, previous *database.ProcessedArticle, feedback string) (*database.ProcessedArticle, error)
+}
+
 // ProcessingResult contains the AI processing result details
 type ProcessingResult struct {
 	ProcessedContent string
 	WordCount        int
 	ProcessingTime   time.Duration
 	TokensUsed       int
+	PromptTokens     int
+	CompletionTokens int
 	Model            string
 }
 
-// ProcessingError represents AI processing failures with context
-type ProcessingError struct {
-	Type      string // "api_error", "rate_limit", "content_filter", "timeout", "invalid_response"
-	Message   string
-	Retryable bool
-	Cause     error
+// Claude Sonnet list pricing in USD per million tokens, used for cost estimates
+const (
+	promptTokenPricePerMillion     = 3.00
+	completionTokenPricePerMillion = 15.00
+)
+
+// EstimateCostUSD estimates the API cost of a request from its token counts
+func EstimateCostUSD(promptTokens, completionTokens int) float64 {
+	return float64(promptTokens)*promptTokenPricePerMillion/1_000_000 +
+		float64(completionTokens)*completionTokenPricePerMillion/1_000_000
 }
 
-func (e ProcessingError) Error() string {
+// AIErrorKind classifies AI failures so callers can decide how to react
+type AIErrorKind string
+
+const (
+	AIErrorRateLimited     AIErrorKind = "rate_limited"
+	AIErrorAuth            AIErrorKind = "auth"
+	AIErrorInvalidResponse AIErrorKind = "invalid_response"
+	AIErrorTimeout         AIErrorKind = "timeout"
+	AIErrorUnknown         AIErrorKind = "unknown"
+)
+
+// AIError is the structured error returned by the Anthropic service
+type AIError struct {
+	Kind     AIErrorKind
+	Message  string
+	Cause    error
+	Attempts int // Requests made before giving up, set by the retry loop
+}
+
+func (e *AIError) Error() string {
 	if e.Cause != nil {
 		return e.Message + ": " + e.Cause.Error()
 	}
 	return e.Message
 }
 
-// NewProcessingError creates a new processing error
-func NewProcessingError(errorType, message string, retryable bool, cause error) *ProcessingError {
-	return &ProcessingError{
-		Type:      errorType,
-		Message:   message,
-		Retryable: retryable,
-		Cause:     cause,
+func (e *AIError) Unwrap() error {
+	return e.Cause
+}
+
+// Retryable reports whether retrying the same request may succeed
+func (e *AIError) Retryable() bool {
+	return e.Kind == AIErrorRateLimited || e.Kind == AIErrorTimeout
+}
+
+// NewAIError creates a new structured AI error
+func NewAIError(kind AIErrorKind, message string, cause error) *AIError {
+	return &AIError{
+		Kind:    kind,
+		Message: message,
+		Cause:   cause,
 	}
 }
 
-// CategoryToJournalistMapping maps submission categories to journalist types
+// AttemptsOf returns how many AI requests were made before err was returned, looking through
+// wrapped errors. Errors that never went through the retry loop count as one attempt.
+func AttemptsOf(err error) int {
+	var aiErr *AIError
+	if errors.As(err, &aiErr) && aiErr.Attempts > 0 {
+		return aiErr.Attempts
+	}
+	return 1
+}
+
+// ErrorKindOf returns the AIErrorKind of err, looking through wrapped errors
+func ErrorKindOf(err error) AIErrorKind {
+	var aiErr *AIError
+	if errors.As(err, &aiErr) {
+		return aiErr.Kind
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return AIErrorTimeout
+	}
+	return AIErrorUnknown
+}
+
+// IsRetryable reports whether err is a rate limit or timeout worth retrying
+func IsRetryable(err error) bool {
+	kind := ErrorKindOf(err)
+	return kind == AIErrorRateLimited || kind == AIErrorTimeout
+}
+
+// CategoryToJournalistMapping maps submission and question categories to journalist types.
+// Categories not listed here are written up by the general journalist.
 var CategoryToJournalistMapping = map[string]string{
+	// Submission categories
 	"feature":   "feature",
 	"interview": "interview",
 	"sports":    "sports",
-	"tech":      "general",
 	"general":   "general",
 	"body_mind": "body_mind",
 	"advice":    "body_mind", // Alternative category name
+
+	// Question categories
+	"work":     "general",
+	"fun":      "general",
+	"personal": "general",
+	"tech":     "general",
+
+	// Body/mind pool categories
+	"wellness":          "body_mind",
+	"mental_health":     "body_mind",
+	"work_life_balance": "body_mind",
 }
 
-// GetJournalistTypeForCategory returns the journalist type for a given category
+// GetJournalistTypeForCategory returns the journalist type for a given category, ignoring case
+// and surrounding spaces. Unknown and empty categories fall back to "general", so the result is
+// always a valid journalist type.
 func GetJournalistTypeForCategory(category string) string {
-	if journalistType, exists := CategoryToJournalistMapping[category]; exists {
+	if journalistType, exists := CategoryToJournalistMapping[strings.ToLower(strings.TrimSpace(category))]; exists {
 		return journalistType
 	}
-	return "general" // Default fallback
+	return "general"
 }
 
 // ParsedJSONResponse represents a parsed JSON article response
@@ -95,17 +185,18 @@ type ParsedJSONResponse struct {
 	WordCount      int                    `json:"word_count"`
 }
 
-// ParseJSONResponse parses and validates JSON response from AI
+// ParseJSONResponse parses and validates JSON response from AI. The response must already have
+// been passed through CleanJSONResponse. Errors are AIErrors of kind AIErrorInvalidResponse.
 func ParseJSONResponse(jsonResponse, journalistType string) (*ParsedJSONResponse, error) {
 	// Validate JSON format and required fields
 	if err := ValidateJSONResponse(jsonResponse, journalistType); err != nil {
-		return nil, err
+		return nil, NewAIError(AIErrorInvalidResponse, "AI response is not valid JSON", err)
 	}
 
 	// Parse JSON content
 	var content map[string]interface{}
 	if err := json.Unmarshal([]byte(jsonResponse), &content); err != nil {
-		return nil, NewProcessingError("invalid_response", "Failed to parse JSON response", false, err)
+		return nil, NewAIError(AIErrorInvalidResponse, "failed to parse JSON response", err)
 	}
 
 	// Calculate approximate word count from all text fields
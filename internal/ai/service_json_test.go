@@ -124,17 +124,25 @@ func TestParseJSONResponse(t *testing.T) {
 			}`,
 			journalistType: "feature",
 			expectError:    true,
+		}, {
+			name:           "Fenced feature JSON with surrounding prose",
+			jsonResponse:   "Sure! Here's the article:\n```json\n{\"headline\": \"Dashboard Launch\", \"lead\": \"Engineering shipped it.\", \"body\": \"Everyone loves the new charts.\", \"byline\": \"Erik Lindqvist\"}\n```",
+			journalistType: "feature",
+			expectError:    false,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// This should FAIL initially as ParseJSONResponse doesn't exist
-			result, err := ParseJSONResponse(tc.jsonResponse, tc.journalistType)
+			// Responses are cleaned once when received, before parsing
+			result, err := ParseJSONResponse(CleanJSONResponse(tc.jsonResponse), tc.journalistType)
 
 			if tc.expectError && err == nil {
 				t.Errorf("Expected error for %s", tc.name)
 			}
+			if tc.expectError && ErrorKindOf(err) != AIErrorInvalidResponse {
+				t.Errorf("Expected an %s AIError for %s, got %v", AIErrorInvalidResponse, tc.name, err)
+			}
 
 			if !tc.expectError && err != nil {
 				t.Errorf("Unexpected error for %s: %v", tc.name, err)
@@ -178,8 +178,15 @@ func TestGetJournalistTypeForCategory(t *testing.T) {
 		{"general", "general"},
 		{"body_mind", "body_mind"},
 		{"advice", "body_mind"}, // Alternative category name
-		{"unknown", "general"},  // Should default to general
-		{"", "general"},         // Should default to general
+		{"work", "general"},
+		{"fun", "general"},
+		{"personal", "general"},
+		{"wellness", "body_mind"},
+		{"mental_health", "body_mind"},
+		{"work_life_balance", "body_mind"},
+		{" Feature ", "feature"}, // Case and spacing are ignored
+		{"unknown", "general"},   // Should default to general
+		{"", "general"},          // Should default to general
 	}
 
 	for _, tt := range tests {
@@ -190,6 +197,20 @@ func TestGetJournalistTypeForCategory(t *testing.T) {
 			}
 		})
 	}
+
+	// Every mapped category is covered above and leads to a journalist that exists
+	covered := make(map[string]bool, len(tests))
+	for _, tt := range tests {
+		covered[tt.category] = true
+	}
+	for category, journalistType := range CategoryToJournalistMapping {
+		if !covered[category] {
+			t.Errorf("Category %q is missing from the table above", category)
+		}
+		if !ValidateJournalistType(journalistType) {
+			t.Errorf("Category %q maps to unknown journalist type %q", category, journalistType)
+		}
+	}
 }
 
 func TestCountWords(t *testing.T) {
@@ -246,19 +267,14 @@ func TestAnthropicServiceInterface(t *testing.T) {
 	}
 }
 
-func TestProcessingError(t *testing.T) {
-	// Test ProcessingError creation and methods
-	err := NewProcessingError("rate_limit", "Rate limit exceeded", true, nil)
-
-	if err.Type != "rate_limit" {
-		t.Errorf("Expected type 'rate_limit', got %s", err.Type)
-	}
+func TestAIError(t *testing.T) {
+	err := NewAIError(AIErrorRateLimited, "Rate limit exceeded", nil)
 
-	if err.Message != "Rate limit exceeded" {
-		t.Errorf("Expected message 'Rate limit exceeded', got %s", err.Message)
+	if err.Kind != AIErrorRateLimited {
+		t.Errorf("Expected kind %s, got %s", AIErrorRateLimited, err.Kind)
 	}
 
-	if !err.Retryable {
+	if !err.Retryable() {
 		t.Error("Expected error to be retryable")
 	}
 
@@ -269,12 +285,18 @@ func TestProcessingError(t *testing.T) {
 
 	// Test with cause
 	cause := errors.New("underlying error")
-	err = NewProcessingError("api_error", "API failed", false, cause)
+	err = NewAIError(AIErrorUnknown, "API failed", cause)
 
 	expectedWithCause := "API failed: underlying error"
 	if err.Error() != expectedWithCause {
 		t.Errorf("Expected error string %s, got %s", expectedWithCause, err.Error())
 	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected the cause to be unwrapped")
+	}
+	if err.Retryable() {
+		t.Error("Expected unknown errors not to be retryable")
+	}
 }
 
 // Mock implementation for testing without real API calls
@@ -285,11 +307,11 @@ type MockAIService struct {
 
 func (m *MockAIService) ProcessSubmission(ctx context.Context, submission database.Submission, journalistType string) (*database.ProcessedArticle, error) {
 	if m.shouldFail {
-		return nil, NewProcessingError("mock_error", "Mock processing failed", true, nil)
+		return nil, NewAIError(AIErrorRateLimited, "Mock processing failed", nil)
 	}
 
 	if !ValidateJournalistType(journalistType) {
-		return nil, NewProcessingError("invalid_journalist_type", "Invalid journalist type", false, nil)
+		return nil, NewAIError(AIErrorUnknown, "Invalid journalist type", nil)
 	}
 
 	profile, _ := GetJournalistProfile(journalistType)
@@ -361,12 +383,12 @@ func TestMockAIService(t *testing.T) {
 		t.Error("Expected error when shouldFail is true")
 	}
 
-	processingErr, ok := err.(*ProcessingError)
-	if !ok {
-		t.Error("Expected ProcessingError type")
+	var aiErr *AIError
+	if !errors.As(err, &aiErr) {
+		t.Fatal("Expected AIError type")
 	}
 
-	if !processingErr.Retryable {
+	if !aiErr.Retryable() {
 		t.Error("Expected mock error to be retryable")
 	}
 }
@@ -3,18 +3,46 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/ai"
 )
 
 type Config struct {
-	Port               string
-	LogLevel           string
-	Env                string
-	SlackBotToken      string
-	SlackSigningSecret string
-	AdminUsers         []string
-	DatabasePath       string
-	AnthropicAPIKey    string
+	Port                 string
+	LogLevel             string
+	Env                  string
+	SlackBotToken        string
+	SlackSigningSecret   string
+	AdminUsers           []string
+	EditorUsers          []string
+	DatabasePath         string
+	AnthropicAPIKey      string
+	MetricsToken         string
+	NewsletterToken      string // Lets editors preview unpublished issues via ?admin=<token>
+	Timezone             string
+	DuplicateWindow      time.Duration
+	AITimeout            time.Duration
+	AIModel              string            // Anthropic model used unless a journalist overrides it
+	AITemperature        float64           // Sampling temperature unless a journalist overrides it
+	QuestionCooldown     int               // Weeks before a used question is picked again
+	BannedWords          []string          // Submissions containing these are held for admin review
+	AssignmentSlots      map[string]int    // Max assignments per content type in one issue
+	SubmissionCategories []string          // Categories accepted by `submit`, empty means all
+	DefaultCategory      string            // Category for submissions that name none
+	DefaultJournalist    string            // Journalist for submissions with neither a question nor an assignment
+	MinSubmissionLength  int               // Submissions shorter than this many characters are rejected
+	MaxSubmissionLength  int               // Submissions longer than this many characters are rejected
+	DMReplyGracePeriod   time.Duration     // How long into a week DM replies may still answer last week's assignment
+	LowPoolThreshold     int               // Auto-broadcast for body/mind questions below this pool size, 0 disables
+	WeeklyRunToken       string            // Shared secret for POST /admin/run-weekly, empty disables it
+	WeeklyRunCandidates  []string          // Users or @usergroups the weekly run assigns from
+	SubmissionWindow     string            // Days `submit` accepts submissions, e.g. "monday-wednesday"; "off" accepts them any time
+	SlackMaxRetries      int               // Retries of a Slack API call after a rate limit or server error
+	JournalistBylines    map[string]string // Byline per journalist type, replacing the built-in ones
+	DebugErrors          bool              // Include details such as the caller's Slack ID in error replies
 }
 
 func Load() *Config {
@@ -22,15 +50,67 @@ func Load() *Config {
 	if admins := getEnv("ADMIN_USERS", ""); admins != "" {
 		adminUsers = strings.Split(admins, ",")
 	}
+	var editorUsers []string
+	if editors := getEnv("EDITOR_USERS", ""); editors != "" {
+		editorUsers = strings.Split(editors, ",")
+	}
+	var bannedWords []string
+	if words := getEnv("BANNED_WORDS", ""); words != "" {
+		for _, word := range strings.Split(words, ",") {
+			if word = strings.TrimSpace(word); word != "" {
+				bannedWords = append(bannedWords, word)
+			}
+		}
+	}
+	var submissionCategories []string
+	if categories := getEnv("SUBMISSION_CATEGORIES", ""); categories != "" {
+		for _, category := range strings.Split(categories, ",") {
+			if category = strings.TrimSpace(category); category != "" {
+				submissionCategories = append(submissionCategories, category)
+			}
+		}
+	}
+	var weeklyRunCandidates []string
+	if candidates := getEnv("WEEKLY_RUN_CANDIDATES", ""); candidates != "" {
+		for _, candidate := range strings.Split(candidates, ",") {
+			if candidate = strings.TrimSpace(candidate); candidate != "" {
+				weeklyRunCandidates = append(weeklyRunCandidates, candidate)
+			}
+		}
+	}
 	return &Config{
-		Port:               getEnv("PORT", "8080"),
-		LogLevel:           getEnv("LOG_LEVEL", "info"),
-		Env:                getEnv("ENVIRONMENT", "development"),
-		SlackBotToken:      getEnv("SLACK_BOT_TOKEN", ""),
-		SlackSigningSecret: getEnv("SLACK_SIGNING_SECRET", ""),
-		AdminUsers:         adminUsers,
-		DatabasePath:       getEnv("DATABASE_PATH", "newsletter.db"),
-		AnthropicAPIKey:    getEnv("ANTHROPIC_API_KEY", ""),
+		Port:                 getEnv("PORT", "8080"),
+		LogLevel:             getEnv("LOG_LEVEL", "info"),
+		Env:                  getEnv("ENVIRONMENT", "development"),
+		SlackBotToken:        getEnv("SLACK_BOT_TOKEN", ""),
+		SlackSigningSecret:   getEnv("SLACK_SIGNING_SECRET", ""),
+		AdminUsers:           adminUsers,
+		EditorUsers:          editorUsers,
+		DatabasePath:         getEnv("DATABASE_PATH", "newsletter.db"),
+		AnthropicAPIKey:      getEnv("ANTHROPIC_API_KEY", ""),
+		MetricsToken:         getEnv("METRICS_TOKEN", ""),
+		NewsletterToken:      getEnv("NEWSLETTER_ADMIN_TOKEN", ""),
+		Timezone:             getEnv("TIMEZONE", "Europe/Stockholm"),
+		DuplicateWindow:      getDurationEnv("SUBMISSION_DUPLICATE_WINDOW", 60*time.Second),
+		AITimeout:            getDurationEnv("AI_TIMEOUT", 30*time.Second),
+		AIModel:              getEnv("AI_MODEL", "claude-3-7-sonnet-latest"),
+		AITemperature:        getFloatEnv("AI_TEMPERATURE", 1.0),
+		QuestionCooldown:     getIntEnv("QUESTION_COOLDOWN_WEEKS", 4),
+		BannedWords:          bannedWords,
+		AssignmentSlots:      getSlotsEnv("ASSIGNMENT_SLOTS", "feature=1,general=3"),
+		SubmissionCategories: submissionCategories,
+		DefaultCategory:      getEnv("DEFAULT_SUBMISSION_CATEGORY", "general"),
+		DefaultJournalist:    getEnv("DEFAULT_JOURNALIST_TYPE", "general"),
+		MinSubmissionLength:  getIntEnv("SUBMISSION_MIN_LENGTH", 10),
+		MaxSubmissionLength:  getIntEnv("SUBMISSION_MAX_LENGTH", 4000),
+		DMReplyGracePeriod:   getDurationEnv("DM_REPLY_GRACE_PERIOD", 48*time.Hour),
+		LowPoolThreshold:     getIntEnv("LOW_POOL_BROADCAST_THRESHOLD", 0),
+		WeeklyRunToken:       getEnv("WEEKLY_RUN_TOKEN", ""),
+		WeeklyRunCandidates:  weeklyRunCandidates,
+		SubmissionWindow:     getEnv("SUBMISSION_WINDOW", "monday-wednesday"),
+		SlackMaxRetries:      getIntEnv("SLACK_MAX_RETRIES", 3),
+		JournalistBylines:    getPairsEnv("JOURNALIST_BYLINES"),
+		DebugErrors:          getBoolEnv("SLACK_DEBUG_ERRORS", false),
 	}
 }
 
@@ -41,12 +121,142 @@ func (c *Config) Validate() error {
 	if c.AnthropicAPIKey == "" {
 		return fmt.Errorf("ANTHROPIC_API_KEY is required")
 	}
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("invalid TIMEZONE %q: %w", c.Timezone, err)
+	}
+	if _, _, _, err := c.SubmissionWindowDays(); err != nil {
+		return err
+	}
+	if !ai.ValidateJournalistType(c.DefaultJournalist) {
+		return fmt.Errorf("unknown DEFAULT_JOURNALIST_TYPE %q", c.DefaultJournalist)
+	}
+	if c.AITemperature < 0 || c.AITemperature > 1 {
+		return fmt.Errorf("AI_TEMPERATURE must be between 0 and 1, got %g", c.AITemperature)
+	}
+	if len(c.SubmissionCategories) > 0 {
+		enabled := false
+		for _, category := range c.SubmissionCategories {
+			if category == c.DefaultCategory {
+				enabled = true
+				break
+			}
+		}
+		if !enabled {
+			return fmt.Errorf("DEFAULT_SUBMISSION_CATEGORY %q must be one of SUBMISSION_CATEGORIES (%s)", c.DefaultCategory, strings.Join(c.SubmissionCategories, ", "))
+		}
+	}
 	return nil
 }
 
+// SubmissionWindowDays parses SubmissionWindow into the days it opens and closes. enabled is
+// false when the window is "off" and submissions are accepted any time.
+func (c *Config) SubmissionWindowDays() (opens, closes time.Weekday, enabled bool, err error) {
+	if strings.EqualFold(strings.TrimSpace(c.SubmissionWindow), "off") {
+		return 0, 0, false, nil
+	}
+
+	first, last, ok := strings.Cut(c.SubmissionWindow, "-")
+	if !ok {
+		return 0, 0, false, fmt.Errorf("invalid SUBMISSION_WINDOW %q: expected \"day-day\", e.g. \"monday-wednesday\", or \"off\"", c.SubmissionWindow)
+	}
+	if opens, ok = parseWeekday(first); !ok {
+		return 0, 0, false, fmt.Errorf("invalid SUBMISSION_WINDOW %q: unknown day %q", c.SubmissionWindow, first)
+	}
+	if closes, ok = parseWeekday(last); !ok {
+		return 0, 0, false, fmt.Errorf("invalid SUBMISSION_WINDOW %q: unknown day %q", c.SubmissionWindow, last)
+	}
+	return opens, closes, true, nil
+}
+
+// parseWeekday parses an English day name such as "Monday" or "mon"
+func parseWeekday(value string) (time.Weekday, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) < 3 {
+		return 0, false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.HasPrefix(strings.ToLower(day.String()), value) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+// getDurationEnv parses a duration such as "90s" or "2m", falling back to the default when unset or invalid
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// getIntEnv parses a non-negative integer, falling back to the default when unset or invalid
+func getIntEnv(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+// getBoolEnv parses a boolean such as "true" or "1", falling back to the default when unset or invalid
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+// getFloatEnv parses a non-negative number, falling back to the default when unset or invalid
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil && f >= 0 {
+			return f
+		}
+	}
+	return defaultValue
+}
+
+// getPairsEnv parses "key=value" pairs such as "feature=Kimchi Kawai,general=Koco Kai",
+// skipping malformed entries. Values can't contain commas.
+func getPairsEnv(key string) map[string]string {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(getEnv(key, ""), ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			continue
+		}
+		pairs[name] = value
+	}
+	return pairs
+}
+
+// getSlotsEnv parses "type=count" pairs such as "feature=1,general=3", skipping malformed entries
+func getSlotsEnv(key, defaultValue string) map[string]int {
+	slots := make(map[string]int)
+	for _, pair := range strings.Split(getEnv(key, defaultValue), ",") {
+		contentType, count, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 1 {
+			continue
+		}
+		slots[strings.TrimSpace(contentType)] = n
+	}
+	return slots
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// SetAdminRole grants a user the "super" or "editor" admin role, replacing any role granted earlier
+func (db *DB) SetAdminRole(userID, role, grantedBy string) error {
+	if role != "super" && role != "editor" {
+		return fmt.Errorf("invalid admin role: %s", role)
+	}
+
+	query := `
+		INSERT INTO admin_roles (user_id, role, granted_by, granted_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(user_id) DO UPDATE SET
+			role = excluded.role,
+			granted_by = excluded.granted_by,
+			granted_at = excluded.granted_at`
+
+	if _, err := db.Exec(query, userID, role, grantedBy); err != nil {
+		return fmt.Errorf("failed to set admin role: %w", err)
+	}
+
+	return nil
+}
+
+// GetAdminRole returns the role granted to a user, reporting false when none was granted
+func (db *DB) GetAdminRole(userID string) (string, bool, error) {
+	var role string
+	err := db.QueryRow("SELECT role FROM admin_roles WHERE user_id = ?", userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get admin role: %w", err)
+	}
+
+	return role, true, nil
+}
+
+// GetAdminRoles returns every granted admin role, super admins first
+func (db *DB) GetAdminRoles() ([]AdminRoleGrant, error) {
+	query := `
+		SELECT user_id, role, granted_by, granted_at
+		FROM admin_roles
+		ORDER BY CASE role WHEN 'super' THEN 0 ELSE 1 END, user_id`
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query admin roles: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []AdminRoleGrant
+	for rows.Next() {
+		var grant AdminRoleGrant
+		if err := rows.Scan(&grant.UserID, &grant.Role, &grant.GrantedBy, &grant.GrantedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan admin role: %w", err)
+		}
+		grants = append(grants, grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating admin roles: %w", err)
+	}
+
+	return grants, nil
+}
+
+// DeleteAdminRole removes the role granted to a user, reporting false when there was none
+func (db *DB) DeleteAdminRole(userID string) (bool, error) {
+	result, err := db.Exec("DELETE FROM admin_roles WHERE user_id = ?", userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete admin role: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
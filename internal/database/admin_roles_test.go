@@ -0,0 +1,60 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TDD: Test granted admin roles can be set, replaced, listed and removed
+func TestAdminRoles(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	if _, found, err := db.GetAdminRole("U111EDITOR"); err != nil || found {
+		t.Fatalf("Expected no role initially, found=%v err=%v", found, err)
+	}
+
+	if err := db.SetAdminRole("U111EDITOR", "editor", "U999ADMIN"); err != nil {
+		t.Fatalf("SetAdminRole() failed: %v", err)
+	}
+	if err := db.SetAdminRole("U222SUPER", "editor", "U999ADMIN"); err != nil {
+		t.Fatalf("SetAdminRole() failed: %v", err)
+	}
+	if err := db.SetAdminRole("U222SUPER", "super", "U999ADMIN"); err != nil {
+		t.Fatalf("SetAdminRole() upsert failed: %v", err)
+	}
+	if err := db.SetAdminRole("U333", "owner", "U999ADMIN"); err == nil {
+		t.Error("Expected unknown role to be rejected")
+	}
+
+	role, found, err := db.GetAdminRole("U222SUPER")
+	if err != nil || !found || role != "super" {
+		t.Fatalf("Expected replaced super role, got %q found=%v err=%v", role, found, err)
+	}
+
+	grants, err := db.GetAdminRoles()
+	if err != nil {
+		t.Fatalf("GetAdminRoles() failed: %v", err)
+	}
+	if len(grants) != 2 || grants[0].UserID != "U222SUPER" || grants[1].Role != "editor" {
+		t.Fatalf("Expected super admin first, got %+v", grants)
+	}
+	if grants[0].GrantedBy != "U999ADMIN" || grants[0].GrantedAt.IsZero() {
+		t.Errorf("Expected grant details to be recorded, got %+v", grants[0])
+	}
+
+	removed, err := db.DeleteAdminRole("U111EDITOR")
+	if err != nil || !removed {
+		t.Fatalf("Expected role to be removed, removed=%v err=%v", removed, err)
+	}
+	if removed, err := db.DeleteAdminRole("U111EDITOR"); err != nil || removed {
+		t.Errorf("Expected nothing to remove the second time, removed=%v err=%v", removed, err)
+	}
+}
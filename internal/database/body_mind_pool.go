@@ -1,13 +1,29 @@
 package database
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
 	"time"
 )
 
 // BodyMindPoolManager handles the anonymous wellness question pool
 type BodyMindPoolManager struct {
-	db *DB
+	db      *DB
+	lowPool *lowPoolBroadcast // nil unless EnableLowPoolBroadcast was called
+}
+
+// lowPoolBroadcast asks the team for new questions when a selection leaves the pool low,
+// at most once per calendar day
+type lowPoolBroadcast struct {
+	threshold int
+	broadcast func(ctx context.Context) error
+	now       func() time.Time
+
+	mu       sync.Mutex
+	lastSent time.Time
 }
 
 // NewBodyMindPoolManager creates a new pool manager instance
@@ -15,16 +31,79 @@ func NewBodyMindPoolManager(db *DB) *BodyMindPoolManager {
 	return &BodyMindPoolManager{db: db}
 }
 
+// EnableLowPoolBroadcast makes question selection call broadcast whenever it leaves fewer
+// than threshold active questions in the pool. Broadcasts are limited to one per day as
+// reported by now, in the database's time zone.
+func (pm *BodyMindPoolManager) EnableLowPoolBroadcast(threshold int, broadcast func(ctx context.Context) error, now func() time.Time) {
+	pm.lowPool = &lowPoolBroadcast{
+		threshold: threshold,
+		broadcast: broadcast,
+		now:       now,
+	}
+}
+
+// checkLowPool fires the low-pool broadcast if the pool has dropped below the threshold
+// and no broadcast has gone out yet today. Failures are logged, not returned, since the
+// selection that triggered the check has already succeeded.
+func (pm *BodyMindPoolManager) checkLowPool() {
+	if pm.lowPool == nil {
+		return
+	}
+
+	status, err := pm.GetPoolStatus()
+	if err != nil {
+		slog.Error("Failed to check pool level after selection", "error", err)
+		return
+	}
+	if status.TotalActive >= pm.lowPool.threshold {
+		return
+	}
+
+	lp := pm.lowPool
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	now := lp.now().In(pm.db.Location())
+	if !lp.lastSent.IsZero() {
+		lastYear, lastMonth, lastDay := lp.lastSent.Date()
+		year, month, day := now.Date()
+		if lastYear == year && lastMonth == month && lastDay == day {
+			slog.Debug("Low-pool broadcast already sent today", "total_active", status.TotalActive)
+			return
+		}
+	}
+
+	slog.Info("Body/mind pool below threshold, broadcasting question request",
+		"total_active", status.TotalActive, "threshold", lp.threshold)
+	if err := lp.broadcast(context.Background()); err != nil {
+		slog.Error("Low-pool broadcast failed", "error", err)
+		return
+	}
+	lp.lastSent = now
+}
+
 // PoolStatus represents the current status of the body/mind question pool
 type PoolStatus struct {
 	TotalActive       int                  `json:"total_active"`
 	CategoryBreakdown map[string]int       `json:"category_breakdown"`
 	RecentActivity    []RecentActivityItem `json:"recent_activity"`
 	LowPoolWarning    bool                 `json:"low_pool_warning"`
+	CategoryWarnings  map[string]string    `json:"category_warnings,omitempty"`
 	RecommendedAction string               `json:"recommended_action"`
 }
 
-// RecentActivityItem represents a recent addition to the pool
+// bodyMindCategories lists the pool categories in display order
+var bodyMindCategories = []string{"wellness", "mental_health", "work_life_balance"}
+
+// BodyMindCategories returns the pool categories in display order
+func BodyMindCategories() []string {
+	return append([]string(nil), bodyMindCategories...)
+}
+
+// lowCategoryThreshold is the per-category count below which a targeted request is recommended
+const lowCategoryThreshold = 2
+
+// RecentActivityItem represents a recent addition to or use of the pool
 type RecentActivityItem struct {
 	Category string `json:"category"`
 	DaysAgo  int    `json:"days_ago"`
@@ -41,9 +120,8 @@ func (pm *BodyMindPoolManager) GetPoolStatus() (*PoolStatus, error) {
 
 	// Build category breakdown
 	categoryBreakdown := make(map[string]int)
-	validCategories := []string{"wellness", "mental_health", "work_life_balance"}
 
-	for _, category := range validCategories {
+	for _, category := range bodyMindCategories {
 		categoryBreakdown[category] = 0
 	}
 
@@ -72,15 +150,68 @@ func (pm *BodyMindPoolManager) GetPoolStatus() (*PoolStatus, error) {
 		recommendedAction = "✅ Pool levels healthy."
 	}
 
+	// Flag individual categories running dry, even when the pool as a whole is fine.
+	// An empty pool already gets the urgent overall warning.
+	categoryWarnings := make(map[string]string)
+	var categoryActions []string
+	if totalActive > 0 {
+		for _, category := range bodyMindCategories {
+			count := categoryBreakdown[category]
+			if count == 0 {
+				categoryWarnings[category] = fmt.Sprintf("%s category empty — broadcast targeted request", category)
+			} else if count < lowCategoryThreshold {
+				categoryWarnings[category] = fmt.Sprintf("%s category low (%d left) — consider targeted request", category, count)
+			} else {
+				continue
+			}
+			categoryActions = append(categoryActions, "⚠️ "+categoryWarnings[category])
+		}
+	}
+
+	if len(categoryActions) > 0 {
+		if lowPoolWarning {
+			recommendedAction += "\n" + strings.Join(categoryActions, "\n")
+		} else {
+			recommendedAction = strings.Join(categoryActions, "\n")
+		}
+	}
+
 	return &PoolStatus{
 		TotalActive:       totalActive,
 		CategoryBreakdown: categoryBreakdown,
 		RecentActivity:    recentActivity,
 		LowPoolWarning:    lowPoolWarning,
+		CategoryWarnings:  categoryWarnings,
 		RecommendedAction: recommendedAction,
 	}, nil
 }
 
+// GetPoolStatusForCategory returns how many active questions a single pool category has and
+// how long its oldest one has been waiting. The age is zero when the category is empty.
+func (pm *BodyMindPoolManager) GetPoolStatusForCategory(category string) (activeCount int, oldestAge time.Duration, err error) {
+	valid := false
+	for _, known := range bodyMindCategories {
+		if known == category {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return 0, 0, fmt.Errorf("invalid category: %s", category)
+	}
+
+	// Questions come oldest first
+	questions, err := pm.db.GetBodyMindQuestionsByCategory(category)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get active questions: %w", err)
+	}
+	if len(questions) == 0 {
+		return 0, 0, nil
+	}
+
+	return len(questions), time.Since(questions[0].CreatedAt), nil
+}
+
 // SelectQuestionForNewsletter selects and marks a question for use in the newsletter
 func (pm *BodyMindPoolManager) SelectQuestionForNewsletter() (*BodyMindQuestion, error) {
 	// Get all active questions
@@ -112,9 +243,68 @@ func (pm *BodyMindPoolManager) SelectQuestionForNewsletter() (*BodyMindQuestion,
 	now := time.Now()
 	selectedQuestion.UsedAt = &now
 
+	pm.checkLowPool()
+
 	return &selectedQuestion, nil
 }
 
+// SelectQuestionsForNewsletter selects up to n of the oldest active questions (FIFO) and
+// marks them used in a single transaction. When the pool holds fewer than n questions,
+// all of them are returned and a warning is logged.
+func (pm *BodyMindPoolManager) SelectQuestionsForNewsletter(n int) ([]BodyMindQuestion, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("number of questions must be positive, got %d", n)
+	}
+
+	tx, err := pm.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`
+		SELECT id, question_text, category, status, created_at, used_at
+		FROM body_mind_questions
+		WHERE status = 'active'
+		ORDER BY created_at ASC, id ASC
+		LIMIT ?`, n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query active questions: %w", err)
+	}
+	selected, err := pm.db.scanBodyMindQuestions(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no active questions available in pool")
+	}
+	if len(selected) < n {
+		slog.Warn("Body/mind pool smaller than requested selection", "requested", n, "selected", len(selected))
+	}
+
+	now := time.Now()
+	for i := range selected {
+		if _, err := tx.Exec(
+			"UPDATE body_mind_questions SET status = 'used', used_at = ? WHERE id = ?",
+			now, selected[i].ID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to mark question %d as used: %w", selected[i].ID, err)
+		}
+		selected[i].Status = "used"
+		selected[i].UsedAt = &now
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit question selection: %w", err)
+	}
+
+	pm.checkLowPool()
+
+	return selected, nil
+}
+
 // AddQuestionToPool adds a new anonymous question to the pool
 func (pm *BodyMindPoolManager) AddQuestionToPool(questionText, category string) (*BodyMindQuestion, error) {
 	// Validate category
@@ -209,52 +399,49 @@ type UsageStats struct {
 	MostUsedCategory        string  `json:"most_used_category"`
 }
 
-// getRecentActivity retrieves recent activity for the pool status
+// How far back, and how many events, the pool status reports as recent activity
+const (
+	recentActivityWindow = 30 * 24 * time.Hour
+	recentActivityLimit  = 10
+)
+
+// getRecentActivity returns the latest additions to and uses of the pool, newest first.
+// A question that was added and then used shows up once for each.
 func (pm *BodyMindPoolManager) getRecentActivity() ([]RecentActivityItem, error) {
-	// Query for recent additions and usage
+	// datetime() normalizes both CURRENT_TIMESTAMP and driver-written times to UTC text
 	query := `
-		SELECT category, created_at, used_at FROM body_mind_questions 
-		WHERE created_at >= date('now', '-30 days') OR used_at >= date('now', '-30 days')
-		ORDER BY COALESCE(used_at, created_at) DESC
-		LIMIT 10`
+		SELECT category, 'added' AS action, datetime(created_at) AS at
+		FROM body_mind_questions
+		WHERE datetime(created_at) >= datetime(?)
+		UNION ALL
+		SELECT category, 'used' AS action, datetime(used_at) AS at
+		FROM body_mind_questions
+		WHERE used_at IS NOT NULL AND datetime(used_at) >= datetime(?)
+		ORDER BY at DESC
+		LIMIT ?`
 
-	rows, err := pm.db.Query(query)
+	now := time.Now()
+	since := now.Add(-recentActivityWindow).UTC().Format(sqliteTimestampLayout)
+	rows, err := pm.db.Query(query, since, since, recentActivityLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query recent activity: %w", err)
 	}
 	defer rows.Close()
 
-	var activity []RecentActivityItem
-	now := time.Now()
-
+	activity := []RecentActivityItem{}
 	for rows.Next() {
-		var category string
-		var createdAt time.Time
-		var usedAt *time.Time
-
-		err := rows.Scan(&category, &createdAt, &usedAt)
-		if err != nil {
+		var item RecentActivityItem
+		var at string
+		if err := rows.Scan(&item.Category, &item.Action, &at); err != nil {
 			return nil, fmt.Errorf("failed to scan activity row: %w", err)
 		}
 
-		// Determine if this was a recent usage or addition
-		if usedAt != nil {
-			// This question was used recently
-			daysAgo := int(now.Sub(*usedAt).Hours() / 24)
-			activity = append(activity, RecentActivityItem{
-				Category: category,
-				DaysAgo:  daysAgo,
-				Action:   "used",
-			})
-		} else {
-			// This question was added recently
-			daysAgo := int(now.Sub(createdAt).Hours() / 24)
-			activity = append(activity, RecentActivityItem{
-				Category: category,
-				DaysAgo:  daysAgo,
-				Action:   "added",
-			})
+		happenedAt, err := time.ParseInLocation(sqliteTimestampLayout, at, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse activity time %q: %w", at, err)
 		}
+		item.DaysAgo = int(now.Sub(happenedAt).Hours() / 24)
+		activity = append(activity, item)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -317,9 +504,17 @@ func (pm *BodyMindPoolManager) FormatPoolStatusForSlack(status *PoolStatus) stri
 
 	message += fmt.Sprintf("*Available Questions:* %d\n", status.TotalActive)
 
-	for category, count := range status.CategoryBreakdown {
+	for _, category := range bodyMindCategories {
+		count, exists := status.CategoryBreakdown[category]
+		if !exists {
+			continue
+		}
 		categoryDisplay := formatCategoryName(category)
-		message += fmt.Sprintf("└─ %s: %d questions\n", categoryDisplay, count)
+		message += fmt.Sprintf("└─ %s: %d questions", categoryDisplay, count)
+		if _, low := status.CategoryWarnings[category]; low {
+			message += " ⚠️"
+		}
+		message += "\n"
 	}
 
 	if len(status.RecentActivity) > 0 {
@@ -343,6 +538,25 @@ func (pm *BodyMindPoolManager) FormatPoolStatusForSlack(status *PoolStatus) stri
 	return message
 }
 
+// FormatCategoryStatusForSlack formats a single category's pool level for Slack display
+func (pm *BodyMindPoolManager) FormatCategoryStatusForSlack(category string, activeCount int, oldestAge time.Duration) string {
+	message := fmt.Sprintf("📊 *Body/Mind Pool: %s*\n\n", formatCategoryName(category))
+	message += fmt.Sprintf("*Available Questions:* %d\n", activeCount)
+	if activeCount > 0 {
+		message += fmt.Sprintf("*Oldest Question Added:* %s\n", formatDaysAgo(int(oldestAge.Hours()/24)))
+	}
+
+	if activeCount == 0 {
+		message += fmt.Sprintf("\n⚠️ %s category empty — broadcast targeted request", category)
+	} else if activeCount < lowCategoryThreshold {
+		message += fmt.Sprintf("\n⚠️ %s category low (%d left) — consider targeted request", category, activeCount)
+	} else {
+		message += "\n✅ Category levels healthy."
+	}
+
+	return message
+}
+
 // Helper functions
 
 func formatCategoryName(category string) string {
@@ -1,7 +1,10 @@
 package database
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -383,3 +386,473 @@ func TestHelperFunctions(t *testing.T) {
 		}
 	})
 }
+
+// TDD: Test a skewed pool recommends a targeted request for the starved category
+func TestPoolStatusCategoryWarnings(t *testing.T) {
+	db, err := NewSimple(t.TempDir() + "/pool.db")
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	pm := NewBodyMindPoolManager(db)
+
+	// Plenty of wellness questions, one mental health, no work-life balance
+	for i := 0; i < 9; i++ {
+		if _, err := db.CreateBodyMindQuestion("How do you stay active?", "wellness"); err != nil {
+			t.Fatalf("Failed to create question: %v", err)
+		}
+	}
+	if _, err := db.CreateBodyMindQuestion("How do you handle stress?", "mental_health"); err != nil {
+		t.Fatalf("Failed to create question: %v", err)
+	}
+
+	status, err := pm.GetPoolStatus()
+	if err != nil {
+		t.Fatalf("Failed to get pool status: %v", err)
+	}
+
+	if status.LowPoolWarning {
+		t.Error("Expected no overall low pool warning with 10 questions")
+	}
+	if !strings.Contains(status.RecommendedAction, "work_life_balance category empty — broadcast targeted request") {
+		t.Errorf("Expected targeted recommendation for empty category, got: %s", status.RecommendedAction)
+	}
+	if !strings.Contains(status.RecommendedAction, "mental_health category low (1 left)") {
+		t.Errorf("Expected low category warning for mental_health, got: %s", status.RecommendedAction)
+	}
+	if strings.Contains(status.RecommendedAction, "healthy") {
+		t.Errorf("Pool should not be reported healthy with an empty category, got: %s", status.RecommendedAction)
+	}
+	if _, warned := status.CategoryWarnings["wellness"]; warned {
+		t.Error("Overstocked wellness category should not be warned about")
+	}
+
+	message := pm.FormatPoolStatusForSlack(status)
+	if !strings.Contains(message, "└─ Work-Life Balance: 0 questions ⚠️") {
+		t.Errorf("Expected flagged work-life balance line, got: %s", message)
+	}
+	if !strings.Contains(message, "└─ Wellness: 9 questions\n") {
+		t.Errorf("Expected unflagged wellness line, got: %s", message)
+	}
+	if !strings.Contains(message, "work_life_balance category empty") {
+		t.Errorf("Expected Slack message to surface category warning, got: %s", message)
+	}
+}
+
+// TDD: Test batch selection marks the oldest questions used in FIFO order
+func TestSelectQuestionsForNewsletter(t *testing.T) {
+	newPool := func(t *testing.T, count int) (*DB, *BodyMindPoolManager) {
+		t.Helper()
+		db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+		if err != nil {
+			t.Fatalf("NewSimple() failed: %v", err)
+		}
+		t.Cleanup(func() { db.Close() })
+		if err := db.Migrate(); err != nil {
+			t.Fatalf("Migrate() failed: %v", err)
+		}
+
+		// Insert newest first so FIFO order can't come from insertion order
+		for i := count; i >= 1; i-- {
+			if _, err := db.Exec(
+				"INSERT INTO body_mind_questions (question_text, category, status, created_at) VALUES (?, 'wellness', 'active', ?)",
+				fmt.Sprintf("Question %d", i), fmt.Sprintf("2025-01-%02d 10:00:00", i),
+			); err != nil {
+				t.Fatalf("Failed to seed question: %v", err)
+			}
+		}
+		return db, NewBodyMindPoolManager(db)
+	}
+
+	t.Run("FewerThanRequested", func(t *testing.T) {
+		_, pm := newPool(t, 3)
+
+		selected, err := pm.SelectQuestionsForNewsletter(5)
+		if err != nil {
+			t.Fatalf("SelectQuestionsForNewsletter() failed: %v", err)
+		}
+		if len(selected) != 3 {
+			t.Fatalf("Expected all 3 questions when pool is smaller than requested, got %d", len(selected))
+		}
+
+		status, err := pm.GetPoolStatus()
+		if err != nil {
+			t.Fatalf("GetPoolStatus() failed: %v", err)
+		}
+		if status.TotalActive != 0 {
+			t.Errorf("Expected empty pool after selection, got %d active", status.TotalActive)
+		}
+
+		if _, err := pm.SelectQuestionsForNewsletter(1); err == nil {
+			t.Error("Expected error selecting from an empty pool")
+		}
+	})
+
+	t.Run("ExactlyAvailableInFIFOOrder", func(t *testing.T) {
+		db, pm := newPool(t, 4)
+
+		selected, err := pm.SelectQuestionsForNewsletter(2)
+		if err != nil {
+			t.Fatalf("SelectQuestionsForNewsletter() failed: %v", err)
+		}
+		if len(selected) != 2 {
+			t.Fatalf("Expected 2 questions, got %d", len(selected))
+		}
+		if selected[0].QuestionText != "Question 1" || selected[1].QuestionText != "Question 2" {
+			t.Errorf("Expected oldest questions first, got %q and %q", selected[0].QuestionText, selected[1].QuestionText)
+		}
+		for _, q := range selected {
+			if q.Status != "used" || q.UsedAt == nil {
+				t.Errorf("Expected question %d to be marked used, got status %s", q.ID, q.Status)
+			}
+		}
+
+		status, err := pm.GetPoolStatus()
+		if err != nil {
+			t.Fatalf("GetPoolStatus() failed: %v", err)
+		}
+		if status.TotalActive != 2 {
+			t.Errorf("Expected 2 active questions left, got %d", status.TotalActive)
+		}
+
+		selected, err = pm.SelectQuestionsForNewsletter(2)
+		if err != nil {
+			t.Fatalf("SelectQuestionsForNewsletter() failed: %v", err)
+		}
+		if len(selected) != 2 || selected[0].QuestionText != "Question 3" || selected[1].QuestionText != "Question 4" {
+			t.Errorf("Expected the remaining questions in FIFO order, got %+v", selected)
+		}
+
+		active, err := db.GetActiveBodyMindQuestions()
+		if err != nil {
+			t.Fatalf("GetActiveBodyMindQuestions() failed: %v", err)
+		}
+		if len(active) != 0 {
+			t.Errorf("Expected no active questions left, got %d", len(active))
+		}
+	})
+}
+
+func TestLowPoolBroadcast(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	pm := NewBodyMindPoolManager(db)
+	for i := 1; i <= 6; i++ {
+		if _, err := pm.AddQuestionToPool(fmt.Sprintf("Wellness question %d?", i), "wellness"); err != nil {
+			t.Fatalf("Failed to add question: %v", err)
+		}
+	}
+
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, db.Location())
+	broadcasts := 0
+	pm.EnableLowPoolBroadcast(5, func(ctx context.Context) error {
+		broadcasts++
+		return nil
+	}, func() time.Time { return now })
+
+	// 6 -> 5 active: still at the threshold
+	if _, err := pm.SelectQuestionForNewsletter(); err != nil {
+		t.Fatalf("SelectQuestionForNewsletter() failed: %v", err)
+	}
+	if broadcasts != 0 {
+		t.Fatalf("Expected no broadcast at the threshold, got %d", broadcasts)
+	}
+
+	// 5 -> 4 active: below the threshold
+	if _, err := pm.SelectQuestionForNewsletter(); err != nil {
+		t.Fatalf("SelectQuestionForNewsletter() failed: %v", err)
+	}
+	if broadcasts != 1 {
+		t.Fatalf("Expected one broadcast once the pool dropped below threshold, got %d", broadcasts)
+	}
+
+	// Draining further the same day must not broadcast again
+	now = now.Add(8 * time.Hour)
+	if _, err := pm.SelectQuestionsForNewsletter(2); err != nil {
+		t.Fatalf("SelectQuestionsForNewsletter() failed: %v", err)
+	}
+	if broadcasts != 1 {
+		t.Errorf("Expected no second broadcast on the same day, got %d", broadcasts)
+	}
+
+	// Next day the reminder goes out again
+	now = now.Add(24 * time.Hour)
+	if _, err := pm.SelectQuestionForNewsletter(); err != nil {
+		t.Fatalf("SelectQuestionForNewsletter() failed: %v", err)
+	}
+	if broadcasts != 2 {
+		t.Errorf("Expected a new broadcast the next day, got %d", broadcasts)
+	}
+}
+
+func TestLowPoolBroadcastRetriesAfterFailure(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	pm := NewBodyMindPoolManager(db)
+	for i := 1; i <= 3; i++ {
+		if _, err := pm.AddQuestionToPool(fmt.Sprintf("Wellness question %d?", i), "wellness"); err != nil {
+			t.Fatalf("Failed to add question: %v", err)
+		}
+	}
+
+	attempts := 0
+	pm.EnableLowPoolBroadcast(5, func(ctx context.Context) error {
+		attempts++
+		if attempts == 1 {
+			return fmt.Errorf("slack unavailable")
+		}
+		return nil
+	}, time.Now)
+
+	// A failed broadcast must not fail the selection, nor count towards the daily limit
+	for i := 0; i < 3; i++ {
+		if _, err := pm.SelectQuestionForNewsletter(); err != nil {
+			t.Fatalf("SelectQuestionForNewsletter() failed: %v", err)
+		}
+	}
+	if attempts != 2 {
+		t.Errorf("Expected a retry after the failed broadcast and then no more, got %d attempts", attempts)
+	}
+}
+
+func TestArchiveUsedQuestionsOlderThan(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	create := func(text string) int {
+		t.Helper()
+		id, err := db.CreateBodyMindQuestion(text, "wellness")
+		if err != nil {
+			t.Fatalf("CreateBodyMindQuestion() failed: %v", err)
+		}
+		return id
+	}
+	oldUsed := create("Used long ago")
+	recentUsed := create("Used last week")
+	active := create("Never used")
+
+	// used_at is written the same way question selection writes it
+	if _, err := db.Exec("UPDATE body_mind_questions SET status = 'used', used_at = ? WHERE id = ?",
+		time.Now().AddDate(0, 0, -100), oldUsed); err != nil {
+		t.Fatalf("Failed to mark old question used: %v", err)
+	}
+	if err := db.MarkBodyMindQuestionUsed(recentUsed); err != nil {
+		t.Fatalf("MarkBodyMindQuestionUsed() failed: %v", err)
+	}
+
+	archived, err := db.ArchiveUsedQuestionsOlderThan(90 * 24 * time.Hour)
+	if err != nil {
+		t.Fatalf("ArchiveUsedQuestionsOlderThan() failed: %v", err)
+	}
+	if archived != 1 {
+		t.Errorf("Expected 1 archived question, got %d", archived)
+	}
+
+	statuses := make(map[int]string)
+	rows, err := db.Query("SELECT id, status FROM body_mind_questions")
+	if err != nil {
+		t.Fatalf("Failed to query statuses: %v", err)
+	}
+	for rows.Next() {
+		var id int
+		var status string
+		if err := rows.Scan(&id, &status); err != nil {
+			t.Fatalf("Failed to scan status: %v", err)
+		}
+		statuses[id] = status
+	}
+	rows.Close()
+
+	expected := map[int]string{oldUsed: "archived", recentUsed: "used", active: "active"}
+	for id, status := range expected {
+		if statuses[id] != status {
+			t.Errorf("Expected question %d to be %s, got %s", id, status, statuses[id])
+		}
+	}
+
+	// Archived questions never come back into the pool
+	activeQuestions, err := db.GetActiveBodyMindQuestions()
+	if err != nil {
+		t.Fatalf("GetActiveBodyMindQuestions() failed: %v", err)
+	}
+	if len(activeQuestions) != 1 || activeQuestions[0].ID != active {
+		t.Errorf("Expected only the unused question to be active, got %+v", activeQuestions)
+	}
+	selected, err := NewBodyMindPoolManager(db).SelectQuestionsForNewsletter(3)
+	if err != nil {
+		t.Fatalf("SelectQuestionsForNewsletter() failed: %v", err)
+	}
+	if len(selected) != 1 || selected[0].ID != active {
+		t.Errorf("Expected selection to skip archived questions, got %+v", selected)
+	}
+
+	// Running again finds nothing new to archive
+	if archived, err := db.ArchiveUsedQuestionsOlderThan(90 * 24 * time.Hour); err != nil || archived != 0 {
+		t.Errorf("Expected second run to archive nothing, got %d (err: %v)", archived, err)
+	}
+}
+
+func TestGetRecentActivity(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	now := time.Now()
+	daysAgo := func(days int) time.Time { return now.Add(-time.Duration(days)*24*time.Hour - time.Hour) }
+
+	// Backdate events by writing the timestamps directly, as the archive tests do
+	create := func(category string, addedDaysAgo int, usedDaysAgo int) {
+		t.Helper()
+		id, err := db.CreateBodyMindQuestion("Question added "+category, category)
+		if err != nil {
+			t.Fatalf("CreateBodyMindQuestion() failed: %v", err)
+		}
+		if _, err := db.Exec("UPDATE body_mind_questions SET created_at = ? WHERE id = ?", daysAgo(addedDaysAgo), id); err != nil {
+			t.Fatalf("Failed to backdate question: %v", err)
+		}
+		if usedDaysAgo >= 0 {
+			if _, err := db.Exec("UPDATE body_mind_questions SET status = 'used', used_at = ? WHERE id = ?", daysAgo(usedDaysAgo), id); err != nil {
+				t.Fatalf("Failed to mark question used: %v", err)
+			}
+		}
+	}
+	create("wellness", 0, -1)          // added today
+	create("mental_health", 40, 1)     // added long ago, used yesterday
+	create("work_life_balance", 2, -1) // added two days ago
+	create("wellness", 5, 3)           // added and used within the window
+	create("mental_health", 60, 45)    // nothing recent
+
+	pm := NewBodyMindPoolManager(db)
+	activity, err := pm.getRecentActivity()
+	if err != nil {
+		t.Fatalf("getRecentActivity() failed: %v", err)
+	}
+
+	expected := []RecentActivityItem{
+		{Category: "wellness", DaysAgo: 0, Action: "added"},
+		{Category: "mental_health", DaysAgo: 1, Action: "used"},
+		{Category: "work_life_balance", DaysAgo: 2, Action: "added"},
+		{Category: "wellness", DaysAgo: 3, Action: "used"},
+		{Category: "wellness", DaysAgo: 5, Action: "added"},
+	}
+	if len(activity) != len(expected) {
+		t.Fatalf("Expected %d activity items, got %d: %+v", len(expected), len(activity), activity)
+	}
+	for i := range expected {
+		if activity[i] != expected[i] {
+			t.Errorf("Item %d: expected %+v, got %+v", i, expected[i], activity[i])
+		}
+	}
+
+	// Only the latest events are reported
+	for i := 0; i < recentActivityLimit; i++ {
+		create("wellness", 0, -1)
+	}
+	activity, err = pm.getRecentActivity()
+	if err != nil {
+		t.Fatalf("getRecentActivity() failed: %v", err)
+	}
+	if len(activity) != recentActivityLimit {
+		t.Errorf("Expected activity capped at %d items, got %d", recentActivityLimit, len(activity))
+	}
+
+	status, err := pm.GetPoolStatus()
+	if err != nil {
+		t.Fatalf("GetPoolStatus() failed: %v", err)
+	}
+	if len(status.RecentActivity) != recentActivityLimit {
+		t.Errorf("Expected pool status to carry the recent activity, got %d items", len(status.RecentActivity))
+	}
+}
+
+// TDD: Per-category status counts only that category's active questions and ages its oldest one
+func TestGetPoolStatusForCategory(t *testing.T) {
+	db, err := NewSimple(t.TempDir() + "/pool.db")
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	pm := NewBodyMindPoolManager(db)
+
+	// Two wellness questions, the first added three days ago, and one mental health question
+	oldID, err := db.CreateBodyMindQuestion("How do you stay active?", "wellness")
+	if err != nil {
+		t.Fatalf("Failed to create question: %v", err)
+	}
+	if _, err := db.Exec("UPDATE body_mind_questions SET created_at = ? WHERE id = ?", time.Now().AddDate(0, 0, -3), oldID); err != nil {
+		t.Fatalf("Failed to backdate question: %v", err)
+	}
+	for _, question := range []struct{ text, category string }{
+		{"What do you eat for breakfast?", "wellness"},
+		{"How do you handle stress?", "mental_health"},
+	} {
+		if _, err := db.CreateBodyMindQuestion(question.text, question.category); err != nil {
+			t.Fatalf("Failed to create question: %v", err)
+		}
+	}
+
+	tests := []struct {
+		category string
+		count    int
+		minAge   time.Duration
+		maxAge   time.Duration
+	}{
+		{"wellness", 2, 71 * time.Hour, 73 * time.Hour},
+		{"mental_health", 1, 0, time.Hour},
+		{"work_life_balance", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.category, func(t *testing.T) {
+			count, age, err := pm.GetPoolStatusForCategory(tt.category)
+			if err != nil {
+				t.Fatalf("GetPoolStatusForCategory failed: %v", err)
+			}
+			if count != tt.count {
+				t.Errorf("Expected %d active questions, got %d", tt.count, count)
+			}
+			if age < tt.minAge || age > tt.maxAge {
+				t.Errorf("Expected oldest age between %v and %v, got %v", tt.minAge, tt.maxAge, age)
+			}
+		})
+	}
+
+	t.Run("InvalidCategory", func(t *testing.T) {
+		if _, _, err := pm.GetPoolStatusForCategory("nutrition"); err == nil || !strings.Contains(err.Error(), "invalid category") {
+			t.Errorf("Expected an invalid category error, got: %v", err)
+		}
+	})
+}
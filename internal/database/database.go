@@ -1,10 +1,12 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -13,6 +15,9 @@ import (
 // DB wraps the SQL database connection
 type DB struct {
 	*sql.DB
+	location        *time.Location      // Time zone used for publication dates
+	assignmentSlots map[ContentType]int // Max assignments per content type in one issue; unlimited when absent
+	txMu            sync.Mutex          // Serializes WithTx so check-then-write transactions can't interleave
 }
 
 // Config holds database configuration
@@ -56,7 +61,7 @@ func New(cfg Config) (*DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &DB{db}, nil
+	return &DB{DB: db, location: time.UTC}, nil
 }
 
 // NewSimple creates a database connection with default config
@@ -69,72 +74,134 @@ func (db *DB) Close() error {
 	return db.DB.Close()
 }
 
-// GetUnderlyingDB returns the *database.DB itself
-func (db *DB) GetUnderlyingDB() *DB {
-	return db
+// SetLocation sets the time zone used to calculate and present publication dates
+func (db *DB) SetLocation(loc *time.Location) {
+	db.location = loc
 }
 
-// Migrate runs database migrations
-func (db *DB) Migrate() error {
-	// Create schema_migrations table if it doesn't exist
-	migrationTableSQL := `
-	CREATE TABLE IF NOT EXISTS schema_migrations (
-		version INTEGER PRIMARY KEY,
-		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-	)`
+// Location returns the publication time zone, defaulting to UTC
+func (db *DB) Location() *time.Location {
+	if db.location == nil {
+		return time.UTC
+	}
+	return db.location
+}
 
-	if _, err := db.Exec(migrationTableSQL); err != nil {
-		return fmt.Errorf("failed to create migrations table: %w", err)
+// SetAssignmentSlots limits how many assignments of each content type one issue can have.
+// Content types without an entry are unlimited.
+func (db *DB) SetAssignmentSlots(slots map[ContentType]int) {
+	db.assignmentSlots = slots
+}
+
+// AssignmentSlots returns a copy of the per-issue slot limits by content type
+func (db *DB) AssignmentSlots() map[ContentType]int {
+	slots := make(map[ContentType]int, len(db.assignmentSlots))
+	for contentType, limit := range db.assignmentSlots {
+		slots[contentType] = limit
 	}
+	return slots
+}
 
-	// For now, we'll run our initial migration directly
-	// In a real system, you'd read migration files from disk
-	initialMigration := `
-	-- Create questions table
-	CREATE TABLE IF NOT EXISTS questions (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		text TEXT NOT NULL,
-		category TEXT NOT NULL DEFAULT 'general',
-		last_used_at DATETIME,
-		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-	);
-
-	-- Create submissions table  
-	CREATE TABLE IF NOT EXISTS submissions (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		user_id TEXT NOT NULL,
-		question_id INTEGER NOT NULL,
-		content TEXT NOT NULL,
-		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		FOREIGN KEY (question_id) REFERENCES questions(id)
-	);
-
-	-- Create newsletter_issues table
-	CREATE TABLE IF NOT EXISTS newsletter_issues (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		title TEXT NOT NULL,
-		content TEXT NOT NULL,
-		published_at DATETIME,
-		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-	);`
-
-	if _, err := db.Exec(initialMigration); err != nil {
-		return fmt.Errorf("failed to run initial migration: %w", err)
+// WithTx runs fn in a transaction, committing when it returns nil and rolling back when it
+// returns an error or panics. Transactions started through WithTx are also serialized within
+// the process, so a check made inside fn still holds when fn writes. That lock is in-process
+// only: invariants that must also hold against other processes need a constraint, like the
+// unique indexes on person_assignments(issue_id, person_id) and newsletter_issues(week_number, year).
+//
+// The lock is not reentrant, so fn must not call WithTx, directly or through another DB method
+// that uses it; a nested call deadlocks.
+func (db *DB) WithTx(fn func(*sql.Tx) error) error {
+	db.txMu.Lock()
+	defer db.txMu.Unlock()
+
+	return runTx(context.Background(), db.DB, fn)
+}
+
+// runTx runs fn in a transaction on sqlDB, committing when it returns nil and rolling back when
+// it returns an error or panics. Unlike WithTx it takes no lock, for callers that only hold a *sql.DB.
+func runTx(ctx context.Context, sqlDB *sql.DB, fn func(*sql.Tx) error) error {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	// Mark migration as applied
-	if _, err := db.Exec("INSERT OR IGNORE INTO schema_migrations (version) VALUES (1)"); err != nil {
-		return fmt.Errorf("failed to record migration: %w", err)
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
 	}
 
-	// Run migration 2: Make question_id nullable
-	var hasNullableMigration int
-	if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations WHERE version = 2").Scan(&hasNullableMigration); err != nil {
-		return fmt.Errorf("failed to check migration 2: %w", err)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	return nil
+}
+
+// GetUnderlyingDB returns the *database.DB itself
+func (db *DB) GetUnderlyingDB() *DB {
+	return db
+}
+
+// migration is a single schema change, applied at most once and recorded in schema_migrations
+type migration struct {
+	version int
+	// addColumns are applied before sql and skipped when the column already exists,
+	// since SQLite has no ADD COLUMN IF NOT EXISTS
+	addColumns []columnAddition
+	sql        string
+}
+
+// columnAddition describes an ALTER TABLE ... ADD COLUMN statement
+type columnAddition struct {
+	table      string
+	column     string
+	definition string
+}
 
-	if hasNullableMigration == 0 {
-		nullableMigration := `
+// migrations lists every schema change in the order it must be applied
+var migrations = []migration{
+	{
+		version: 1,
+		sql: `
+		-- Create questions table
+		CREATE TABLE IF NOT EXISTS questions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			text TEXT NOT NULL,
+			category TEXT NOT NULL DEFAULT 'general',
+			last_used_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		-- Create submissions table  
+		CREATE TABLE IF NOT EXISTS submissions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id TEXT NOT NULL,
+			question_id INTEGER NOT NULL,
+			content TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (question_id) REFERENCES questions(id)
+		);
+
+		-- Create newsletter_issues table
+		CREATE TABLE IF NOT EXISTS newsletter_issues (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			content TEXT NOT NULL,
+			published_at DATETIME,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	},
+	{
+		version: 2,
+		sql: `
 		-- Make question_id nullable to support general news submissions
 		-- SQLite doesn't support ALTER COLUMN directly, so we need to recreate the table
 
@@ -154,26 +221,11 @@ func (db *DB) Migrate() error {
 
 		-- Drop old table and rename new one
 		DROP TABLE submissions;
-		ALTER TABLE submissions_new RENAME TO submissions;`
-
-		if _, err := db.Exec(nullableMigration); err != nil {
-			return fmt.Errorf("failed to run migration 2: %w", err)
-		}
-
-		// Mark migration as applied
-		if _, err := db.Exec("INSERT INTO schema_migrations (version) VALUES (2)"); err != nil {
-			return fmt.Errorf("failed to record migration 2: %w", err)
-		}
-	}
-
-	// Run migration 3: Add processed_articles table for AI-generated content
-	var hasProcessedArticlesMigration int
-	if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations WHERE version = 3").Scan(&hasProcessedArticlesMigration); err != nil {
-		return fmt.Errorf("failed to check migration 3: %w", err)
-	}
-
-	if hasProcessedArticlesMigration == 0 {
-		processedArticlesMigration := `
+		ALTER TABLE submissions_new RENAME TO submissions;`,
+	},
+	{
+		version: 3,
+		sql: `
 		-- Migration 3: Add processed_articles table for AI-generated content
 		CREATE TABLE IF NOT EXISTS processed_articles (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -206,33 +258,19 @@ func (db *DB) Migrate() error {
 		-- Indexes for common query patterns
 		CREATE INDEX IF NOT EXISTS idx_processed_articles_submission_id ON processed_articles(submission_id);
 		CREATE INDEX IF NOT EXISTS idx_processed_articles_status ON processed_articles(processing_status);
-		CREATE INDEX IF NOT EXISTS idx_processed_articles_newsletter_issue ON processed_articles(newsletter_issue_id);`
-
-		if _, err := db.Exec(processedArticlesMigration); err != nil {
-			return fmt.Errorf("failed to run migration 3: %w", err)
-		}
-
-		// Mark migration as applied
-		if _, err := db.Exec("INSERT INTO schema_migrations (version) VALUES (3)"); err != nil {
-			return fmt.Errorf("failed to record migration 3: %w", err)
-		}
-	}
-
-	// Run migration 4: Add weekly newsletter automation tables
-	var hasWeeklyAutomationMigration int
-	if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations WHERE version = 4").Scan(&hasWeeklyAutomationMigration); err != nil {
-		return fmt.Errorf("failed to check migration 4: %w", err)
-	}
-
-	if hasWeeklyAutomationMigration == 0 {
-		weeklyAutomationMigration := `
+		CREATE INDEX IF NOT EXISTS idx_processed_articles_newsletter_issue ON processed_articles(newsletter_issue_id);`,
+	},
+	{
+		version: 4,
+		// Enhance newsletter_issues table for weekly automation
+		addColumns: []columnAddition{
+			{"newsletter_issues", "week_number", "INTEGER"},
+			{"newsletter_issues", "year", "INTEGER"},
+			{"newsletter_issues", "status", "TEXT NOT NULL DEFAULT 'draft'"},
+			{"newsletter_issues", "publication_date", "DATETIME"},
+		},
+		sql: `
 		-- Migration 4: Add weekly newsletter automation tables
-		
-		-- Enhance newsletter_issues table for weekly automation
-		ALTER TABLE newsletter_issues ADD COLUMN week_number INTEGER;
-		ALTER TABLE newsletter_issues ADD COLUMN year INTEGER;
-		ALTER TABLE newsletter_issues ADD COLUMN status TEXT NOT NULL DEFAULT 'draft';
-		ALTER TABLE newsletter_issues ADD COLUMN publication_date DATETIME;
 
 		-- Create person_assignments table for weekly content assignments
 		CREATE TABLE IF NOT EXISTS person_assignments (
@@ -277,21 +315,267 @@ func (db *DB) Migrate() error {
 		CREATE INDEX IF NOT EXISTS idx_person_assignments_person_id ON person_assignments(person_id);
 		CREATE INDEX IF NOT EXISTS idx_body_mind_questions_status ON body_mind_questions(status);
 		CREATE INDEX IF NOT EXISTS idx_person_rotation_history_person_type ON person_rotation_history(person_id, content_type);
-		CREATE INDEX IF NOT EXISTS idx_person_rotation_history_week_year ON person_rotation_history(week_number, year);`
+		CREATE INDEX IF NOT EXISTS idx_person_rotation_history_week_year ON person_rotation_history(week_number, year);`,
+	},
+	{
+		version: 5,
+		// Add token usage columns for AI cost reporting
+		addColumns: []columnAddition{
+			{"processed_articles", "prompt_tokens", "INTEGER NOT NULL DEFAULT 0"},
+			{"processed_articles", "completion_tokens", "INTEGER NOT NULL DEFAULT 0"},
+		},
+	},
+	{
+		version: 6,
+		// Keep earlier article versions around when a submission is re-processed
+		addColumns: []columnAddition{
+			{"processed_articles", "superseded_at", "DATETIME"},
+		},
+	},
+	{
+		version: 7,
+		sql: `
+		-- Migration 7: Runtime overrides for the built-in journalist profiles
+		CREATE TABLE IF NOT EXISTS journalist_profiles (
+			journalist_type TEXT PRIMARY KEY,
+			system_prompt TEXT NOT NULL,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	},
+	{
+		version: 8,
+		// Moderation flags for submissions held back from AI processing
+		addColumns: []columnAddition{
+			{"submissions", "review_status", "TEXT"},
+			{"submissions", "review_reason", "TEXT"},
+		},
+	},
+	{
+		version: 9,
+		// Anonymous submissions promoted into the body/mind question pool aren't offered again
+		addColumns: []columnAddition{
+			{"submissions", "promoted_at", "DATETIME"},
+		},
+	},
+	{
+		version: 10,
+		sql: `
+		-- Migration 10: Dead letters for submissions whose AI processing failed for good
+		CREATE TABLE IF NOT EXISTS failed_processing (
+			submission_id INTEGER PRIMARY KEY REFERENCES submissions(id),
+			last_error TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 1,
+			failed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	},
+	{
+		version: 11,
+		// Deleted submissions are kept for audit and hidden from listings
+		addColumns: []columnAddition{
+			{"submissions", "deleted_at", "DATETIME"},
+		},
+	},
+	{
+		version: 12,
+		// Set when the assignee acknowledges their assignment DM
+		addColumns: []columnAddition{
+			{"person_assignments", "accepted_at", "DATETIME"},
+		},
+	},
+	{
+		version: 13,
+		sql: `
+		-- Migration 13: One assignment per person and issue, enforced by SQLite rather than only by
+		-- the check in CreatePersonAssignment. Duplicates left by earlier races keep the row that has
+		-- a submission linked, or else the oldest.
+		DELETE FROM person_assignments
+		WHERE id NOT IN (
+			SELECT COALESCE(MIN(CASE WHEN submission_id IS NOT NULL THEN id END), MIN(id))
+			FROM person_assignments
+			GROUP BY issue_id, person_id
+		);
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_person_assignments_issue_person
+			ON person_assignments(issue_id, person_id);`,
+	},
+	{
+		version: 14,
+		sql: `
+		-- Migration 14: Admin roles granted at runtime by super admins, on top of the configured lists
+		CREATE TABLE IF NOT EXISTS admin_roles (
+			user_id TEXT PRIMARY KEY,
+			role TEXT NOT NULL CHECK (role IN ('super', 'editor')),
+			granted_by TEXT NOT NULL,
+			granted_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	},
+	{
+		version: 15,
+		// Optional theme for an issue, such as "Summer", shown in the masthead and intro
+		addColumns: []columnAddition{
+			{"newsletter_issues", "theme", "TEXT NOT NULL DEFAULT ''"},
+		},
+	},
+	{
+		version: 16,
+		sql: `
+		-- Migration 16: One issue per week, enforced by SQLite so concurrent GetOrCreateWeeklyIssue
+		-- calls can't both insert. Duplicates left by earlier races are merged into the oldest issue
+		-- of their week; an assignment that would clash with one already on that issue is dropped.
+		-- The plain lookup index from migration 4 is replaced by a unique one of the same name.
+		UPDATE processed_articles
+		SET newsletter_issue_id = (
+			SELECT MIN(k.id) FROM newsletter_issues k, newsletter_issues d
+			WHERE d.id = processed_articles.newsletter_issue_id
+				AND k.week_number = d.week_number AND k.year = d.year
+		)
+		WHERE newsletter_issue_id IN (SELECT id FROM newsletter_issues);
+		UPDATE OR IGNORE person_assignments
+		SET issue_id = (
+			SELECT MIN(k.id) FROM newsletter_issues k, newsletter_issues d
+			WHERE d.id = person_assignments.issue_id
+				AND k.week_number = d.week_number AND k.year = d.year
+		)
+		WHERE issue_id IN (SELECT id FROM newsletter_issues);
+		DELETE FROM person_assignments
+		WHERE issue_id IN (SELECT id FROM newsletter_issues)
+			AND issue_id NOT IN (SELECT MIN(id) FROM newsletter_issues GROUP BY week_number, year);
+		DELETE FROM newsletter_issues
+		WHERE id NOT IN (SELECT MIN(id) FROM newsletter_issues GROUP BY week_number, year);
+		DROP INDEX IF EXISTS idx_newsletter_issues_week_year;
+		CREATE UNIQUE INDEX idx_newsletter_issues_week_year ON newsletter_issues(week_number, year);`,
+	},
+	{
+		version: 17,
+		sql: `
+		-- Migration 17: Earlier content of edited submissions, so an edit never loses the original
+		CREATE TABLE IF NOT EXISTS submission_revisions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			submission_id INTEGER NOT NULL,
+			content TEXT NOT NULL,
+			revised_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (submission_id) REFERENCES submissions(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_submission_revisions_submission ON submission_revisions(submission_id);`,
+	},
+	{
+		version: 18,
+		sql: `
+		-- Migration 18: Slack files shared along with a submission, such as a screenshot for a feature story
+		CREATE TABLE IF NOT EXISTS submission_files (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			submission_id INTEGER NOT NULL,
+			file_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			mime TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (submission_id) REFERENCES submissions(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_submission_files_submission ON submission_files(submission_id);`,
+	},
+	{
+		version: 19,
+		// Set when an admin deletes a submission's article, so process-backlog leaves it alone
+		addColumns: []columnAddition{
+			{"submissions", "article_deleted_at", "DATETIME"},
+		},
+	},
+}
+
+// Migrate runs database migrations
+func (db *DB) Migrate() error {
+	// Create schema_migrations table if it doesn't exist
+	migrationTableSQL := `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	if _, err := db.Exec(migrationTableSQL); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
 
-		if _, err := db.Exec(weeklyAutomationMigration); err != nil {
-			return fmt.Errorf("failed to run migration 4: %w", err)
+	for _, m := range migrations {
+		var applied int
+		if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations WHERE version = ?", m.version).Scan(&applied); err != nil {
+			return fmt.Errorf("failed to check migration %d: %w", m.version, err)
+		}
+		if applied > 0 {
+			continue
 		}
 
-		// Mark migration as applied
-		if _, err := db.Exec("INSERT INTO schema_migrations (version) VALUES (4)"); err != nil {
-			return fmt.Errorf("failed to record migration 4: %w", err)
+		if err := db.applyMigration(m); err != nil {
+			return fmt.Errorf("failed to run migration %d: %w", m.version, err)
 		}
 	}
 
 	return nil
 }
 
+// applyMigration runs a single migration and records it in one transaction,
+// so a failure leaves neither a half-applied schema nor a recorded version
+func (db *DB) applyMigration(m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, col := range m.addColumns {
+		exists, err := columnExists(tx, col.table, col.column)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		stmt := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", col.table, col.column, col.definition)
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", col.table, col.column, err)
+		}
+	}
+
+	if m.sql != "" {
+		if _, err := tx.Exec(m.sql); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", m.version); err != nil {
+		return fmt.Errorf("failed to record migration: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// columnExists reports whether a table already has a column, using PRAGMA table_info
+func columnExists(tx *sql.Tx, table, column string) (bool, error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect table %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			columnType string
+			notNull    int
+			defaultVal sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultVal, &primaryKey); err != nil {
+			return false, fmt.Errorf("failed to scan table info for %s: %w", table, err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+
+	return false, rows.Err()
+}
+
 // CreateNewsSubmission creates a news submission without a specific question
 func (db *DB) CreateNewsSubmission(userID, content string) (int, error) {
 	result, err := db.Exec(
@@ -352,7 +636,7 @@ func (db *DB) GetSubmission(id int) (*Submission, error) {
 	var questionID sql.NullInt64
 
 	err := db.QueryRow(
-		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE id = ?",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE id = ? AND deleted_at IS NULL",
 		id,
 	).Scan(&submission.ID, &submission.UserID, &questionID, &submission.Content, &submission.CreatedAt)
 
@@ -377,7 +661,7 @@ func (db *DB) GetSubmission(id int) (*Submission, error) {
 // ListSubmissions retrieves all submissions
 func (db *DB) ListSubmissions() ([]*Submission, error) {
 	rows, err := db.Query(
-		"SELECT id, user_id, question_id, content, created_at FROM submissions ORDER BY created_at DESC",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE deleted_at IS NULL ORDER BY created_at DESC",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list submissions: %w", err)
@@ -412,21 +696,56 @@ func (db *DB) ListSubmissions() ([]*Submission, error) {
 	return submissions, nil
 }
 
-// DeleteSubmission deletes a submission by ID
+// DeleteSubmission soft-deletes a submission by ID, keeping the row for audit.
+// Use DeleteSubmissionCascade to remove it for good.
 func (db *DB) DeleteSubmission(id int) error {
-	result, err := db.Exec("DELETE FROM submissions WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("failed to delete submission: %w", err)
-	}
+	return db.WithTx(func(tx *sql.Tx) error {
+		return softDeleteSubmission(context.Background(), tx, id)
+	})
+}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
+// DeleteSubmissionCascade permanently deletes a submission, soft-deleted or not, together with its
+// processed articles, dead letter and edit history, and unlinks any person assignments pointing at it, all within a single transaction
+func (db *DB) DeleteSubmissionCascade(id int) error {
+	return db.WithTx(func(tx *sql.Tx) error {
+		// Remove AI-generated articles so they no longer show up in newsletter rendering
+		if _, err := tx.Exec("DELETE FROM processed_articles WHERE submission_id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete processed articles: %w", err)
+		}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("submission not found")
-	}
+		// A deleted submission can't be reprocessed, so its dead letter goes too
+		if _, err := tx.Exec("DELETE FROM failed_processing WHERE submission_id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete dead letter: %w", err)
+		}
 
-	return nil
+		// Keep the assignment itself, but mark it as no longer submitted
+		if _, err := tx.Exec("UPDATE person_assignments SET submission_id = NULL WHERE submission_id = ?", id); err != nil {
+			return fmt.Errorf("failed to unlink person assignments: %w", err)
+		}
+
+		// Earlier contents go with the submission, so nothing it said is left behind
+		if _, err := tx.Exec("DELETE FROM submission_revisions WHERE submission_id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete submission revisions: %w", err)
+		}
+
+		if _, err := tx.Exec("DELETE FROM submission_files WHERE submission_id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete submission files: %w", err)
+		}
+
+		result, err := tx.Exec("DELETE FROM submissions WHERE id = ?", id)
+		if err != nil {
+			return fmt.Errorf("failed to delete submission: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("submission not found")
+		}
+
+		return nil
+	})
 }
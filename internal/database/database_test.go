@@ -1,6 +1,8 @@
 package database
 
 import (
+	"database/sql"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -93,6 +95,61 @@ func TestMigrate(t *testing.T) {
 	}
 }
 
+// TDD: Test migrations tolerate a partially-migrated database and gaps in recorded versions
+func TestMigratePartiallyMigratedDatabase(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	// Simulate a crash during migration 4: some columns were added but the version was never recorded
+	setup := []string{
+		`CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY, applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+		`CREATE TABLE newsletter_issues (id INTEGER PRIMARY KEY AUTOINCREMENT, title TEXT NOT NULL, content TEXT NOT NULL, published_at DATETIME, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+		`ALTER TABLE newsletter_issues ADD COLUMN week_number INTEGER`,
+		`ALTER TABLE newsletter_issues ADD COLUMN year INTEGER`,
+	}
+	for _, stmt := range setup {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Setup failed on %q: %v", stmt, err)
+		}
+	}
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() on partially-migrated DB failed: %v", err)
+	}
+
+	for _, column := range []string{"week_number", "year", "status", "publication_date"} {
+		var count int
+		if err := db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('newsletter_issues') WHERE name = ?", column).Scan(&count); err != nil {
+			t.Fatalf("Failed to inspect newsletter_issues: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("Expected newsletter_issues.%s to exist once, found %d", column, count)
+		}
+	}
+
+	// Forget a version in the middle; re-running must re-apply it without duplicate column errors
+	if _, err := db.Exec("DELETE FROM schema_migrations WHERE version IN (4, 5)"); err != nil {
+		t.Fatalf("Failed to remove recorded migrations: %v", err)
+	}
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() with a version gap failed: %v", err)
+	}
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Repeated Migrate() failed: %v", err)
+	}
+
+	var versions int
+	if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&versions); err != nil {
+		t.Fatalf("Failed to count migrations: %v", err)
+	}
+	if versions != len(migrations) {
+		t.Errorf("Expected %d recorded migrations, got %d", len(migrations), versions)
+	}
+}
+
 func TestSubmissionCRUD(t *testing.T) {
 	tempDir := t.TempDir()
 	dbPath := filepath.Join(tempDir, "test.db")
@@ -215,3 +272,44 @@ func TestCreateNewsSubmissionWithoutQuestion(t *testing.T) {
 		t.Errorf("Expected QuestionID to be nil for news submission, got %v", *retrieved.QuestionID)
 	}
 }
+
+// TDD: WithTx commits on success and rolls back when fn returns an error
+func TestWithTx(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	insert := func(tx *sql.Tx, content string) error {
+		_, err := tx.Exec("INSERT INTO submissions (user_id, content) VALUES (?, ?)", "U123", content)
+		return err
+	}
+
+	if err := db.WithTx(func(tx *sql.Tx) error { return insert(tx, "committed") }); err != nil {
+		t.Fatalf("WithTx() failed: %v", err)
+	}
+
+	errBoom := errors.New("boom")
+	err = db.WithTx(func(tx *sql.Tx) error {
+		if err := insert(tx, "rolled back"); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Expected fn error to be returned, got %v", err)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM submissions").Scan(&count); err != nil {
+		t.Fatalf("Failed to count submissions: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected only the committed submission, got %d rows", count)
+	}
+}
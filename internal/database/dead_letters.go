@@ -0,0 +1,61 @@
+package database
+
+import (
+	"fmt"
+)
+
+// RecordDeadLetter records that processing a submission failed after its retries were
+// exhausted, with the number of AI requests made. A submission failing again keeps one row
+// with the attempts of every run added up.
+func (db *DB) RecordDeadLetter(submissionID int, lastError string, attempts int) error {
+	query := `
+		INSERT INTO failed_processing (submission_id, last_error, attempts, failed_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(submission_id) DO UPDATE SET
+			last_error = excluded.last_error,
+			attempts = failed_processing.attempts + excluded.attempts,
+			failed_at = excluded.failed_at`
+
+	if _, err := db.Exec(query, submissionID, lastError, attempts); err != nil {
+		return fmt.Errorf("failed to record dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeadLetters returns every dead-lettered submission, most recent failure first
+func (db *DB) GetDeadLetters() ([]DeadLetter, error) {
+	query := `
+		SELECT submission_id, last_error, attempts, failed_at
+		FROM failed_processing
+		ORDER BY datetime(failed_at) DESC, submission_id DESC`
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	var deadLetters []DeadLetter
+	for rows.Next() {
+		var dl DeadLetter
+		if err := rows.Scan(&dl.SubmissionID, &dl.LastError, &dl.Attempts, &dl.FailedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan dead letter: %w", err)
+		}
+		deadLetters = append(deadLetters, dl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating dead letters: %w", err)
+	}
+
+	return deadLetters, nil
+}
+
+// DeleteDeadLetter removes a submission's dead letter once it has been processed successfully.
+// Submissions without one are left alone.
+func (db *DB) DeleteDeadLetter(submissionID int) error {
+	if _, err := db.Exec("DELETE FROM failed_processing WHERE submission_id = ?", submissionID); err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeadLetters(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	ctx := context.Background()
+	sm := NewSubmissionManager(db.DB)
+	first, err := sm.CreateNewsSubmission(ctx, "U123", "Our team shipped the new dashboard")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	second, err := sm.CreateNewsSubmission(ctx, "U456", "The coffee machine finally works again")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	if deadLetters, err := db.GetDeadLetters(); err != nil || len(deadLetters) != 0 {
+		t.Fatalf("Expected no dead letters initially, got %v (err %v)", deadLetters, err)
+	}
+
+	// Failing again keeps one row, adding up the attempts and keeping the latest error
+	if err := db.RecordDeadLetter(first.ID, "rate limited", 3); err != nil {
+		t.Fatalf("RecordDeadLetter() failed: %v", err)
+	}
+	if err := db.RecordDeadLetter(first.ID, "invalid response", 1); err != nil {
+		t.Fatalf("RecordDeadLetter() failed: %v", err)
+	}
+	if err := db.RecordDeadLetter(second.ID, "timed out", 2); err != nil {
+		t.Fatalf("RecordDeadLetter() failed: %v", err)
+	}
+
+	deadLetters, err := db.GetDeadLetters()
+	if err != nil {
+		t.Fatalf("GetDeadLetters() failed: %v", err)
+	}
+	if len(deadLetters) != 2 {
+		t.Fatalf("Expected 2 dead letters, got %d", len(deadLetters))
+	}
+	byID := make(map[int]DeadLetter)
+	for _, dl := range deadLetters {
+		byID[dl.SubmissionID] = dl
+	}
+	if got := byID[first.ID]; got.Attempts != 4 || got.LastError != "invalid response" || got.FailedAt.IsZero() {
+		t.Errorf("Expected 4 attempts with the latest error, got %+v", got)
+	}
+	if got := byID[second.ID]; got.Attempts != 2 || got.LastError != "timed out" {
+		t.Errorf("Expected 2 attempts, got %+v", got)
+	}
+
+	if err := db.DeleteDeadLetter(first.ID); err != nil {
+		t.Fatalf("DeleteDeadLetter() failed: %v", err)
+	}
+	if err := db.DeleteDeadLetter(first.ID); err != nil {
+		t.Errorf("Expected deleting a missing dead letter to be a no-op, got %v", err)
+	}
+
+	// Deleting the submission takes its dead letter with it
+	if err := db.DeleteSubmissionCascade(second.ID); err != nil {
+		t.Fatalf("DeleteSubmissionCascade() failed: %v", err)
+	}
+	if deadLetters, err := db.GetDeadLetters(); err != nil || len(deadLetters) != 0 {
+		t.Errorf("Expected no dead letters left, got %v (err %v)", deadLetters, err)
+	}
+}
@@ -0,0 +1,188 @@
+package database
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// issueCSVHeader lists the columns produced by ExportIssueCSV
+var issueCSVHeader = []string{
+	"submission_id",
+	"person_id",
+	"content_type",
+	"journalist_type",
+	"headline",
+	"word_count",
+	"processing_status",
+}
+
+// ExportIssueCSV produces a CSV overview of the current processed articles in a newsletter issue
+func (db *DB) ExportIssueCSV(issueID int) ([]byte, error) {
+	if _, err := db.GetWeeklyNewsletterIssue(issueID); err != nil {
+		return nil, fmt.Errorf("failed to get newsletter issue: %w", err)
+	}
+
+	// Versions replaced after an edit or rerun are history, not part of the issue
+	articles, err := db.GetCurrentProcessedArticlesByNewsletterIssue(issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get articles for issue: %w", err)
+	}
+
+	// Order rows by submission so repeated exports are stable
+	sort.SliceStable(articles, func(i, j int) bool {
+		return articles[i].SubmissionID < articles[j].SubmissionID
+	})
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(issueCSVHeader); err != nil {
+		return nil, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, article := range articles {
+		personID, contentType := db.exportAttribution(article.SubmissionID)
+
+		// Articles without parseable JSON (e.g. failed processing) get an empty headline
+		headline, err := article.GetHeadline()
+		if err != nil {
+			headline = ""
+		}
+
+		record := []string{
+			strconv.Itoa(article.SubmissionID),
+			personID,
+			contentType,
+			article.JournalistType,
+			headline,
+			strconv.Itoa(article.WordCount),
+			article.ProcessingStatus,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write CSV row for submission %d: %w", article.SubmissionID, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush CSV: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// issueJSONExport is the document produced by ExportIssueJSON
+type issueJSONExport struct {
+	Issue    issueJSONMetadata   `json:"issue"`
+	Articles []articleJSONExport `json:"articles"`
+}
+
+// issueJSONMetadata describes the exported newsletter issue
+type issueJSONMetadata struct {
+	ID              int                   `json:"id"`
+	WeekNumber      int                   `json:"week_number"`
+	Year            int                   `json:"year"`
+	Title           string                `json:"title"`
+	Theme           string                `json:"theme,omitempty"`
+	Status          NewsletterIssueStatus `json:"status"`
+	PublicationDate time.Time             `json:"publication_date"`
+	PublishedAt     *time.Time            `json:"published_at,omitempty"`
+}
+
+// articleJSONExport is one article, with its content as parsed JSON rather than an embedded string
+type articleJSONExport struct {
+	SubmissionID   int                    `json:"submission_id"`
+	PersonID       string                 `json:"person_id,omitempty"`
+	ContentType    string                 `json:"content_type,omitempty"`
+	JournalistType string                 `json:"journalist_type"`
+	TemplateFormat string                 `json:"template_format"`
+	Content        map[string]interface{} `json:"content"`
+	Byline         string                 `json:"byline"`
+	WordCount      int                    `json:"word_count"`
+}
+
+// ExportIssueJSON produces a machine-readable export of a newsletter issue and its current
+// articles. Only articles with parseable content are included, since there is nothing to render
+// for the others (e.g. failed processing).
+func (db *DB) ExportIssueJSON(issueID int) ([]byte, error) {
+	issue, err := db.GetWeeklyNewsletterIssue(issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter issue: %w", err)
+	}
+
+	// Versions replaced after an edit or rerun are history, not part of the issue
+	articles, err := db.GetCurrentProcessedArticlesByNewsletterIssue(issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get articles for issue: %w", err)
+	}
+
+	// Order articles by submission so repeated exports are stable
+	sort.SliceStable(articles, func(i, j int) bool {
+		return articles[i].SubmissionID < articles[j].SubmissionID
+	})
+
+	export := issueJSONExport{
+		Issue: issueJSONMetadata{
+			ID:              issue.ID,
+			WeekNumber:      issue.WeekNumber,
+			Year:            issue.Year,
+			Title:           issue.Title,
+			Theme:           issue.Theme,
+			Status:          issue.Status,
+			PublicationDate: issue.PublicationDate,
+			PublishedAt:     issue.PublishedAt,
+		},
+		Articles: []articleJSONExport{},
+	}
+
+	for i := range articles {
+		article := &articles[i]
+		content, err := article.cachedJSONContent()
+		if err != nil {
+			continue
+		}
+
+		personID, contentType := db.exportAttribution(article.SubmissionID)
+		byline, _ := article.GetByline() // Empty when the journalist left it out
+
+		export.Articles = append(export.Articles, articleJSONExport{
+			SubmissionID:   article.SubmissionID,
+			PersonID:       personID,
+			ContentType:    contentType,
+			JournalistType: article.JournalistType,
+			TemplateFormat: article.TemplateFormat,
+			Content:        content,
+			Byline:         byline,
+			WordCount:      article.WordCount,
+		})
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode issue export: %w", err)
+	}
+	return data, nil
+}
+
+// exportAttribution resolves who wrote a submission and which content type it was assigned as.
+// Submissions without an assignment fall back to the submitter with no content type. Body/mind
+// pieces are published anonymously, so their author is never exported.
+func (db *DB) exportAttribution(submissionID int) (string, string) {
+	if assignment, err := db.GetAssignmentBySubmissionID(submissionID); err == nil {
+		if assignment.ContentType == ContentTypeBodyMind {
+			return "", string(assignment.ContentType)
+		}
+		return assignment.PersonID, string(assignment.ContentType)
+	}
+
+	if submission, err := db.GetSubmission(submissionID); err == nil {
+		return submission.UserID, ""
+	}
+
+	return "", ""
+}
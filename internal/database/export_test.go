@@ -0,0 +1,258 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TDD: Test CSV export of a newsletter issue
+func TestExportIssueCSV(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(40, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create weekly issue: %v", err)
+	}
+
+	// Assigned feature submission with a headline containing a comma and newline
+	featureID, err := db.CreateNewsSubmission("U100USER1", "We finally launched the app")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	assignmentID, err := db.CreatePersonAssignment(PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U100USER1",
+		ContentType: ContentTypeFeature,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := db.LinkSubmissionToAssignment(assignmentID, featureID); err != nil {
+		t.Fatalf("Failed to link submission: %v", err)
+	}
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      featureID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "feature",
+		ProcessedContent:  `{"headline": "Launch, finally\nshipped", "content": "Body", "byline": "Koco Kai"}`,
+		TemplateFormat:    "hero",
+		ProcessingStatus:  ProcessingStatusSuccess,
+		WordCount:         120,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	// Unassigned submission whose processing failed - no parseable JSON
+	failedID, err := db.CreateNewsSubmission("U200USER2", "Quick update")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      failedID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "general",
+		ProcessedContent:  "not json",
+		TemplateFormat:    "column",
+		ProcessingStatus:  ProcessingStatusFailed,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	// A version replaced by an edit is kept for history but left out of the export
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      featureID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "feature",
+		ProcessedContent:  `{"headline": "Launch", "content": "Old body", "byline": "Koco Kai"}`,
+		TemplateFormat:    "hero",
+		ProcessingStatus:  ProcessingStatusSuperseded,
+		WordCount:         90,
+	}); err != nil {
+		t.Fatalf("Failed to create superseded article: %v", err)
+	}
+
+	csvBytes, err := db.ExportIssueCSV(issue.ID)
+	if err != nil {
+		t.Fatalf("ExportIssueCSV() failed: %v", err)
+	}
+
+	expected := "submission_id,person_id,content_type,journalist_type,headline,word_count,processing_status\n" +
+		fmt.Sprintf("%d,U100USER1,feature,feature,\"Launch, finally\nshipped\",120,success\n", featureID) +
+		fmt.Sprintf("%d,U200USER2,,general,,0,failed\n", failedID)
+
+	if string(csvBytes) != expected {
+		t.Errorf("Unexpected CSV output.\nExpected:\n%q\nGot:\n%q", expected, string(csvBytes))
+	}
+
+	// Unknown issue returns an error
+	if _, err := db.ExportIssueCSV(9999); err == nil {
+		t.Error("Expected error for non-existent issue")
+	}
+}
+
+// TDD: JSON export carries issue metadata and each article's content as parsed JSON
+func TestExportIssueJSON(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(41, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create weekly issue: %v", err)
+	}
+
+	// Assigned interview, whose questions must stay an array
+	interviewID, err := db.CreateNewsSubmission("U100USER1", "Ask me anything")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	assignmentID, err := db.CreatePersonAssignment(PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U100USER1",
+		ContentType: ContentTypeInterview,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := db.LinkSubmissionToAssignment(assignmentID, interviewID); err != nil {
+		t.Fatalf("Failed to link submission: %v", err)
+	}
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      interviewID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "interview",
+		ProcessedContent:  `{"headline": "Meet Ada", "introduction": "Say hi", "questions": [{"q": "Coffee or tea?", "a": "Tea"}, {"q": "Favourite tool?", "a": "Vim"}], "byline": "Interview Desk"}`,
+		TemplateFormat:    "interview",
+		ProcessingStatus:  ProcessingStatusSuccess,
+		WordCount:         40,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	// Body/mind answers are anonymous, so the assigned person must not be exported
+	bodyMindID, err := db.CreateNewsSubmission("U300USER3", "Walk at lunch")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	bodyMindAssignmentID, err := db.CreatePersonAssignment(PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U300USER3",
+		ContentType: ContentTypeBodyMind,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := db.LinkSubmissionToAssignment(bodyMindAssignmentID, bodyMindID); err != nil {
+		t.Fatalf("Failed to link submission: %v", err)
+	}
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      bodyMindID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "body_mind",
+		ProcessedContent:  `{"headline": "Walk it off", "body": "Short walks help", "byline": "Wellness Desk"}`,
+		TemplateFormat:    "wellness",
+		ProcessingStatus:  ProcessingStatusSuccess,
+		WordCount:         20,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	// Failed processing has nothing to render and is left out
+	failedID, err := db.CreateNewsSubmission("U200USER2", "Quick update")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      failedID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "general",
+		ProcessedContent:  "not json",
+		TemplateFormat:    "column",
+		ProcessingStatus:  ProcessingStatusFailed,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	data, err := db.ExportIssueJSON(issue.ID)
+	if err != nil {
+		t.Fatalf("ExportIssueJSON() failed: %v", err)
+	}
+
+	var export struct {
+		Issue struct {
+			ID         int    `json:"id"`
+			WeekNumber int    `json:"week_number"`
+			Year       int    `json:"year"`
+			Status     string `json:"status"`
+		} `json:"issue"`
+		Articles []struct {
+			SubmissionID   int                    `json:"submission_id"`
+			PersonID       string                 `json:"person_id"`
+			ContentType    string                 `json:"content_type"`
+			TemplateFormat string                 `json:"template_format"`
+			Content        map[string]interface{} `json:"content"`
+			Byline         string                 `json:"byline"`
+			WordCount      int                    `json:"word_count"`
+		} `json:"articles"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil {
+		t.Fatalf("Export is not valid JSON: %v\n%s", err, data)
+	}
+
+	if export.Issue.ID != issue.ID || export.Issue.WeekNumber != 41 || export.Issue.Year != 2025 || export.Issue.Status != string(issue.Status) {
+		t.Errorf("Unexpected issue metadata: %+v", export.Issue)
+	}
+
+	if len(export.Articles) != 2 {
+		t.Fatalf("Expected only the parseable articles, got %d:\n%s", len(export.Articles), data)
+	}
+	if bodyMind := export.Articles[1]; bodyMind.SubmissionID != bodyMindID || bodyMind.PersonID != "" || bodyMind.ContentType != "body_mind" {
+		t.Errorf("Expected the body/mind article without a person, got: %+v", bodyMind)
+	}
+	if strings.Contains(string(data), "U300USER3") {
+		t.Errorf("Export leaks the body/mind author:\n%s", data)
+	}
+	article := export.Articles[0]
+	if article.SubmissionID != interviewID || article.PersonID != "U100USER1" || article.ContentType != "interview" {
+		t.Errorf("Unexpected article attribution: %+v", article)
+	}
+	if article.TemplateFormat != "interview" || article.Byline != "Interview Desk" || article.WordCount != 40 {
+		t.Errorf("Unexpected article fields: %+v", article)
+	}
+	if article.Content["headline"] != "Meet Ada" {
+		t.Errorf("Expected the content to be parsed, got: %v", article.Content)
+	}
+
+	questions, ok := article.Content["questions"].([]interface{})
+	if !ok || len(questions) != 2 {
+		t.Fatalf("Expected interview questions as an array of 2, got: %#v", article.Content["questions"])
+	}
+	if first, ok := questions[0].(map[string]interface{}); !ok || first["q"] != "Coffee or tea?" || first["a"] != "Tea" {
+		t.Errorf("Unexpected first question: %#v", questions[0])
+	}
+
+	// Unknown issue returns an error
+	if _, err := db.ExportIssueJSON(9999); err == nil {
+		t.Error("Expected error for non-existent issue")
+	}
+}
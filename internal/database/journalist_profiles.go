@@ -0,0 +1,60 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// GetJournalistPromptOverride returns the stored system prompt override for a journalist type,
+// reporting false when the built-in prompt should be used
+func (db *DB) GetJournalistPromptOverride(journalistType string) (string, bool, error) {
+	var systemPrompt string
+	err := db.QueryRow(
+		"SELECT system_prompt FROM journalist_profiles WHERE journalist_type = ?",
+		journalistType,
+	).Scan(&systemPrompt)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get journalist prompt override: %w", err)
+	}
+
+	return systemPrompt, true, nil
+}
+
+// SetJournalistPromptOverride creates or replaces the system prompt override for a journalist type
+func (db *DB) SetJournalistPromptOverride(journalistType, systemPrompt string) error {
+	if systemPrompt == "" {
+		return fmt.Errorf("system prompt cannot be empty")
+	}
+
+	query := `
+		INSERT INTO journalist_profiles (journalist_type, system_prompt, updated_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(journalist_type) DO UPDATE SET
+			system_prompt = excluded.system_prompt,
+			updated_at = excluded.updated_at`
+
+	if _, err := db.Exec(query, journalistType, systemPrompt); err != nil {
+		return fmt.Errorf("failed to set journalist prompt override: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteJournalistPromptOverride removes the system prompt override for a journalist type so the
+// built-in prompt is used again, reporting false when there was no override to remove
+func (db *DB) DeleteJournalistPromptOverride(journalistType string) (bool, error) {
+	result, err := db.Exec("DELETE FROM journalist_profiles WHERE journalist_type = ?", journalistType)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete journalist prompt override: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestJournalistPromptOverride(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	if _, found, err := db.GetJournalistPromptOverride("feature"); err != nil || found {
+		t.Fatalf("Expected no override initially, found=%v err=%v", found, err)
+	}
+
+	if err := db.SetJournalistPromptOverride("feature", "First"); err != nil {
+		t.Fatalf("SetJournalistPromptOverride() failed: %v", err)
+	}
+	if err := db.SetJournalistPromptOverride("feature", "Second"); err != nil {
+		t.Fatalf("SetJournalistPromptOverride() upsert failed: %v", err)
+	}
+
+	prompt, found, err := db.GetJournalistPromptOverride("feature")
+	if err != nil || !found {
+		t.Fatalf("Expected override, found=%v err=%v", found, err)
+	}
+	if prompt != "Second" {
+		t.Errorf("Expected upserted prompt 'Second', got %q", prompt)
+	}
+
+	if err := db.SetJournalistPromptOverride("feature", ""); err == nil {
+		t.Error("Expected empty prompt to be rejected")
+	}
+
+	removed, err := db.DeleteJournalistPromptOverride("feature")
+	if err != nil || !removed {
+		t.Fatalf("Expected override to be removed, removed=%v err=%v", removed, err)
+	}
+	if _, found, _ := db.GetJournalistPromptOverride("feature"); found {
+		t.Error("Expected no override after delete")
+	}
+
+	removed, err = db.DeleteJournalistPromptOverride("feature")
+	if err != nil || removed {
+		t.Errorf("Expected nothing to remove the second time, removed=%v err=%v", removed, err)
+	}
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -13,6 +15,32 @@ type Submission struct {
 	QuestionID *int      `json:"question_id,omitempty"` // Nullable for general news submissions
 	Content    string    `json:"content"`
 	CreatedAt  time.Time `json:"created_at"`
+
+	// Duplicate is set when CreateNewsSubmission returned an existing identical submission
+	Duplicate bool `json:"-"`
+}
+
+// SubmissionRevision is the content a submission had before an edit replaced it
+type SubmissionRevision struct {
+	ID           int       `json:"id"`
+	SubmissionID int       `json:"submission_id"`
+	Content      string    `json:"content"`
+	RevisedAt    time.Time `json:"revised_at"` // When the edit replaced this content
+}
+
+// SubmissionFile is a Slack file shared along with a submission
+type SubmissionFile struct {
+	ID           int       `json:"id"`
+	SubmissionID int       `json:"submission_id"`
+	FileID       string    `json:"file_id"` // Slack's file ID
+	URL          string    `json:"url"`
+	MimeType     string    `json:"mime"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// IsImage reports whether the file can be shown inline as an image
+func (f SubmissionFile) IsImage() bool {
+	return strings.HasPrefix(f.MimeType, "image/")
 }
 
 // Question represents a prompt question for newsletter submissions
@@ -24,6 +52,13 @@ type Question struct {
 	CreatedAt  time.Time  `json:"created_at"`
 }
 
+// QuestionUsageStat reports how often a question has been answered
+type QuestionUsageStat struct {
+	Question   Question   `json:"question"`
+	UseCount   int        `json:"use_count"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
 // NewsletterIssue represents a generated newsletter
 type NewsletterIssue struct {
 	ID          int        `json:"id"`
@@ -33,6 +68,9 @@ type NewsletterIssue struct {
 	CreatedAt   time.Time  `json:"created_at"`
 }
 
+// SubmissionReviewNeedsReview marks a submission held back from AI processing until an admin reviews it
+const SubmissionReviewNeedsReview = "needs_review"
+
 // ProcessingStatus constants for processed articles
 const (
 	ProcessingStatusPending    = "pending"
@@ -40,6 +78,7 @@ const (
 	ProcessingStatusSuccess    = "success"
 	ProcessingStatusFailed     = "failed"
 	ProcessingStatusRetry      = "retry"
+	ProcessingStatusSuperseded = "superseded" // Replaced by a newer version after an edit or rerun
 )
 
 // ValidProcessingStatuses map for validation
@@ -49,6 +88,7 @@ var ValidProcessingStatuses = map[string]bool{
 	ProcessingStatusSuccess:    true,
 	ProcessingStatusFailed:     true,
 	ProcessingStatusRetry:      true,
+	ProcessingStatusSuperseded: true,
 }
 
 // ProcessedArticle represents an AI-processed article from a submission
@@ -71,9 +111,30 @@ type ProcessedArticle struct {
 	RetryCount       int     `json:"retry_count"`
 
 	// Metadata
-	WordCount   int        `json:"word_count"`
-	ProcessedAt *time.Time `json:"processed_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
+	WordCount    int        `json:"word_count"`
+	ProcessedAt  *time.Time `json:"processed_at,omitempty"`
+	SupersededAt *time.Time `json:"superseded_at,omitempty"` // When a newer version replaced this article; IsSuperseded tells whether it was
+	CreatedAt    time.Time  `json:"created_at"`
+
+	// AI token usage for cost tracking
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+
+	// Parsed ProcessedContent, filled lazily by the JSON accessors
+	parsed *parsedContent
+}
+
+// TokenUsage aggregates AI token consumption for a newsletter issue
+type TokenUsage struct {
+	IssueID          int `json:"issue_id"`
+	ArticleCount     int `json:"article_count"`
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// TotalTokens returns prompt and completion tokens combined
+func (tu *TokenUsage) TotalTokens() int {
+	return tu.PromptTokens + tu.CompletionTokens
 }
 
 // Validate checks if the ProcessedArticle has valid data
@@ -107,6 +168,12 @@ type InterviewQuestion struct {
 	Answer   string `json:"a"`
 }
 
+// IsSuperseded reports whether a newer version has replaced this article. The processing status is
+// the source of truth; SupersededAt is missing on articles superseded before it was recorded.
+func (pa *ProcessedArticle) IsSuperseded() bool {
+	return pa.ProcessingStatus == ProcessingStatusSuperseded
+}
+
 // ParseJSONContent parses the processed content as JSON
 func (pa *ProcessedArticle) ParseJSONContent() (map[string]interface{}, error) {
 	var content map[string]interface{}
@@ -116,9 +183,41 @@ func (pa *ProcessedArticle) ParseJSONContent() (map[string]interface{}, error) {
 	return content, nil
 }
 
+// parsedContent caches the result of parsing one version of ProcessedContent
+type parsedContent struct {
+	source  string
+	content map[string]interface{}
+	err     error
+}
+
+// parsedContentMu guards the parsed field of every ProcessedArticle
+var parsedContentMu sync.RWMutex
+
+// cachedJSONContent returns the parsed JSON content, only unmarshalling again when
+// ProcessedContent has changed since the last call. The returned map is shared and
+// must not be modified; use ParseJSONContent for a private copy.
+func (pa *ProcessedArticle) cachedJSONContent() (map[string]interface{}, error) {
+	parsedContentMu.RLock()
+	cached := pa.parsed
+	parsedContentMu.RUnlock()
+
+	if cached != nil && cached.source == pa.ProcessedContent {
+		return cached.content, cached.err
+	}
+
+	content, err := pa.ParseJSONContent()
+	cached = &parsedContent{source: pa.ProcessedContent, content: content, err: err}
+
+	parsedContentMu.Lock()
+	pa.parsed = cached
+	parsedContentMu.Unlock()
+
+	return content, err
+}
+
 // GetHeadline extracts the headline from JSON content
 func (pa *ProcessedArticle) GetHeadline() (string, error) {
-	content, err := pa.ParseJSONContent()
+	content, err := pa.cachedJSONContent()
 	if err != nil {
 		return "", err
 	}
@@ -132,7 +231,7 @@ func (pa *ProcessedArticle) GetHeadline() (string, error) {
 
 // GetByline extracts the byline from JSON content
 func (pa *ProcessedArticle) GetByline() (string, error) {
-	content, err := pa.ParseJSONContent()
+	content, err := pa.cachedJSONContent()
 	if err != nil {
 		return "", err
 	}
@@ -146,7 +245,7 @@ func (pa *ProcessedArticle) GetByline() (string, error) {
 
 // ParseInterviewQuestions extracts Q&A pairs from interview articles
 func (pa *ProcessedArticle) ParseInterviewQuestions() ([]InterviewQuestion, error) {
-	content, err := pa.ParseJSONContent()
+	content, err := pa.cachedJSONContent()
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +294,7 @@ func (pa *ProcessedArticle) ValidateJSONContent() error {
 	}
 
 	// Validate JSON can be parsed
-	content, err := pa.ParseJSONContent()
+	content, err := pa.cachedJSONContent()
 	if err != nil {
 		return fmt.Errorf("invalid JSON content: %w", err)
 	}
@@ -258,16 +357,18 @@ var ValidIssueStatuses = map[NewsletterIssueStatus]bool{
 type ContentType string
 
 const (
-	ContentTypeFeature  ContentType = "feature"
-	ContentTypeGeneral  ContentType = "general"
-	ContentTypeBodyMind ContentType = "body_mind"
+	ContentTypeFeature   ContentType = "feature"
+	ContentTypeGeneral   ContentType = "general"
+	ContentTypeInterview ContentType = "interview"
+	ContentTypeBodyMind  ContentType = "body_mind"
 )
 
 // ValidContentTypes map for validation
 var ValidContentTypes = map[ContentType]bool{
-	ContentTypeFeature:  true,
-	ContentTypeGeneral:  true,
-	ContentTypeBodyMind: true,
+	ContentTypeFeature:   true,
+	ContentTypeGeneral:   true,
+	ContentTypeInterview: true,
+	ContentTypeBodyMind:  true,
 }
 
 // WeeklyNewsletterIssue represents an enhanced newsletter issue for weekly automation
@@ -277,6 +378,7 @@ type WeeklyNewsletterIssue struct {
 	Year            int                   `json:"year"`
 	Title           string                `json:"title"`
 	Content         string                `json:"content"`
+	Theme           string                `json:"theme,omitempty"` // Empty when the week has no theme
 	Status          NewsletterIssueStatus `json:"status"`
 	PublicationDate time.Time             `json:"publication_date"`
 	PublishedAt     *time.Time            `json:"published_at,omitempty"`
@@ -315,6 +417,22 @@ type PersonRotationHistory struct {
 	CreatedAt   time.Time   `json:"created_at"`
 }
 
+// DeadLetter records a submission whose AI processing failed for good, kept for manual reprocessing
+type DeadLetter struct {
+	SubmissionID int       `json:"submission_id"`
+	LastError    string    `json:"last_error"`
+	Attempts     int       `json:"attempts"`
+	FailedAt     time.Time `json:"failed_at"`
+}
+
+// AdminRoleGrant is an admin role given to a user at runtime, on top of the configured admin and editor lists
+type AdminRoleGrant struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"` // "super" or "editor"
+	GrantedBy string    `json:"granted_by"`
+	GrantedAt time.Time `json:"granted_at"`
+}
+
 // Validate checks if the WeeklyNewsletterIssue has valid data
 func (wni *WeeklyNewsletterIssue) Validate() error {
 	if !ValidIssueStatuses[wni.Status] {
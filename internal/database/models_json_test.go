@@ -202,3 +202,92 @@ func TestProcessedArticle_ValidateJSONContent(t *testing.T) {
 		})
 	}
 }
+
+// TDD: Test cached accessors return the same values as a fresh parse, and notice content changes
+func TestProcessedArticle_CachedAccessorsMatchFreshParse(t *testing.T) {
+	article := &ProcessedArticle{
+		JournalistType:   "interview",
+		ProcessedContent: `{"headline": "Meet the Platform Team", "introduction": "Intro", "questions": [{"q": "Why Go?", "a": "Simplicity."}, {"q": "Favorite tool?", "a": "pprof"}], "byline": "Anna Berg, Interviewer"}`,
+		ProcessingStatus: ProcessingStatusSuccess,
+	}
+	fresh := &ProcessedArticle{ProcessedContent: article.ProcessedContent}
+
+	for i := 0; i < 2; i++ { // second pass is served from the cache
+		headline, err := article.GetHeadline()
+		if err != nil {
+			t.Fatalf("GetHeadline() failed: %v", err)
+		}
+		content, err := fresh.ParseJSONContent()
+		if err != nil {
+			t.Fatalf("ParseJSONContent() failed: %v", err)
+		}
+		if headline != content["headline"] {
+			t.Errorf("Cached headline %q differs from fresh parse %q", headline, content["headline"])
+		}
+
+		byline, err := article.GetByline()
+		if err != nil {
+			t.Fatalf("GetByline() failed: %v", err)
+		}
+		if byline != content["byline"] {
+			t.Errorf("Cached byline %q differs from fresh parse %q", byline, content["byline"])
+		}
+
+		questions, err := article.ParseInterviewQuestions()
+		if err != nil {
+			t.Fatalf("ParseInterviewQuestions() failed: %v", err)
+		}
+		freshQuestions, _ := fresh.ParseInterviewQuestions()
+		if len(questions) != 2 || len(questions) != len(freshQuestions) {
+			t.Fatalf("Expected 2 questions, got %d (fresh %d)", len(questions), len(freshQuestions))
+		}
+		for j := range questions {
+			if questions[j] != freshQuestions[j] {
+				t.Errorf("Question %d differs: %+v vs %+v", j, questions[j], freshQuestions[j])
+			}
+		}
+	}
+
+	// Changing the content must not serve a stale headline
+	article.ProcessedContent = `{"headline": "Updated Headline", "byline": "Anna Berg, Interviewer"}`
+	headline, err := article.GetHeadline()
+	if err != nil {
+		t.Fatalf("GetHeadline() after edit failed: %v", err)
+	}
+	if headline != "Updated Headline" {
+		t.Errorf("Expected updated headline, got %q", headline)
+	}
+
+	// Parse errors are reported consistently too
+	article.ProcessedContent = `not json`
+	if _, err := article.GetHeadline(); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+	if _, err := article.GetByline(); err == nil {
+		t.Error("Expected cached error for invalid JSON")
+	}
+}
+
+func BenchmarkProcessedArticle_Accessors(b *testing.B) {
+	content := `{"headline": "Meet the Platform Team", "introduction": "Intro", "questions": [{"q": "Why Go?", "a": "Simplicity."}, {"q": "Favorite tool?", "a": "pprof"}], "byline": "Anna Berg, Interviewer"}`
+
+	b.Run("cached", func(b *testing.B) {
+		article := &ProcessedArticle{ProcessedContent: content}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			article.GetHeadline()
+			article.GetByline()
+			article.ParseInterviewQuestions()
+		}
+	})
+
+	b.Run("fresh parse", func(b *testing.B) {
+		article := &ProcessedArticle{ProcessedContent: content}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 3; j++ {
+				article.ParseJSONContent()
+			}
+		}
+	})
+}
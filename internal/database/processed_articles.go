@@ -3,6 +3,7 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -26,8 +27,8 @@ func (db *DB) CreateProcessedArticle(article ProcessedArticle) (int, error) {
 		INSERT INTO processed_articles (
 			submission_id, newsletter_issue_id, journalist_type, processed_content, 
 			processing_prompt, template_format, processing_status, error_message, 
-			retry_count, word_count, processed_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			retry_count, word_count, prompt_tokens, completion_tokens, processed_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	result, err := db.Exec(query,
 		article.SubmissionID,
@@ -40,6 +41,8 @@ func (db *DB) CreateProcessedArticle(article ProcessedArticle) (int, error) {
 		article.ErrorMessage,
 		article.RetryCount,
 		article.WordCount,
+		article.PromptTokens,
+		article.CompletionTokens,
 		processedAt,
 	)
 	if err != nil {
@@ -59,7 +62,7 @@ func (db *DB) GetProcessedArticle(id int) (*ProcessedArticle, error) {
 	query := `
 		SELECT id, submission_id, newsletter_issue_id, journalist_type, processed_content,
 			   processing_prompt, template_format, processing_status, error_message,
-			   retry_count, word_count, processed_at, created_at
+			   retry_count, word_count, prompt_tokens, completion_tokens, processed_at, created_at
 		FROM processed_articles 
 		WHERE id = ?`
 
@@ -84,6 +87,8 @@ func (db *DB) GetProcessedArticle(id int) (*ProcessedArticle, error) {
 		&errorMessage,
 		&article.RetryCount,
 		&article.WordCount,
+		&article.PromptTokens,
+		&article.CompletionTokens,
 		&processedAt,
 		&article.CreatedAt,
 	)
@@ -161,7 +166,7 @@ func (db *DB) GetProcessedArticlesByStatus(status string) ([]ProcessedArticle, e
 	query := `
 		SELECT id, submission_id, newsletter_issue_id, journalist_type, processed_content,
 			   processing_prompt, template_format, processing_status, error_message,
-			   retry_count, word_count, processed_at, created_at
+			   retry_count, word_count, prompt_tokens, completion_tokens, processed_at, created_at
 		FROM processed_articles 
 		WHERE processing_status = ?
 		ORDER BY created_at DESC`
@@ -193,6 +198,8 @@ func (db *DB) GetProcessedArticlesByStatus(status string) ([]ProcessedArticle, e
 			&errorMessage,
 			&article.RetryCount,
 			&article.WordCount,
+			&article.PromptTokens,
+			&article.CompletionTokens,
 			&processedAt,
 			&article.CreatedAt,
 		)
@@ -233,7 +240,7 @@ func (db *DB) GetProcessedArticlesBySubmissionID(submissionID int) ([]ProcessedA
 	query := `
 		SELECT id, submission_id, newsletter_issue_id, journalist_type, processed_content,
 			   processing_prompt, template_format, processing_status, error_message,
-			   retry_count, word_count, processed_at, created_at
+			   retry_count, word_count, prompt_tokens, completion_tokens, processed_at, created_at
 		FROM processed_articles 
 		WHERE submission_id = ?
 		ORDER BY created_at DESC`
@@ -265,6 +272,8 @@ func (db *DB) GetProcessedArticlesBySubmissionID(submissionID int) ([]ProcessedA
 			&errorMessage,
 			&article.RetryCount,
 			&article.WordCount,
+			&article.PromptTokens,
+			&article.CompletionTokens,
 			&processedAt,
 			&article.CreatedAt,
 		)
@@ -305,7 +314,7 @@ func (db *DB) GetProcessedArticlesByNewsletterIssue(issueID int) ([]ProcessedArt
 	query := `
 		SELECT id, submission_id, newsletter_issue_id, journalist_type, processed_content,
 			   processing_prompt, template_format, processing_status, error_message,
-			   retry_count, word_count, processed_at, created_at
+			   retry_count, word_count, prompt_tokens, completion_tokens, processed_at, created_at
 		FROM processed_articles 
 		WHERE newsletter_issue_id = ?
 		ORDER BY created_at ASC`
@@ -336,6 +345,8 @@ func (db *DB) GetProcessedArticlesByNewsletterIssue(issueID int) ([]ProcessedArt
 			&errorMessage,
 			&article.RetryCount,
 			&article.WordCount,
+			&article.PromptTokens,
+			&article.CompletionTokens,
 			&processedAt,
 			&article.CreatedAt,
 		)
@@ -367,23 +378,315 @@ func (db *DB) GetProcessedArticlesByNewsletterIssue(issueID int) ([]ProcessedArt
 	return articles, nil
 }
 
-// DeleteProcessedArticle permanently removes a processed article from the database
+// GetCurrentProcessedArticlesByNewsletterIssue retrieves the processed articles of a newsletter issue
+// that have not been superseded, i.e. the ones that belong in the newsletter
+func (db *DB) GetCurrentProcessedArticlesByNewsletterIssue(issueID int) ([]ProcessedArticle, error) {
+	articles, err := db.GetProcessedArticlesByNewsletterIssue(issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := articles[:0]
+	for _, article := range articles {
+		if !article.IsSuperseded() {
+			current = append(current, article)
+		}
+	}
+	return current, nil
+}
+
+// DeleteProcessedArticle permanently removes a processed article from the database. Its submission
+// is marked so process-backlog doesn't write the article again.
 func (db *DB) DeleteProcessedArticle(id int) error {
-	query := `DELETE FROM processed_articles WHERE id = ?`
-	
-	result, err := db.Exec(query, id)
+	return db.WithTx(func(tx *sql.Tx) error {
+		var submissionID int
+		err := tx.QueryRow("SELECT submission_id FROM processed_articles WHERE id = ?", id).Scan(&submissionID)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("processed article with ID %d not found", id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get processed article: %w", err)
+		}
+
+		if _, err := tx.Exec("DELETE FROM processed_articles WHERE id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete processed article: %w", err)
+		}
+
+		if _, err := tx.Exec("UPDATE submissions SET article_deleted_at = ? WHERE id = ?", time.Now(), submissionID); err != nil {
+			return fmt.Errorf("failed to mark submission's article deleted: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetTokenUsageByIssue sums the AI token usage of all processed articles in a newsletter issue
+func (db *DB) GetTokenUsageByIssue(issueID int) (*TokenUsage, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(prompt_tokens), 0), COALESCE(SUM(completion_tokens), 0)
+		FROM processed_articles
+		WHERE newsletter_issue_id = ?`
+
+	usage := &TokenUsage{IssueID: issueID}
+	err := db.QueryRow(query, issueID).Scan(&usage.ArticleCount, &usage.PromptTokens, &usage.CompletionTokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token usage for issue %d: %w", issueID, err)
+	}
+
+	return usage, nil
+}
+
+// supersedeArticlesQuery marks a submission's articles as superseded. The processing status is what
+// every reader checks; superseded_at only records when it happened and is never written on its own.
+const supersedeArticlesQuery = `
+		UPDATE processed_articles
+		SET processing_status = ?, superseded_at = CURRENT_TIMESTAMP
+		WHERE submission_id = ? AND processing_status != ?`
+
+// SupersedeProcessedArticles marks every earlier article for a submission as superseded, e.g. after an edit
+func (db *DB) SupersedeProcessedArticles(submissionID int) (int, error) {
+	result, err := db.Exec(supersedeArticlesQuery, ProcessingStatusSuperseded, submissionID, ProcessingStatusSuperseded)
 	if err != nil {
-		return fmt.Errorf("failed to delete processed article: %w", err)
+		return 0, fmt.Errorf("failed to supersede processed articles: %w", err)
 	}
-	
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
 	}
-	
-	if rowsAffected == 0 {
-		return fmt.Errorf("processed article with ID %d not found", id)
+
+	return int(rowsAffected), nil
+}
+
+// SupersedeEarlierArticleVersions marks every article for a submission except the newest as superseded,
+// e.g. after a rerun has saved a new version
+func (db *DB) SupersedeEarlierArticleVersions(submissionID int) (int, error) {
+	query := supersedeArticlesQuery + `
+		  AND id < (SELECT MAX(id) FROM processed_articles WHERE submission_id = ?)`
+
+	result, err := db.Exec(query, ProcessingStatusSuperseded, submissionID, ProcessingStatusSuperseded, submissionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to supersede earlier article versions: %w", err)
 	}
-	
-	return nil
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// GetArticleVersions retrieves every processed version of a submission, oldest first
+func (db *DB) GetArticleVersions(submissionID int) ([]ProcessedArticle, error) {
+	query := `
+		SELECT id, submission_id, newsletter_issue_id, journalist_type, processed_content,
+			   processing_prompt, template_format, processing_status, error_message,
+			   retry_count, word_count, prompt_tokens, completion_tokens, processed_at,
+			   superseded_at, created_at
+		FROM processed_articles
+		WHERE submission_id = ?
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := db.Query(query, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query article versions: %w", err)
+	}
+	defer rows.Close()
+
+	var articles []ProcessedArticle
+	for rows.Next() {
+		var article ProcessedArticle
+		var newsletterIssueID sql.NullInt64
+		var errorMessage sql.NullString
+		var processedContent sql.NullString
+		var processingPrompt sql.NullString
+		var processedAt sql.NullTime
+		var supersededAt sql.NullTime
+
+		err := rows.Scan(
+			&article.ID,
+			&article.SubmissionID,
+			&newsletterIssueID,
+			&article.JournalistType,
+			&processedContent,
+			&processingPrompt,
+			&article.TemplateFormat,
+			&article.ProcessingStatus,
+			&errorMessage,
+			&article.RetryCount,
+			&article.WordCount,
+			&article.PromptTokens,
+			&article.CompletionTokens,
+			&processedAt,
+			&supersededAt,
+			&article.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article version: %w", err)
+		}
+
+		// Handle nullable fields
+		if newsletterIssueID.Valid {
+			issueID := int(newsletterIssueID.Int64)
+			article.NewsletterIssueID = &issueID
+		}
+		if errorMessage.Valid {
+			article.ErrorMessage = &errorMessage.String
+		}
+		if processedContent.Valid {
+			article.ProcessedContent = processedContent.String
+		}
+		if processingPrompt.Valid {
+			article.ProcessingPrompt = processingPrompt.String
+		}
+		if processedAt.Valid {
+			article.ProcessedAt = &processedAt.Time
+		}
+		if supersededAt.Valid {
+			article.SupersededAt = &supersededAt.Time
+		}
+
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over article versions: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetProcessedArticleWithSubmission retrieves an article together with the submission it was written from in a single query
+func (db *DB) GetProcessedArticleWithSubmission(articleID int) (*ProcessedArticle, *Submission, error) {
+	query := `
+		SELECT pa.id, pa.submission_id, pa.newsletter_issue_id, pa.journalist_type, pa.processed_content,
+			   pa.processing_prompt, pa.template_format, pa.processing_status, pa.error_message,
+			   pa.retry_count, pa.word_count, pa.prompt_tokens, pa.completion_tokens, pa.processed_at,
+			   pa.superseded_at, pa.created_at,
+			   s.id, s.user_id, s.question_id, s.content, s.created_at
+		FROM processed_articles pa
+		JOIN submissions s ON s.id = pa.submission_id
+		WHERE pa.id = ?`
+
+	var article ProcessedArticle
+	var submission Submission
+	var newsletterIssueID sql.NullInt64
+	var errorMessage sql.NullString
+	var processedContent sql.NullString
+	var processingPrompt sql.NullString
+	var processedAt sql.NullTime
+	var supersededAt sql.NullTime
+	var questionID sql.NullInt64
+
+	err := db.QueryRow(query, articleID).Scan(
+		&article.ID,
+		&article.SubmissionID,
+		&newsletterIssueID,
+		&article.JournalistType,
+		&processedContent,
+		&processingPrompt,
+		&article.TemplateFormat,
+		&article.ProcessingStatus,
+		&errorMessage,
+		&article.RetryCount,
+		&article.WordCount,
+		&article.PromptTokens,
+		&article.CompletionTokens,
+		&processedAt,
+		&supersededAt,
+		&article.CreatedAt,
+		&submission.ID,
+		&submission.UserID,
+		&questionID,
+		&submission.Content,
+		&submission.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("processed article with ID %d not found", articleID)
+		}
+		return nil, nil, fmt.Errorf("failed to get processed article with submission: %w", err)
+	}
+
+	// Handle nullable fields
+	if newsletterIssueID.Valid {
+		issueID := int(newsletterIssueID.Int64)
+		article.NewsletterIssueID = &issueID
+	}
+	if errorMessage.Valid {
+		article.ErrorMessage = &errorMessage.String
+	}
+	if processedContent.Valid {
+		article.ProcessedContent = processedContent.String
+	}
+	if processingPrompt.Valid {
+		article.ProcessingPrompt = processingPrompt.String
+	}
+	if processedAt.Valid {
+		article.ProcessedAt = &processedAt.Time
+	}
+	if supersededAt.Valid {
+		article.SupersededAt = &supersededAt.Time
+	}
+	if questionID.Valid {
+		qid := int(questionID.Int64)
+		submission.QuestionID = &qid
+	}
+
+	return &article, &submission, nil
+}
+
+// GetIssueHeadlines returns the headlines of an issue's live articles in the order they were written.
+// Articles that failed, were superseded or have no headline are skipped.
+func (db *DB) GetIssueHeadlines(issueID int) ([]string, error) {
+	articles, err := db.GetCurrentProcessedArticlesByNewsletterIssue(issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var headlines []string
+	for i := range articles {
+		if articles[i].ProcessingStatus != ProcessingStatusSuccess {
+			continue
+		}
+		headline, err := articles[i].GetHeadline()
+		if err != nil || strings.TrimSpace(headline) == "" {
+			continue
+		}
+		headlines = append(headlines, strings.TrimSpace(headline))
+	}
+
+	return headlines, nil
+}
+
+// GetProcessedArticleStats counts an issue's processed articles by processing status in one query.
+// Statuses with no articles are left out of the map.
+func (db *DB) GetProcessedArticleStats(issueID int) (map[string]int, error) {
+	query := `
+		SELECT processing_status, COUNT(*)
+		FROM processed_articles
+		WHERE newsletter_issue_id = ?
+		GROUP BY processing_status`
+
+	rows, err := db.Query(query, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count processed articles: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan processed article stats: %w", err)
+		}
+		stats[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over processed article stats: %w", err)
+	}
+
+	return stats, nil
 }
@@ -1,8 +1,11 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -417,3 +420,438 @@ func TestGetProcessedArticlesBySubmissionID(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTokenUsageByIssue(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(20, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	otherIssue, err := db.CreateWeeklyNewsletterIssue(21, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	articles := []struct {
+		issueID          int
+		promptTokens     int
+		completionTokens int
+	}{
+		{issue.ID, 1000, 250},
+		{issue.ID, 800, 150},
+		{otherIssue.ID, 5000, 5000},
+	}
+
+	for i, a := range articles {
+		submissionID, err := db.CreateNewsSubmission("U123", fmt.Sprintf("Story %d", i))
+		if err != nil {
+			t.Fatalf("Failed to create submission: %v", err)
+		}
+		issueID := a.issueID
+		if _, err := db.CreateProcessedArticle(ProcessedArticle{
+			SubmissionID:      submissionID,
+			NewsletterIssueID: &issueID,
+			JournalistType:    "general",
+			ProcessedContent:  `{"headline": "Test", "body": "Body", "byline": "Koco Kai"}`,
+			TemplateFormat:    "column",
+			ProcessingStatus:  ProcessingStatusSuccess,
+			PromptTokens:      a.promptTokens,
+			CompletionTokens:  a.completionTokens,
+		}); err != nil {
+			t.Fatalf("Failed to create processed article: %v", err)
+		}
+	}
+
+	usage, err := db.GetTokenUsageByIssue(issue.ID)
+	if err != nil {
+		t.Fatalf("GetTokenUsageByIssue() failed: %v", err)
+	}
+
+	if usage.ArticleCount != 2 {
+		t.Errorf("Expected 2 articles, got %d", usage.ArticleCount)
+	}
+	if usage.PromptTokens != 1800 || usage.CompletionTokens != 400 {
+		t.Errorf("Expected 1800/400 tokens, got %d/%d", usage.PromptTokens, usage.CompletionTokens)
+	}
+	if usage.TotalTokens() != 2200 {
+		t.Errorf("Expected 2200 total tokens, got %d", usage.TotalTokens())
+	}
+
+	// Issue without articles reports zeros
+	emptyIssue, err := db.CreateWeeklyNewsletterIssue(22, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	empty, err := db.GetTokenUsageByIssue(emptyIssue.ID)
+	if err != nil {
+		t.Fatalf("GetTokenUsageByIssue() failed for empty issue: %v", err)
+	}
+	if empty.ArticleCount != 0 || empty.TotalTokens() != 0 {
+		t.Errorf("Expected zero usage for empty issue, got %+v", empty)
+	}
+}
+
+// TDD: Test article counts are grouped by status and scoped to the issue
+func TestGetProcessedArticleStats(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(20, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	otherIssue, err := db.CreateWeeklyNewsletterIssue(21, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	articles := []struct {
+		issueID int
+		status  string
+	}{
+		{issue.ID, ProcessingStatusSuccess},
+		{issue.ID, ProcessingStatusSuccess},
+		{issue.ID, ProcessingStatusSuccess},
+		{issue.ID, ProcessingStatusFailed},
+		{issue.ID, ProcessingStatusPending},
+		{issue.ID, ProcessingStatusSuperseded},
+		{otherIssue.ID, ProcessingStatusFailed},
+	}
+
+	for i, a := range articles {
+		submissionID, err := db.CreateNewsSubmission("U123", fmt.Sprintf("Story %d", i))
+		if err != nil {
+			t.Fatalf("Failed to create submission: %v", err)
+		}
+		issueID := a.issueID
+		if _, err := db.CreateProcessedArticle(ProcessedArticle{
+			SubmissionID:      submissionID,
+			NewsletterIssueID: &issueID,
+			JournalistType:    "general",
+			ProcessedContent:  `{"headline": "Test", "body": "Body", "byline": "Koco Kai"}`,
+			TemplateFormat:    "column",
+			ProcessingStatus:  a.status,
+		}); err != nil {
+			t.Fatalf("Failed to create processed article: %v", err)
+		}
+	}
+
+	stats, err := db.GetProcessedArticleStats(issue.ID)
+	if err != nil {
+		t.Fatalf("GetProcessedArticleStats() failed: %v", err)
+	}
+
+	expected := map[string]int{
+		ProcessingStatusSuccess:    3,
+		ProcessingStatusFailed:     1,
+		ProcessingStatusPending:    1,
+		ProcessingStatusSuperseded: 1,
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("Expected stats %v, got %v", expected, stats)
+	}
+
+	// Issue without articles returns an empty map
+	emptyIssue, err := db.CreateWeeklyNewsletterIssue(22, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	empty, err := db.GetProcessedArticleStats(emptyIssue.ID)
+	if err != nil {
+		t.Fatalf("GetProcessedArticleStats() failed for empty issue: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("Expected no stats for empty issue, got %v", empty)
+	}
+}
+
+// TDD: Test re-processed versions are kept, ordered oldest first, and only the latest stays live
+func TestGetArticleVersions(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	submissionID, err := db.CreateNewsSubmission("U123", "We moved offices")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	otherSubmissionID, err := db.CreateNewsSubmission("U456", "Unrelated story")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	create := func(subID, wordCount int) int {
+		id, err := db.CreateProcessedArticle(ProcessedArticle{
+			SubmissionID:     subID,
+			JournalistType:   "general",
+			ProcessedContent: fmt.Sprintf(`{"headline":"Version with %d words"}`, wordCount),
+			TemplateFormat:   "column",
+			ProcessingStatus: ProcessingStatusSuccess,
+			WordCount:        wordCount,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create processed article: %v", err)
+		}
+		return id
+	}
+
+	// Each rerun saves a new version, then supersedes the older ones
+	var ids []int
+	for _, wordCount := range []int{120, 135, 110} {
+		ids = append(ids, create(submissionID, wordCount))
+		if _, err := db.SupersedeEarlierArticleVersions(submissionID); err != nil {
+			t.Fatalf("SupersedeEarlierArticleVersions() failed: %v", err)
+		}
+	}
+	otherID := create(otherSubmissionID, 50)
+
+	versions, err := db.GetArticleVersions(submissionID)
+	if err != nil {
+		t.Fatalf("GetArticleVersions() failed: %v", err)
+	}
+
+	if len(versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(versions))
+	}
+	for i, version := range versions {
+		if version.ID != ids[i] {
+			t.Errorf("Expected version %d to be article %d, got %d", i+1, ids[i], version.ID)
+		}
+	}
+
+	for _, version := range versions[:2] {
+		if version.ProcessingStatus != ProcessingStatusSuperseded || version.SupersededAt == nil {
+			t.Errorf("Expected article %d to be superseded, got status %s superseded_at %v",
+				version.ID, version.ProcessingStatus, version.SupersededAt)
+		}
+	}
+	latest := versions[2]
+	if latest.ProcessingStatus != ProcessingStatusSuccess || latest.SupersededAt != nil {
+		t.Errorf("Expected latest version to stay live, got status %s superseded_at %v",
+			latest.ProcessingStatus, latest.SupersededAt)
+	}
+	if latest.WordCount != 110 {
+		t.Errorf("Expected latest word count 110, got %d", latest.WordCount)
+	}
+
+	other, err := db.GetArticleVersions(otherSubmissionID)
+	if err != nil {
+		t.Fatalf("GetArticleVersions() failed: %v", err)
+	}
+	if len(other) != 1 || other[0].ID != otherID || other[0].SupersededAt != nil {
+		t.Errorf("Other submission's article should be untouched, got %+v", other)
+	}
+}
+
+func TestGetProcessedArticleWithSubmission(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	question, err := NewQuestionSelector(db.DB).AddQuestion(context.Background(), "What shipped this week?", "work")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	answerID, err := db.CreateSubmission(&Submission{UserID: "U123", QuestionID: &question.ID, Content: "The new dashboard"})
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	newsID, err := db.CreateNewsSubmission("U456", "We moved offices")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	issue, err := db.GetOrCreateWeeklyIssue(10, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	answerArticleID, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      answerID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "feature",
+		ProcessedContent:  `{"headline":"Dashboard ships"}`,
+		TemplateFormat:    "hero",
+		ProcessingStatus:  ProcessingStatusSuccess,
+		WordCount:         150,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+	newsArticleID, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:     newsID,
+		JournalistType:   "general",
+		ProcessedContent: `{"headline":"Moving day"}`,
+		TemplateFormat:   "column",
+		ProcessingStatus: ProcessingStatusSuccess,
+		WordCount:        90,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	t.Run("WithIssueAndQuestion", func(t *testing.T) {
+		article, submission, err := db.GetProcessedArticleWithSubmission(answerArticleID)
+		if err != nil {
+			t.Fatalf("GetProcessedArticleWithSubmission() failed: %v", err)
+		}
+		if article.ID != answerArticleID || article.JournalistType != "feature" || article.WordCount != 150 {
+			t.Errorf("Unexpected article: %+v", article)
+		}
+		if article.NewsletterIssueID == nil || *article.NewsletterIssueID != issue.ID {
+			t.Errorf("Expected newsletter issue %d, got %v", issue.ID, article.NewsletterIssueID)
+		}
+		if submission.ID != answerID || submission.UserID != "U123" || submission.Content != "The new dashboard" {
+			t.Errorf("Unexpected submission: %+v", submission)
+		}
+		if submission.QuestionID == nil || *submission.QuestionID != question.ID {
+			t.Errorf("Expected question ID %d, got %v", question.ID, submission.QuestionID)
+		}
+	})
+
+	t.Run("WithoutIssueOrQuestion", func(t *testing.T) {
+		article, submission, err := db.GetProcessedArticleWithSubmission(newsArticleID)
+		if err != nil {
+			t.Fatalf("GetProcessedArticleWithSubmission() failed: %v", err)
+		}
+		if article.NewsletterIssueID != nil {
+			t.Errorf("Expected no newsletter issue, got %d", *article.NewsletterIssueID)
+		}
+		if article.ProcessedContent != `{"headline":"Moving day"}` {
+			t.Errorf("Unexpected content: %s", article.ProcessedContent)
+		}
+		if submission.ID != newsID || submission.UserID != "U456" || submission.QuestionID != nil {
+			t.Errorf("Unexpected submission: %+v", submission)
+		}
+	})
+
+	t.Run("MissingArticle", func(t *testing.T) {
+		article, submission, err := db.GetProcessedArticleWithSubmission(9999)
+		if err == nil {
+			t.Fatal("Expected error for missing article")
+		}
+		if !strings.Contains(err.Error(), "not found") {
+			t.Errorf("Expected not found error, got: %v", err)
+		}
+		if article != nil || submission != nil {
+			t.Error("Expected nil results for missing article")
+		}
+	})
+}
+
+// TDD: Test both ways of superseding mark articles the same way and leave them out of the current articles
+func TestGetCurrentProcessedArticlesByNewsletterIssue(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(40, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create weekly issue: %v", err)
+	}
+
+	create := func(submissionID int) int {
+		id, err := db.CreateProcessedArticle(ProcessedArticle{
+			SubmissionID:      submissionID,
+			NewsletterIssueID: &issue.ID,
+			JournalistType:    "general",
+			ProcessedContent:  `{"headline":"Story"}`,
+			TemplateFormat:    "column",
+			ProcessingStatus:  ProcessingStatusSuccess,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create processed article: %v", err)
+		}
+		return id
+	}
+
+	editedID, err := db.CreateNewsSubmission("U123", "Edited story")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	rerunID, err := db.CreateNewsSubmission("U456", "Rerun story")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	// An edit supersedes the old article before the new one is written; a rerun after
+	create(editedID)
+	if _, err := db.SupersedeProcessedArticles(editedID); err != nil {
+		t.Fatalf("SupersedeProcessedArticles() failed: %v", err)
+	}
+	latestEdited := create(editedID)
+
+	create(rerunID)
+	latestRerun := create(rerunID)
+	if _, err := db.SupersedeEarlierArticleVersions(rerunID); err != nil {
+		t.Fatalf("SupersedeEarlierArticleVersions() failed: %v", err)
+	}
+
+	all, err := db.GetProcessedArticlesByNewsletterIssue(issue.ID)
+	if err != nil {
+		t.Fatalf("GetProcessedArticlesByNewsletterIssue() failed: %v", err)
+	}
+	for _, article := range all {
+		live := article.ID == latestEdited || article.ID == latestRerun
+		if article.IsSuperseded() == live {
+			t.Errorf("Article %d: IsSuperseded() = %v, want %v", article.ID, article.IsSuperseded(), !live)
+		}
+	}
+
+	current, err := db.GetCurrentProcessedArticlesByNewsletterIssue(issue.ID)
+	if err != nil {
+		t.Fatalf("GetCurrentProcessedArticlesByNewsletterIssue() failed: %v", err)
+	}
+	if len(current) != 2 || current[0].ID != latestEdited || current[1].ID != latestRerun {
+		t.Errorf("Expected only the latest versions %d and %d, got %+v", latestEdited, latestRerun, current)
+	}
+}
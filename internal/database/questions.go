@@ -4,15 +4,25 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 )
 
+// DefaultQuestionCooldownWeeks is how many weeks a question is avoided after being used
+const DefaultQuestionCooldownWeeks = 4
+
 // QuestionSelector handles intelligent question selection
 type QuestionSelector struct {
-	db *sql.DB
+	db            *sql.DB
+	cooldownWeeks int
 }
 
 func NewQuestionSelector(db *sql.DB) *QuestionSelector {
-	return &QuestionSelector{db: db}
+	return &QuestionSelector{db: db, cooldownWeeks: DefaultQuestionCooldownWeeks}
+}
+
+// SetCooldownWeeks configures how many weeks a used question is skipped; zero disables the cooldown
+func (qs *QuestionSelector) SetCooldownWeeks(weeks int) {
+	qs.cooldownWeeks = weeks
 }
 
 // MarkQuestionUsed updates the last_used_at timestamp
@@ -29,24 +39,35 @@ func (qs *QuestionSelector) MarkQuestionUsed(ctx context.Context, questionID int
 
 // SelectNextQuestion picks the best question based on rotation logic
 func (qs *QuestionSelector) SelectNextQuestion(ctx context.Context, category string) (*Question, error) {
-	// Strategy: Pick the least recently used question in the category
+	// Strategy: Skip questions in cooldown, i.e. whose text was used within the last
+	// cooldownWeeks in any category, then pick the least recently used question.
+	// If every question is in cooldown, fall back to the least recently used one.
 	// If multiple questions have never been used, pick randomly among them
 
 	query := `
              SELECT id, text, category, last_used_at, created_at
-             FROM questions
+             FROM questions q
              WHERE category = ?
              ORDER BY
+                 CASE WHEN EXISTS (
+                     SELECT 1 FROM questions o
+                     WHERE lower(o.text) = lower(q.text)
+                       AND o.last_used_at IS NOT NULL
+                       AND datetime(o.last_used_at) >= datetime(?)
+                 ) THEN 1 ELSE 0 END,                                -- Questions out of cooldown first
                  CASE WHEN last_used_at IS NULL THEN 0 ELSE 1 END,  -- Unused questions first
                  last_used_at ASC,                                   -- Then oldest used ones
                  RANDOM()                                            -- Random tiebreaker
              LIMIT 1
          `
 
+	// A zero cooldown uses "now" as the cutoff, so nothing counts as recently used
+	cutoff := time.Now().AddDate(0, 0, -7*qs.cooldownWeeks).UTC().Format(sqliteTimestampLayout)
+
 	var q Question
 	var lastUsedAt sql.NullTime
 
-	err := qs.db.QueryRowContext(ctx, query, category).Scan(
+	err := qs.db.QueryRowContext(ctx, query, category, cutoff).Scan(
 		&q.ID,
 		&q.Text,
 		&q.Category,
@@ -167,3 +188,70 @@ func (qs *QuestionSelector) DeleteQuestion(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// ResetCategoryRotation clears last_used_at for every question in a category so
+// rotation treats them as never used, e.g. after importing questions
+func (qs *QuestionSelector) ResetCategoryRotation(ctx context.Context, category string) error {
+	query := `UPDATE questions SET last_used_at = NULL WHERE category = ?`
+
+	if _, err := qs.db.ExecContext(ctx, query, category); err != nil {
+		return fmt.Errorf("failed to reset question rotation: %w", err)
+	}
+
+	return nil
+}
+
+// GetQuestionUsageStats reports how many submissions reference each question in a category,
+// ordered least used first so stale questions surface at the top
+func (qs *QuestionSelector) GetQuestionUsageStats(ctx context.Context, category string) ([]QuestionUsageStat, error) {
+	query := `
+             SELECT q.id, q.text, q.category, q.last_used_at, q.created_at, COUNT(s.id) AS use_count
+             FROM questions q
+             LEFT JOIN submissions s ON s.question_id = q.id
+             WHERE q.category = ?
+             GROUP BY q.id
+             ORDER BY
+                 use_count ASC,
+                 CASE WHEN q.last_used_at IS NULL THEN 0 ELSE 1 END,  -- Never used first
+                 q.last_used_at ASC,
+                 q.id ASC
+         `
+
+	rows, err := qs.db.QueryContext(ctx, query, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query question usage: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []QuestionUsageStat
+
+	for rows.Next() {
+		var stat QuestionUsageStat
+		var lastUsedAt sql.NullTime
+
+		err := rows.Scan(
+			&stat.Question.ID,
+			&stat.Question.Text,
+			&stat.Question.Category,
+			&lastUsedAt,
+			&stat.Question.CreatedAt,
+			&stat.UseCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question usage: %w", err)
+		}
+
+		if lastUsedAt.Valid {
+			stat.Question.LastUsedAt = &lastUsedAt.Time
+			stat.LastUsedAt = &lastUsedAt.Time
+		}
+
+		stats = append(stats, stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating question usage: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,251 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TDD: Test question usage stats count submissions and order least used first
+func TestQuestionSelector_GetQuestionUsageStats(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	selector := NewQuestionSelector(db.DB)
+	ctx := context.Background()
+
+	popular, err := selector.AddQuestion(ctx, "What shipped this week?", "work")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	occasional, err := selector.AddQuestion(ctx, "Who helped you out?", "work")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	unused, err := selector.AddQuestion(ctx, "What are you learning?", "work")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	if _, err := selector.AddQuestion(ctx, "Favourite snack?", "fun"); err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+
+	if err := selector.MarkQuestionUsed(ctx, popular.ID); err != nil {
+		t.Fatalf("Failed to mark question used: %v", err)
+	}
+
+	// Seed submissions: 3 for popular, 1 for occasional, none for unused
+	seed := map[int]int{popular.ID: 3, occasional.ID: 1}
+	for questionID, count := range seed {
+		for i := 0; i < count; i++ {
+			qID := questionID
+			if _, err := db.CreateSubmission(&Submission{
+				UserID:     "U123456789",
+				QuestionID: &qID,
+				Content:    "An answer",
+			}); err != nil {
+				t.Fatalf("Failed to create submission: %v", err)
+			}
+		}
+	}
+
+	stats, err := selector.GetQuestionUsageStats(ctx, "work")
+	if err != nil {
+		t.Fatalf("GetQuestionUsageStats() failed: %v", err)
+	}
+
+	if len(stats) != 3 {
+		t.Fatalf("Expected 3 questions in work category, got %d", len(stats))
+	}
+
+	expected := []struct {
+		id    int
+		count int
+	}{
+		{unused.ID, 0},
+		{occasional.ID, 1},
+		{popular.ID, 3},
+	}
+
+	for i, want := range expected {
+		if stats[i].Question.ID != want.id {
+			t.Errorf("Position %d: expected question %d, got %d", i, want.id, stats[i].Question.ID)
+		}
+		if stats[i].UseCount != want.count {
+			t.Errorf("Position %d: expected use count %d, got %d", i, want.count, stats[i].UseCount)
+		}
+	}
+
+	if stats[2].LastUsedAt == nil {
+		t.Error("Expected LastUsedAt to be set for used question")
+	}
+	if stats[0].LastUsedAt != nil {
+		t.Error("Expected LastUsedAt to be nil for unused question")
+	}
+
+	// Empty category returns no stats
+	empty, err := selector.GetQuestionUsageStats(ctx, "nonexistent")
+	if err != nil {
+		t.Fatalf("GetQuestionUsageStats() failed for empty category: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("Expected no stats for empty category, got %d", len(empty))
+	}
+}
+
+// TDD: Test selection skips questions in cooldown, including uses of the same text in other categories
+func TestQuestionSelector_SelectNextQuestionCooldown(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	selector := NewQuestionSelector(db.DB)
+	ctx := context.Background()
+
+	// usedWeeksAgo seeds last_used_at the given number of weeks in the past
+	addQuestion := func(text, category string, usedWeeksAgo int) *Question {
+		q, err := selector.AddQuestion(ctx, text, category)
+		if err != nil {
+			t.Fatalf("Failed to add question: %v", err)
+		}
+		usedAt := time.Now().AddDate(0, 0, -7*usedWeeksAgo).UTC().Format(sqliteTimestampLayout)
+		if _, err := db.Exec("UPDATE questions SET last_used_at = ? WHERE id = ?", usedAt, q.ID); err != nil {
+			t.Fatalf("Failed to seed last_used_at: %v", err)
+		}
+		return q
+	}
+
+	// "work": the oldest question's text was just used in "team", so the next oldest should win
+	sharedWork := addQuestion("What did you ship?", "work", 10)
+	addQuestion("What did you ship?", "team", 1)
+	rested := addQuestion("Who helped you out?", "work", 6)
+
+	// "fun": every question is in cooldown, so fall back to the least recent
+	addQuestion("Favourite snack?", "fun", 1)
+	leastRecentFun := addQuestion("Best holiday?", "fun", 2)
+
+	t.Run("SkipsCrossCategoryCooldown", func(t *testing.T) {
+		q, err := selector.SelectNextQuestion(ctx, "work")
+		if err != nil {
+			t.Fatalf("SelectNextQuestion() failed: %v", err)
+		}
+		if q.ID != rested.ID {
+			t.Errorf("Expected question %d outside cooldown, got %d (%q)", rested.ID, q.ID, q.Text)
+		}
+	})
+
+	t.Run("FallsBackToLeastRecentWhenAllInCooldown", func(t *testing.T) {
+		q, err := selector.SelectNextQuestion(ctx, "fun")
+		if err != nil {
+			t.Fatalf("SelectNextQuestion() failed: %v", err)
+		}
+		if q.ID != leastRecentFun.ID {
+			t.Errorf("Expected least recent question %d, got %d (%q)", leastRecentFun.ID, q.ID, q.Text)
+		}
+	})
+
+	t.Run("ConfigurableCooldown", func(t *testing.T) {
+		// With no cooldown, plain least-recently-used rotation applies again
+		selector.SetCooldownWeeks(0)
+		defer selector.SetCooldownWeeks(DefaultQuestionCooldownWeeks)
+
+		q, err := selector.SelectNextQuestion(ctx, "work")
+		if err != nil {
+			t.Fatalf("SelectNextQuestion() failed: %v", err)
+		}
+		if q.ID != sharedWork.ID {
+			t.Errorf("Expected least recently used question %d without cooldown, got %d", sharedWork.ID, q.ID)
+		}
+
+		// A long cooldown puts the 6-week-old question back in cooldown, so the
+		// least recent of the work questions is used as fallback
+		selector.SetCooldownWeeks(12)
+		q, err = selector.SelectNextQuestion(ctx, "work")
+		if err != nil {
+			t.Fatalf("SelectNextQuestion() failed: %v", err)
+		}
+		if q.ID != sharedWork.ID {
+			t.Errorf("Expected fallback to least recent question %d, got %d", sharedWork.ID, q.ID)
+		}
+	})
+}
+
+// TDD: Test resetting rotation clears last_used_at only within the category
+func TestQuestionSelector_ResetCategoryRotation(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	selector := NewQuestionSelector(db.DB)
+	ctx := context.Background()
+
+	var questionIDs []int
+	for _, q := range []struct{ text, category string }{
+		{"What shipped this week?", "work"},
+		{"Who helped you out?", "work"},
+		{"Favourite snack?", "fun"},
+	} {
+		question, err := selector.AddQuestion(ctx, q.text, q.category)
+		if err != nil {
+			t.Fatalf("Failed to add question: %v", err)
+		}
+		if err := selector.MarkQuestionUsed(ctx, question.ID); err != nil {
+			t.Fatalf("Failed to mark question used: %v", err)
+		}
+		questionIDs = append(questionIDs, question.ID)
+	}
+
+	if err := selector.ResetCategoryRotation(ctx, "work"); err != nil {
+		t.Fatalf("ResetCategoryRotation() failed: %v", err)
+	}
+
+	work, err := selector.GetQuestionsByCategory(ctx, "work")
+	if err != nil {
+		t.Fatalf("GetQuestionsByCategory() failed: %v", err)
+	}
+	if len(work) != 2 {
+		t.Fatalf("Expected 2 work questions, got %d", len(work))
+	}
+	for _, q := range work {
+		if q.LastUsedAt != nil {
+			t.Errorf("Expected question %d to be reset, last used %v", q.ID, q.LastUsedAt)
+		}
+	}
+
+	fun, err := selector.GetQuestionByID(ctx, questionIDs[2])
+	if err != nil {
+		t.Fatalf("GetQuestionByID() failed: %v", err)
+	}
+	if fun.LastUsedAt == nil {
+		t.Error("Questions outside the category should keep their last used time")
+	}
+}
@@ -4,20 +4,46 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 )
 
+// DefaultDuplicateWindow is how long an identical submission from the same user is treated as a duplicate
+const DefaultDuplicateWindow = 60 * time.Second
+
+// sqliteTimestampLayout matches the UTC format SQLite uses for CURRENT_TIMESTAMP
+const sqliteTimestampLayout = "2006-01-02 15:04:05"
+
 // SubmissionManager handles news submission operations
 type SubmissionManager struct {
-	db *sql.DB
+	db              *sql.DB
+	duplicateWindow time.Duration
 }
 
 // NewSubmissionManager creates a new submission manager
 func NewSubmissionManager(db *sql.DB) *SubmissionManager {
-	return &SubmissionManager{db: db}
+	return &SubmissionManager{db: db, duplicateWindow: DefaultDuplicateWindow}
+}
+
+// SetDuplicateWindow configures how long identical submissions are collapsed; zero disables dedup
+func (sm *SubmissionManager) SetDuplicateWindow(window time.Duration) {
+	sm.duplicateWindow = window
 }
 
-// CreateNewsSubmission creates a news submission without a specific question
+// CreateNewsSubmission creates a news submission without a specific question.
+// If the same user submitted identical content within the duplicate window, the
+// existing submission is returned instead (e.g. a double-clicked command).
 func (sm *SubmissionManager) CreateNewsSubmission(ctx context.Context, userID, content string) (*Submission, error) {
+	if sm.duplicateWindow > 0 {
+		existing, err := sm.findRecentDuplicate(ctx, userID, content)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			existing.Duplicate = true
+			return existing, nil
+		}
+	}
+
 	result, err := sm.db.ExecContext(ctx,
 		"INSERT INTO submissions (user_id, question_id, content) VALUES (?, NULL, ?)",
 		userID, content,
@@ -35,10 +61,32 @@ func (sm *SubmissionManager) CreateNewsSubmission(ctx context.Context, userID, c
 	return sm.getSubmissionByID(ctx, int(id))
 }
 
+// findRecentDuplicate returns the latest identical submission by the user within the duplicate window
+func (sm *SubmissionManager) findRecentDuplicate(ctx context.Context, userID, content string) (*Submission, error) {
+	cutoff := time.Now().Add(-sm.duplicateWindow).UTC().Format(sqliteTimestampLayout)
+
+	var id int
+	err := sm.db.QueryRowContext(ctx,
+		`SELECT id FROM submissions
+		 WHERE user_id = ? AND content = ? AND datetime(created_at) >= datetime(?) AND deleted_at IS NULL
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT 1`,
+		userID, content, cutoff,
+	).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to check for duplicate submission: %w", err)
+	}
+
+	return sm.getSubmissionByID(ctx, id)
+}
+
 // GetSubmissionsByUser retrieves all submissions by a specific user
 func (sm *SubmissionManager) GetSubmissionsByUser(ctx context.Context, userID string) ([]Submission, error) {
 	rows, err := sm.db.QueryContext(ctx,
-		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE user_id = ? ORDER BY created_at DESC",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE user_id = ? AND deleted_at IS NULL ORDER BY created_at DESC",
 		userID,
 	)
 	if err != nil {
@@ -52,7 +100,7 @@ func (sm *SubmissionManager) GetSubmissionsByUser(ctx context.Context, userID st
 // GetAllSubmissions retrieves all submissions (for admin use)
 func (sm *SubmissionManager) GetAllSubmissions(ctx context.Context) ([]Submission, error) {
 	rows, err := sm.db.QueryContext(ctx,
-		"SELECT id, user_id, question_id, content, created_at FROM submissions ORDER BY created_at DESC",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE deleted_at IS NULL ORDER BY created_at DESC",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query all submissions: %w", err)
@@ -62,13 +110,231 @@ func (sm *SubmissionManager) GetAllSubmissions(ctx context.Context) ([]Submissio
 	return sm.scanSubmissions(rows)
 }
 
+// GetSubmissionsByDateRange retrieves submissions created in [start, end), oldest first
+func (sm *SubmissionManager) GetSubmissionsByDateRange(ctx context.Context, start, end time.Time) ([]Submission, error) {
+	var submissions []Submission
+	err := sm.IterateSubmissionsByDateRange(ctx, start, end, func(submission Submission) error {
+		submissions = append(submissions, submission)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// IterateSubmissions calls fn with every live submission, oldest first, scanning one row at a time
+// so exports don't hold every submission in memory. An error from fn stops the iteration and is
+// returned as is.
+func (sm *SubmissionManager) IterateSubmissions(ctx context.Context, fn func(Submission) error) error {
+	rows, err := sm.db.QueryContext(ctx,
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE deleted_at IS NULL ORDER BY created_at ASC, id ASC",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query all submissions: %w", err)
+	}
+	defer rows.Close()
+
+	return iterateSubmissionRows(rows, fn)
+}
+
+// IterateSubmissionsByDateRange is IterateSubmissions for submissions created in [start, end)
+func (sm *SubmissionManager) IterateSubmissionsByDateRange(ctx context.Context, start, end time.Time, fn func(Submission) error) error {
+	// created_at is stored as UTC "YYYY-MM-DD HH:MM:SS", so normalize both sides with datetime()
+	rows, err := sm.db.QueryContext(ctx,
+		`SELECT id, user_id, question_id, content, created_at FROM submissions
+		 WHERE datetime(created_at) >= datetime(?) AND datetime(created_at) < datetime(?) AND deleted_at IS NULL
+		 ORDER BY created_at ASC, id ASC`,
+		start.UTC().Format(sqliteTimestampLayout), end.UTC().Format(sqliteTimestampLayout),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query submissions by date range: %w", err)
+	}
+	defer rows.Close()
+
+	return iterateSubmissionRows(rows, fn)
+}
+
+// GetUserSubmissionCounts returns how many submissions each user has made since a point in time.
+// Anonymous submissions (empty user_id) are excluded.
+func (sm *SubmissionManager) GetUserSubmissionCounts(ctx context.Context, since time.Time) (map[string]int, error) {
+	rows, err := sm.db.QueryContext(ctx,
+		`SELECT user_id, COUNT(*) FROM submissions
+		 WHERE user_id != '' AND datetime(created_at) >= datetime(?) AND deleted_at IS NULL
+		 GROUP BY user_id`,
+		since.UTC().Format(sqliteTimestampLayout),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user submission counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan user submission count: %w", err)
+		}
+		counts[userID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over user submission counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetSubmissionsWithoutProcessedArticle returns live submissions that never got a processed article,
+// oldest first, such as ones whose background processing died with the server. Submissions held
+// for review or dead-lettered are left out, since they wait for an admin instead, and so are ones
+// whose article an admin deleted.
+func (sm *SubmissionManager) GetSubmissionsWithoutProcessedArticle(ctx context.Context) ([]Submission, error) {
+	rows, err := sm.db.QueryContext(ctx,
+		`SELECT s.id, s.user_id, s.question_id, s.content, s.created_at
+		 FROM submissions s
+		 LEFT JOIN processed_articles pa ON pa.submission_id = s.id
+		 LEFT JOIN failed_processing fp ON fp.submission_id = s.id
+		 WHERE pa.id IS NULL AND fp.submission_id IS NULL AND s.deleted_at IS NULL
+		 AND s.article_deleted_at IS NULL
+		 AND (s.review_status IS NULL OR s.review_status != ?)
+		 ORDER BY s.created_at ASC, s.id ASC`,
+		SubmissionReviewNeedsReview,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query submissions without processed article: %w", err)
+	}
+	defer rows.Close()
+
+	return sm.scanSubmissions(rows)
+}
+
+// UpdateSubmissionContent replaces the content of a submission owned by userID, keeping the
+// previous content as a revision. Ownership is enforced by the UPDATE itself, so other users'
+// submissions are never touched.
+func (sm *SubmissionManager) UpdateSubmissionContent(ctx context.Context, id int, userID, content string) error {
+	return runTx(ctx, sm.db, func(tx *sql.Tx) error {
+		// An edit that doesn't change anything leaves no revision
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO submission_revisions (submission_id, content)
+			 SELECT id, content FROM submissions
+			 WHERE id = ? AND user_id = ? AND user_id != '' AND deleted_at IS NULL AND content != ?`,
+			id, userID, content,
+		); err != nil {
+			return fmt.Errorf("failed to record submission revision: %w", err)
+		}
+
+		result, err := tx.ExecContext(ctx,
+			"UPDATE submissions SET content = ? WHERE id = ? AND user_id = ? AND user_id != '' AND deleted_at IS NULL",
+			content, id, userID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update submission: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("submission not found or not owned by user")
+		}
+
+		return nil
+	})
+}
+
+// GetSubmissionRevisions returns the earlier contents of a submission, oldest first
+func (db *DB) GetSubmissionRevisions(id int) ([]SubmissionRevision, error) {
+	rows, err := db.Query(
+		"SELECT id, submission_id, content, revised_at FROM submission_revisions WHERE submission_id = ? ORDER BY id",
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query submission revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []SubmissionRevision
+	for rows.Next() {
+		var revision SubmissionRevision
+		if err := rows.Scan(&revision.ID, &revision.SubmissionID, &revision.Content, &revision.RevisedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan submission revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating submission revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// AddSubmissionFile stores a reference to a Slack file shared along with a submission
+func (db *DB) AddSubmissionFile(submissionID int, fileID, url, mime string) error {
+	_, err := db.Exec(
+		"INSERT INTO submission_files (submission_id, file_id, url, mime) VALUES (?, ?, ?, ?)",
+		submissionID, fileID, url, mime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add submission file: %w", err)
+	}
+	return nil
+}
+
+// GetSubmissionFile returns one stored submission file by its ID
+func (db *DB) GetSubmissionFile(id int) (*SubmissionFile, error) {
+	var file SubmissionFile
+	err := db.QueryRow(
+		"SELECT id, submission_id, file_id, url, mime, created_at FROM submission_files WHERE id = ?",
+		id,
+	).Scan(&file.ID, &file.SubmissionID, &file.FileID, &file.URL, &file.MimeType, &file.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("submission file with ID %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submission file: %w", err)
+	}
+	return &file, nil
+}
+
+// GetFilesForSubmission returns the files shared along with a submission, in the order they were added
+func (db *DB) GetFilesForSubmission(id int) ([]SubmissionFile, error) {
+	rows, err := db.Query(
+		"SELECT id, submission_id, file_id, url, mime, created_at FROM submission_files WHERE submission_id = ? ORDER BY id",
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query submission files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []SubmissionFile
+	for rows.Next() {
+		var file SubmissionFile
+		if err := rows.Scan(&file.ID, &file.SubmissionID, &file.FileID, &file.URL, &file.MimeType, &file.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan submission file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating submission files: %w", err)
+	}
+
+	return files, nil
+}
+
 // getSubmissionByID is a helper method to retrieve a submission by ID
 func (sm *SubmissionManager) getSubmissionByID(ctx context.Context, id int) (*Submission, error) {
 	var submission Submission
 	var questionID sql.NullInt64
 
 	err := sm.db.QueryRowContext(ctx,
-		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE id = ?",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE id = ? AND deleted_at IS NULL",
 		id,
 	).Scan(&submission.ID, &submission.UserID, &questionID, &submission.Content, &submission.CreatedAt)
 
@@ -93,37 +359,59 @@ func (sm *SubmissionManager) getSubmissionByID(ctx context.Context, id int) (*Su
 // scanSubmissions is a helper method to scan multiple submissions from query results
 func (sm *SubmissionManager) scanSubmissions(rows *sql.Rows) ([]Submission, error) {
 	var submissions []Submission
+	err := iterateSubmissionRows(rows, func(submission Submission) error {
+		submissions = append(submissions, submission)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
 
+// iterateSubmissionRows scans id, user_id, question_id, content, created_at rows into submissions
+// and calls fn with each, stopping at the first error fn returns
+func iterateSubmissionRows(rows *sql.Rows, fn func(Submission) error) error {
 	for rows.Next() {
 		var submission Submission
 		var questionID sql.NullInt64
 
 		err := rows.Scan(&submission.ID, &submission.UserID, &questionID, &submission.Content, &submission.CreatedAt)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan submission: %w", err)
+			return fmt.Errorf("failed to scan submission: %w", err)
 		}
 
 		// Handle nullable question_id
 		if questionID.Valid {
 			qid := int(questionID.Int64)
 			submission.QuestionID = &qid
-		} else {
-			submission.QuestionID = nil
 		}
 
-		submissions = append(submissions, submission)
+		if err := fn(submission); err != nil {
+			return err
+		}
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating submissions: %w", err)
+		return fmt.Errorf("error iterating submissions: %w", err)
 	}
 
-	return submissions, nil
+	return nil
 }
 
-// DeleteSubmission deletes a submission by ID
+// DeleteSubmission soft-deletes a submission by ID. See softDeleteSubmission.
 func (sm *SubmissionManager) DeleteSubmission(ctx context.Context, id int) error {
-	result, err := sm.db.ExecContext(ctx, "DELETE FROM submissions WHERE id = ?", id)
+	return runTx(ctx, sm.db, func(tx *sql.Tx) error {
+		return softDeleteSubmission(ctx, tx, id)
+	})
+}
+
+// softDeleteSubmission marks a submission deleted so it disappears from listings but stays in the
+// table for audit. Its articles are superseded so they drop out of the newsletter, its dead letter
+// is removed, and assignments pointing at it are unlinked, all within tx.
+func softDeleteSubmission(ctx context.Context, tx *sql.Tx, id int) error {
+	result, err := tx.ExecContext(ctx,
+		"UPDATE submissions SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete submission: %w", err)
 	}
@@ -137,9 +425,46 @@ func (sm *SubmissionManager) DeleteSubmission(ctx context.Context, id int) error
 		return fmt.Errorf("submission not found")
 	}
 
+	// Keep the articles for history, but out of newsletter rendering
+	if _, err := tx.ExecContext(ctx, supersedeArticlesQuery, ProcessingStatusSuperseded, id, ProcessingStatusSuperseded); err != nil {
+		return fmt.Errorf("failed to supersede processed articles: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM failed_processing WHERE submission_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE person_assignments SET submission_id = NULL WHERE submission_id = ?", id); err != nil {
+		return fmt.Errorf("failed to unlink person assignments: %w", err)
+	}
+
 	return nil
 }
 
+// GetDeletedSubmissionIDsByUser returns the IDs of a user's soft-deleted submissions
+func (db *DB) GetDeletedSubmissionIDsByUser(userID string) ([]int, error) {
+	rows, err := db.Query("SELECT id FROM submissions WHERE user_id = ? AND deleted_at IS NOT NULL ORDER BY id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted submission: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating deleted submissions: %w", err)
+	}
+
+	return ids, nil
+}
+
 // CreateAnonymousSubmission creates a submission without user attribution
 func (db *DB) CreateAnonymousSubmission(content, category string) (*Submission, error) {
 	result, err := db.Exec(
@@ -164,10 +489,54 @@ func (db *DB) CreateAnonymousSubmission(content, category string) (*Submission,
 	}, nil
 }
 
+// FlagSubmission marks a submission as needing review, recording why it was flagged
+func (db *DB) FlagSubmission(id int, reason string) error {
+	result, err := db.Exec(
+		"UPDATE submissions SET review_status = ?, review_reason = ? WHERE id = ?",
+		SubmissionReviewNeedsReview, reason, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to flag submission: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("submission with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// ClearSubmissionReview removes a submission's review flag once an admin has approved it
+func (db *DB) ClearSubmissionReview(id int) error {
+	_, err := db.Exec("UPDATE submissions SET review_status = NULL, review_reason = NULL WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to clear submission review: %w", err)
+	}
+	return nil
+}
+
+// GetSubmissionReview returns the review status and reason of a submission; both are empty when unflagged
+func (db *DB) GetSubmissionReview(id int) (string, string, error) {
+	var status, reason sql.NullString
+	err := db.QueryRow("SELECT review_status, review_reason FROM submissions WHERE id = ?", id).Scan(&status, &reason)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", "", fmt.Errorf("submission with ID %d not found", id)
+		}
+		return "", "", fmt.Errorf("failed to get submission review: %w", err)
+	}
+
+	return status.String, reason.String, nil
+}
+
 // GetAnonymousSubmissionsByCategory retrieves anonymous submissions by category
 func (db *DB) GetAnonymousSubmissionsByCategory(category string) ([]Submission, error) {
 	rows, err := db.Query(
-		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE user_id = '' ORDER BY created_at DESC",
+		"SELECT id, user_id, question_id, content, created_at FROM submissions WHERE user_id = '' AND deleted_at IS NULL ORDER BY created_at DESC",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query anonymous submissions: %w", err)
@@ -201,3 +570,90 @@ func (db *DB) GetAnonymousSubmissionsByCategory(category string) ([]Submission,
 
 	return submissions, nil
 }
+
+// GetPromotableAnonymousSubmissions returns one page of anonymous submissions, newest first, that
+// haven't been promoted to the body/mind pool yet. Submissions held for review are left out.
+func (db *DB) GetPromotableAnonymousSubmissions(limit, offset int) ([]Submission, error) {
+	rows, err := db.Query(`
+		SELECT id, user_id, question_id, content, created_at
+		FROM submissions
+		WHERE user_id = '' AND promoted_at IS NULL AND deleted_at IS NULL
+		AND (review_status IS NULL OR review_status != ?)
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?`,
+		SubmissionReviewNeedsReview, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promotable anonymous submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []Submission
+	for rows.Next() {
+		var submission Submission
+		var questionID sql.NullInt64
+
+		if err := rows.Scan(&submission.ID, &submission.UserID, &questionID, &submission.Content, &submission.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan anonymous submission: %w", err)
+		}
+		if questionID.Valid {
+			qid := int(questionID.Int64)
+			submission.QuestionID = &qid
+		}
+
+		submissions = append(submissions, submission)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating anonymous submissions: %w", err)
+	}
+
+	return submissions, nil
+}
+
+// GetPromotableAnonymousSubmission returns one anonymous submission if it can still be promoted to
+// the body/mind pool: not promoted yet, not deleted and not held for review
+func (db *DB) GetPromotableAnonymousSubmission(id int) (*Submission, error) {
+	var submission Submission
+	var questionID sql.NullInt64
+	err := db.QueryRow(`
+		SELECT id, user_id, question_id, content, created_at
+		FROM submissions
+		WHERE id = ? AND user_id = '' AND promoted_at IS NULL AND deleted_at IS NULL
+		AND (review_status IS NULL OR review_status != ?)`,
+		id, SubmissionReviewNeedsReview,
+	).Scan(&submission.ID, &submission.UserID, &questionID, &submission.Content, &submission.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("anonymous submission with ID %d not found or already promoted", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anonymous submission: %w", err)
+	}
+	if questionID.Valid {
+		qid := int(questionID.Int64)
+		submission.QuestionID = &qid
+	}
+
+	return &submission, nil
+}
+
+// MarkSubmissionPromoted records that an anonymous submission was added to the body/mind pool
+func (db *DB) MarkSubmissionPromoted(id int) error {
+	result, err := db.Exec(
+		"UPDATE submissions SET promoted_at = ? WHERE id = ? AND user_id = ''",
+		time.Now().UTC().Format(sqliteTimestampLayout), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark submission promoted: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("anonymous submission with ID %d not found", id)
+	}
+
+	return nil
+}
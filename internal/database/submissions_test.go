@@ -2,8 +2,11 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 // TDD: Test for SubmissionManager interface
@@ -156,3 +159,851 @@ func TestSubmissionManager_GetAllSubmissions(t *testing.T) {
 		}
 	}
 }
+
+// TDD: Test rapid identical submissions are collapsed into one
+func TestSubmissionManager_DuplicateSubmissions(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	manager := NewSubmissionManager(db.DB)
+	ctx := context.Background()
+	userID := "U123456789"
+	content := "We moved into the new office!"
+
+	first, err := manager.CreateNewsSubmission(ctx, userID, content)
+	if err != nil {
+		t.Fatalf("CreateNewsSubmission() failed: %v", err)
+	}
+
+	t.Run("RapidDuplicateIsCollapsed", func(t *testing.T) {
+		second, err := manager.CreateNewsSubmission(ctx, userID, content)
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		if second.ID != first.ID {
+			t.Errorf("Expected duplicate to return submission %d, got %d", first.ID, second.ID)
+		}
+		if !second.Duplicate || first.Duplicate {
+			t.Error("Expected only the collapsed submission to be flagged as duplicate")
+		}
+
+		submissions, err := manager.GetSubmissionsByUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("GetSubmissionsByUser() failed: %v", err)
+		}
+		if len(submissions) != 1 {
+			t.Errorf("Expected 1 stored submission, got %d", len(submissions))
+		}
+	})
+
+	t.Run("DifferentContentOrUserIsStored", func(t *testing.T) {
+		other, err := manager.CreateNewsSubmission(ctx, userID, content+" ")
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		if other.ID == first.ID {
+			t.Error("Expected different content to create a new submission")
+		}
+
+		otherUser, err := manager.CreateNewsSubmission(ctx, "U987654321", content)
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		if otherUser.ID == first.ID {
+			t.Error("Expected a different user to create a new submission")
+		}
+	})
+
+	t.Run("SameContentAfterWindowIsStored", func(t *testing.T) {
+		// Move the original submission outside the 60 second window
+		if _, err := db.Exec(
+			"UPDATE submissions SET created_at = datetime('now', '-2 minutes') WHERE id = ?", first.ID,
+		); err != nil {
+			t.Fatalf("Failed to backdate submission: %v", err)
+		}
+
+		later, err := manager.CreateNewsSubmission(ctx, userID, content)
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		if later.ID == first.ID {
+			t.Error("Expected same content after the window to create a new submission")
+		}
+	})
+
+	t.Run("ZeroWindowDisablesDedup", func(t *testing.T) {
+		manager.SetDuplicateWindow(0)
+		defer manager.SetDuplicateWindow(DefaultDuplicateWindow)
+
+		a, err := manager.CreateNewsSubmission(ctx, "U555555555", "Same twice")
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		b, err := manager.CreateNewsSubmission(ctx, "U555555555", "Same twice")
+		if err != nil {
+			t.Fatalf("CreateNewsSubmission() failed: %v", err)
+		}
+		if a.ID == b.ID {
+			t.Error("Expected dedup to be disabled with a zero window")
+		}
+	})
+}
+
+// TDD: Test date range queries include the start boundary and exclude the end boundary
+func TestSubmissionManager_GetSubmissionsByDateRange(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	manager := NewSubmissionManager(db.DB)
+	ctx := context.Background()
+
+	// Seed submissions with fixed timestamps in the same format as CURRENT_TIMESTAMP
+	seed := []struct {
+		content   string
+		createdAt string
+	}{
+		{"Before range", "2025-02-28 23:59:59"},
+		{"At start", "2025-03-01 00:00:00"},
+		{"Middle", "2025-03-15 12:00:00"},
+		{"Just before end", "2025-03-31 23:59:59"},
+		{"At end", "2025-04-01 00:00:00"},
+	}
+	for _, s := range seed {
+		if _, err := db.Exec(
+			"INSERT INTO submissions (user_id, question_id, content, created_at) VALUES (?, NULL, ?, ?)",
+			"U123456789", s.content, s.createdAt,
+		); err != nil {
+			t.Fatalf("Failed to seed submission: %v", err)
+		}
+	}
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	submissions, err := manager.GetSubmissionsByDateRange(ctx, start, end)
+	if err != nil {
+		t.Fatalf("GetSubmissionsByDateRange() failed: %v", err)
+	}
+
+	expected := []string{"At start", "Middle", "Just before end"}
+	if len(submissions) != len(expected) {
+		t.Fatalf("Expected %d submissions, got %d", len(expected), len(submissions))
+	}
+	for i, content := range expected {
+		if submissions[i].Content != content {
+			t.Errorf("Position %d: expected %q, got %q", i, content, submissions[i].Content)
+		}
+	}
+
+	// Non-UTC bounds are converted before comparing
+	stockholm := time.FixedZone("CET", 3600)
+	submissions, err = manager.GetSubmissionsByDateRange(ctx,
+		time.Date(2025, 3, 15, 13, 0, 0, 0, stockholm),
+		time.Date(2025, 3, 15, 13, 0, 1, 0, stockholm))
+	if err != nil {
+		t.Fatalf("GetSubmissionsByDateRange() failed: %v", err)
+	}
+	if len(submissions) != 1 || submissions[0].Content != "Middle" {
+		t.Errorf("Expected only the middle submission for the CET range, got %d", len(submissions))
+	}
+
+	// Empty range returns no submissions
+	empty, err := manager.GetSubmissionsByDateRange(ctx,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetSubmissionsByDateRange() failed for empty range: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("Expected no submissions in empty range, got %d", len(empty))
+	}
+}
+
+// TDD: IterateSubmissions calls back once per live row, oldest first, and stops at the first error
+func TestSubmissionManager_IterateSubmissions(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	ctx := context.Background()
+	sm := NewSubmissionManager(db.DB)
+
+	var ids []int
+	for _, content := range []string{"First story", "Second story", "Third story", "Deleted story"} {
+		submission, err := sm.CreateNewsSubmission(ctx, "U123456789", content)
+		if err != nil {
+			t.Fatalf("Failed to create submission: %v", err)
+		}
+		ids = append(ids, submission.ID)
+	}
+	if err := sm.DeleteSubmission(ctx, ids[3]); err != nil {
+		t.Fatalf("DeleteSubmission() failed: %v", err)
+	}
+
+	var seen []int
+	err = sm.IterateSubmissions(ctx, func(submission Submission) error {
+		seen = append(seen, submission.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("IterateSubmissions() failed: %v", err)
+	}
+	if len(seen) != 3 || seen[0] != ids[0] || seen[1] != ids[1] || seen[2] != ids[2] {
+		t.Errorf("Expected callbacks for %v in order, got %v", ids[:3], seen)
+	}
+
+	// An error from the callback stops the iteration and is returned unchanged
+	errStop := errors.New("stop")
+	seen = nil
+	err = sm.IterateSubmissions(ctx, func(submission Submission) error {
+		seen = append(seen, submission.ID)
+		if len(seen) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Expected the callback's error, got %v", err)
+	}
+	if len(seen) != 2 {
+		t.Errorf("Expected iteration to stop after 2 rows, got %d callbacks", len(seen))
+	}
+}
+
+// TDD: Test cascading submission delete cleans up articles and assignment links
+// TDD: Test only the owner can update a submission's content
+func TestSubmissionManager_UpdateSubmissionContent(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	sm := NewSubmissionManager(db.DB)
+	ctx := context.Background()
+
+	submission, err := sm.CreateNewsSubmission(ctx, "U111111111", "Typo in teh story")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	anonymous, err := db.CreateAnonymousSubmission("Anonymous wellness question", "body_mind")
+	if err != nil {
+		t.Fatalf("Failed to create anonymous submission: %v", err)
+	}
+
+	if err := sm.UpdateSubmissionContent(ctx, submission.ID, "U222222222", "Someone else's edit"); err == nil {
+		t.Error("Expected error when editing another user's submission")
+	}
+	if err := sm.UpdateSubmissionContent(ctx, anonymous.ID, "", "Edited anonymously"); err == nil {
+		t.Error("Expected error when editing an anonymous submission")
+	}
+	if err := sm.UpdateSubmissionContent(ctx, 9999, "U111111111", "Missing"); err == nil {
+		t.Error("Expected error when editing a missing submission")
+	}
+
+	stored, err := db.GetSubmission(submission.ID)
+	if err != nil {
+		t.Fatalf("GetSubmission() failed: %v", err)
+	}
+	if stored.Content != "Typo in teh story" {
+		t.Errorf("Expected content unchanged after rejected edits, got %q", stored.Content)
+	}
+
+	if err := sm.UpdateSubmissionContent(ctx, submission.ID, "U111111111", "Typo in the story"); err != nil {
+		t.Fatalf("UpdateSubmissionContent() failed for owner: %v", err)
+	}
+
+	stored, err = db.GetSubmission(submission.ID)
+	if err != nil {
+		t.Fatalf("GetSubmission() failed: %v", err)
+	}
+	if stored.Content != "Typo in the story" {
+		t.Errorf("Expected updated content, got %q", stored.Content)
+	}
+}
+
+// TDD: Every edit keeps the content it replaced, oldest revision first
+func TestSubmissionManager_UpdateSubmissionContentRecordsRevisions(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	sm := NewSubmissionManager(db.DB)
+	ctx := context.Background()
+
+	submission, err := sm.CreateNewsSubmission(ctx, "U111111111", "First draft")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	revisions, err := db.GetSubmissionRevisions(submission.ID)
+	if err != nil {
+		t.Fatalf("GetSubmissionRevisions() failed: %v", err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("Expected no revisions before any edit, got %d", len(revisions))
+	}
+
+	for _, content := range []string{"Second draft", "Final version"} {
+		if err := sm.UpdateSubmissionContent(ctx, submission.ID, "U111111111", content); err != nil {
+			t.Fatalf("UpdateSubmissionContent(%q) failed: %v", content, err)
+		}
+	}
+
+	// Neither a rejected edit nor one that changes nothing adds a revision
+	if err := sm.UpdateSubmissionContent(ctx, submission.ID, "U222222222", "Hijacked"); err == nil {
+		t.Error("Expected error when editing another user's submission")
+	}
+	if err := sm.UpdateSubmissionContent(ctx, submission.ID, "U111111111", "Final version"); err != nil {
+		t.Fatalf("UpdateSubmissionContent() failed for an unchanged edit: %v", err)
+	}
+
+	revisions, err = db.GetSubmissionRevisions(submission.ID)
+	if err != nil {
+		t.Fatalf("GetSubmissionRevisions() failed: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %d: %+v", len(revisions), revisions)
+	}
+	if revisions[0].Content != "First draft" || revisions[1].Content != "Second draft" {
+		t.Errorf("Expected revisions [First draft, Second draft], got [%q, %q]", revisions[0].Content, revisions[1].Content)
+	}
+	for _, revision := range revisions {
+		if revision.SubmissionID != submission.ID || revision.RevisedAt.IsZero() {
+			t.Errorf("Unexpected revision: %+v", revision)
+		}
+	}
+
+	stored, err := db.GetSubmission(submission.ID)
+	if err != nil {
+		t.Fatalf("GetSubmission() failed: %v", err)
+	}
+	if stored.Content != "Final version" {
+		t.Errorf("Expected current content %q, got %q", "Final version", stored.Content)
+	}
+}
+
+// TDD: Files shared with a submission are stored and returned in order, per submission
+func TestSubmissionFiles(t *testing.T) {
+	db, err := NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	submissionID, err := db.CreateNewsSubmission("U111111111", "Here is the new dashboard")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	otherID, err := db.CreateNewsSubmission("U222222222", "No pictures here")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	files, err := db.GetFilesForSubmission(submissionID)
+	if err != nil {
+		t.Fatalf("GetFilesForSubmission() failed: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Expected no files before any were added, got %d", len(files))
+	}
+
+	expected := []SubmissionFile{
+		{FileID: "F0001", URL: "https://files.slack.com/files-pri/T1-F0001/dashboard.png", MimeType: "image/png"},
+		{FileID: "F0002", URL: "https://files.slack.com/files-pri/T1-F0002/spec.pdf", MimeType: "application/pdf"},
+	}
+	for _, file := range expected {
+		if err := db.AddSubmissionFile(submissionID, file.FileID, file.URL, file.MimeType); err != nil {
+			t.Fatalf("AddSubmissionFile(%s) failed: %v", file.FileID, err)
+		}
+	}
+
+	files, err = db.GetFilesForSubmission(submissionID)
+	if err != nil {
+		t.Fatalf("GetFilesForSubmission() failed: %v", err)
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("Expected %d files, got %d: %+v", len(expected), len(files), files)
+	}
+	for i, file := range files {
+		if file.SubmissionID != submissionID || file.FileID != expected[i].FileID || file.URL != expected[i].URL ||
+			file.MimeType != expected[i].MimeType || file.CreatedAt.IsZero() {
+			t.Errorf("Unexpected file %d: %+v", i, file)
+		}
+	}
+	if !files[0].IsImage() || files[1].IsImage() {
+		t.Errorf("Expected only the PNG to be an image, got %v and %v", files[0].IsImage(), files[1].IsImage())
+	}
+
+	files, err = db.GetFilesForSubmission(otherID)
+	if err != nil {
+		t.Fatalf("GetFilesForSubmission() failed: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Expected no files for another submission, got %d", len(files))
+	}
+}
+
+func TestSubmissionManager_GetUserSubmissionCounts(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	manager := NewSubmissionManager(db.DB)
+	ctx := context.Background()
+
+	seed := []struct {
+		userID    string
+		createdAt string
+	}{
+		{"U111", "2025-03-02 09:00:00"},
+		{"U111", "2025-03-10 09:00:00"},
+		{"U111", "2025-02-01 09:00:00"}, // before the cutoff
+		{"U222", "2025-03-05 09:00:00"},
+		{"", "2025-03-06 09:00:00"}, // anonymous body_mind submissions
+		{"", "2025-03-07 09:00:00"},
+	}
+	for _, s := range seed {
+		if _, err := db.Exec(
+			"INSERT INTO submissions (user_id, question_id, content, created_at) VALUES (?, NULL, ?, ?)",
+			s.userID, "Some news", s.createdAt,
+		); err != nil {
+			t.Fatalf("Failed to seed submission: %v", err)
+		}
+	}
+
+	counts, err := manager.GetUserSubmissionCounts(ctx, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetUserSubmissionCounts() failed: %v", err)
+	}
+
+	if len(counts) != 2 {
+		t.Fatalf("Expected counts for 2 users, got %v", counts)
+	}
+	if counts["U111"] != 2 {
+		t.Errorf("Expected 2 submissions for U111 since cutoff, got %d", counts["U111"])
+	}
+	if counts["U222"] != 1 {
+		t.Errorf("Expected 1 submission for U222, got %d", counts["U222"])
+	}
+	if _, counted := counts[""]; counted {
+		t.Error("Anonymous submissions should not be counted")
+	}
+}
+
+func TestDeleteSubmissionCascade(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	// Create a submission with a processed article and a linked assignment
+	submissionID, err := db.CreateNewsSubmission("U123456789", "Story that will be removed")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(38, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create weekly issue: %v", err)
+	}
+
+	articleID, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      submissionID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "general",
+		ProcessedContent:  `{"headline": "Test", "content": "Body", "byline": "Koco Kai"}`,
+		TemplateFormat:    "column",
+		ProcessingStatus:  ProcessingStatusSuccess,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	assignmentID, err := db.CreatePersonAssignment(PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U123456789",
+		ContentType: ContentTypeGeneral,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	if err := db.LinkSubmissionToAssignment(assignmentID, submissionID); err != nil {
+		t.Fatalf("Failed to link submission to assignment: %v", err)
+	}
+
+	if err := NewSubmissionManager(db.DB).UpdateSubmissionContent(context.Background(), submissionID, "U123456789", "Edited story"); err != nil {
+		t.Fatalf("Failed to edit submission: %v", err)
+	}
+	if err := db.AddSubmissionFile(submissionID, "F0123", "https://files.slack.com/F0123/shot.png", "image/png"); err != nil {
+		t.Fatalf("Failed to add submission file: %v", err)
+	}
+
+	// Test: Cascade delete the submission
+	if err := db.DeleteSubmissionCascade(submissionID); err != nil {
+		t.Fatalf("DeleteSubmissionCascade() failed: %v", err)
+	}
+
+	// Verify submission is gone
+	if _, err := db.GetSubmission(submissionID); err == nil {
+		t.Error("Expected submission to be deleted")
+	}
+
+	// Verify processed article is gone
+	if _, err := db.GetProcessedArticle(articleID); err == nil {
+		t.Error("Expected processed article to be deleted")
+	}
+
+	// Verify the edit history is gone
+	if revisions, err := db.GetSubmissionRevisions(submissionID); err != nil || len(revisions) != 0 {
+		t.Errorf("Expected submission revisions to be deleted, got %d (%v)", len(revisions), err)
+	}
+
+	// Verify the file references are gone
+	if files, err := db.GetFilesForSubmission(submissionID); err != nil || len(files) != 0 {
+		t.Errorf("Expected submission files to be deleted, got %d (%v)", len(files), err)
+	}
+
+	// Verify assignment remains but is unlinked
+	assignment, err := db.GetPersonAssignmentByID(assignmentID)
+	if err != nil {
+		t.Fatalf("Expected assignment to remain: %v", err)
+	}
+	if assignment.SubmissionID != nil {
+		t.Errorf("Expected assignment SubmissionID to be nil, got %d", *assignment.SubmissionID)
+	}
+
+	// Test: Deleting a missing submission returns an error
+	if err := db.DeleteSubmissionCascade(submissionID); err == nil {
+		t.Error("Expected error when deleting non-existent submission")
+	}
+}
+
+// TDD: Soft-deleted submissions drop out of listings and the newsletter but stay in the table
+func TestSoftDeleteSubmission(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	ctx := context.Background()
+	sm := NewSubmissionManager(db.DB)
+
+	kept, err := sm.CreateNewsSubmission(ctx, "U123456789", "Story that stays")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	removed, err := sm.CreateNewsSubmission(ctx, "U123456789", "Story that will be removed")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	issue, err := db.CreateWeeklyNewsletterIssue(38, 2025)
+	if err != nil {
+		t.Fatalf("Failed to create weekly issue: %v", err)
+	}
+	articleID, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:      removed.ID,
+		NewsletterIssueID: &issue.ID,
+		JournalistType:    "general",
+		ProcessedContent:  `{"headline": "Test", "content": "Body", "byline": "Koco Kai"}`,
+		TemplateFormat:    "column",
+		ProcessingStatus:  ProcessingStatusSuccess,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+	assignmentID, err := db.CreatePersonAssignment(PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U123456789",
+		ContentType: ContentTypeGeneral,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if err := db.LinkSubmissionToAssignment(assignmentID, removed.ID); err != nil {
+		t.Fatalf("Failed to link submission to assignment: %v", err)
+	}
+
+	if err := sm.DeleteSubmission(ctx, removed.ID); err != nil {
+		t.Fatalf("DeleteSubmission() failed: %v", err)
+	}
+
+	// Listings only show the remaining submission
+	all, err := sm.GetAllSubmissions(ctx)
+	if err != nil {
+		t.Fatalf("GetAllSubmissions() failed: %v", err)
+	}
+	if len(all) != 1 || all[0].ID != kept.ID {
+		t.Errorf("Expected only submission %d in GetAllSubmissions, got %+v", kept.ID, all)
+	}
+	byUser, err := sm.GetSubmissionsByUser(ctx, "U123456789")
+	if err != nil {
+		t.Fatalf("GetSubmissionsByUser() failed: %v", err)
+	}
+	if len(byUser) != 1 || byUser[0].ID != kept.ID {
+		t.Errorf("Expected only submission %d in GetSubmissionsByUser, got %+v", kept.ID, byUser)
+	}
+	if _, err := db.GetSubmission(removed.ID); err == nil {
+		t.Error("Expected GetSubmission to treat the soft-deleted submission as missing")
+	}
+
+	// The row itself is still there, stamped with when it was deleted
+	var content string
+	var deletedAt sql.NullTime
+	if err := db.QueryRow("SELECT content, deleted_at FROM submissions WHERE id = ?", removed.ID).Scan(&content, &deletedAt); err != nil {
+		t.Fatalf("Expected soft-deleted row to remain: %v", err)
+	}
+	if content != "Story that will be removed" || !deletedAt.Valid {
+		t.Errorf("Expected original content with deleted_at set, got %q (deleted_at valid: %v)", content, deletedAt.Valid)
+	}
+
+	// Its article is kept for history but superseded, and the assignment is open again
+	article, err := db.GetProcessedArticle(articleID)
+	if err != nil {
+		t.Fatalf("Expected processed article to remain: %v", err)
+	}
+	if article.ProcessingStatus != ProcessingStatusSuperseded {
+		t.Errorf("Expected article to be superseded, got %s", article.ProcessingStatus)
+	}
+	assignment, err := db.GetPersonAssignmentByID(assignmentID)
+	if err != nil {
+		t.Fatalf("Expected assignment to remain: %v", err)
+	}
+	if assignment.SubmissionID != nil {
+		t.Errorf("Expected assignment SubmissionID to be nil, got %d", *assignment.SubmissionID)
+	}
+
+	// Deleting twice reports the submission as missing
+	if err := sm.DeleteSubmission(ctx, removed.ID); err == nil {
+		t.Error("Expected error when deleting an already deleted submission")
+	}
+
+	deletedIDs, err := db.GetDeletedSubmissionIDsByUser("U123456789")
+	if err != nil {
+		t.Fatalf("GetDeletedSubmissionIDsByUser() failed: %v", err)
+	}
+	if len(deletedIDs) != 1 || deletedIDs[0] != removed.ID {
+		t.Errorf("Expected deleted IDs [%d], got %v", removed.ID, deletedIDs)
+	}
+
+	// A hard delete removes the soft-deleted row for good
+	if err := db.DeleteSubmissionCascade(removed.ID); err != nil {
+		t.Fatalf("DeleteSubmissionCascade() failed: %v", err)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM submissions WHERE id = ?", removed.ID).Scan(&count); err != nil {
+		t.Fatalf("Failed to count submissions: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected hard delete to remove the row, %d left", count)
+	}
+}
+
+// TDD: Promotable anonymous submissions are paged, and promoted or held ones drop out
+func TestGetPromotableAnonymousSubmissions(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	var ids []int
+	for _, content := range []string{"How do I sleep better?", "How do I say no to meetings?", "Is standing desk worth it?", "How do I switch off after work?"} {
+		submission, err := db.CreateAnonymousSubmission(content, "body_mind")
+		if err != nil {
+			t.Fatalf("Failed to create anonymous submission: %v", err)
+		}
+		ids = append(ids, submission.ID)
+	}
+	if _, err := db.CreateNewsSubmission("U123456789", "Not anonymous"); err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	if err := db.FlagSubmission(ids[3], "contains banned word"); err != nil {
+		t.Fatalf("Failed to flag submission: %v", err)
+	}
+
+	// Newest first, flagged and attributed submissions excluded
+	firstPage, err := db.GetPromotableAnonymousSubmissions(2, 0)
+	if err != nil {
+		t.Fatalf("GetPromotableAnonymousSubmissions() failed: %v", err)
+	}
+	if len(firstPage) != 2 || firstPage[0].ID != ids[2] || firstPage[1].ID != ids[1] {
+		t.Fatalf("Expected submissions %d and %d on the first page, got %+v", ids[2], ids[1], firstPage)
+	}
+	secondPage, err := db.GetPromotableAnonymousSubmissions(2, 2)
+	if err != nil {
+		t.Fatalf("GetPromotableAnonymousSubmissions() failed: %v", err)
+	}
+	if len(secondPage) != 1 || secondPage[0].ID != ids[0] {
+		t.Fatalf("Expected submission %d on the second page, got %+v", ids[0], secondPage)
+	}
+
+	if err := db.MarkSubmissionPromoted(ids[2]); err != nil {
+		t.Fatalf("MarkSubmissionPromoted() failed: %v", err)
+	}
+	remaining, err := db.GetPromotableAnonymousSubmissions(10, 0)
+	if err != nil {
+		t.Fatalf("GetPromotableAnonymousSubmissions() failed: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Errorf("Expected promoted submission to drop out, got %d remaining", len(remaining))
+	}
+
+	if err := db.MarkSubmissionPromoted(99999); err == nil {
+		t.Error("Expected an error marking a missing submission promoted")
+	}
+}
+
+// TDD: Only live submissions without a processed article are returned, oldest first
+func TestSubmissionManager_GetSubmissionsWithoutProcessedArticle(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+
+	db, err := NewSimple(dbPath)
+	if err != nil {
+		t.Fatalf("NewSimple() failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate() failed: %v", err)
+	}
+
+	ctx := context.Background()
+	sm := NewSubmissionManager(db.DB)
+
+	var ids []int
+	for _, content := range []string{"Processed story", "Story the server lost", "Deleted story", "Flagged story", "Failed story", "Story whose article was deleted"} {
+		submission, err := sm.CreateNewsSubmission(ctx, "U123456789", content)
+		if err != nil {
+			t.Fatalf("Failed to create submission: %v", err)
+		}
+		ids = append(ids, submission.ID)
+	}
+
+	if _, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:     ids[0],
+		JournalistType:   "general",
+		ProcessedContent: `{"headline": "Test", "content": "Body", "byline": "Koco Kai"}`,
+		TemplateFormat:   "column",
+		ProcessingStatus: ProcessingStatusSuccess,
+	}); err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+	if err := sm.DeleteSubmission(ctx, ids[2]); err != nil {
+		t.Fatalf("DeleteSubmission() failed: %v", err)
+	}
+	if err := db.FlagSubmission(ids[3], "contains banned word"); err != nil {
+		t.Fatalf("Failed to flag submission: %v", err)
+	}
+	if err := db.RecordDeadLetter(ids[4], "rate limited", 1); err != nil {
+		t.Fatalf("Failed to record dead letter: %v", err)
+	}
+	removedArticleID, err := db.CreateProcessedArticle(ProcessedArticle{
+		SubmissionID:     ids[5],
+		JournalistType:   "general",
+		ProcessedContent: `{"headline": "Taken out", "content": "Body", "byline": "Koco Kai"}`,
+		TemplateFormat:   "column",
+		ProcessingStatus: ProcessingStatusSuccess,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+	if err := db.DeleteProcessedArticle(removedArticleID); err != nil {
+		t.Fatalf("DeleteProcessedArticle() failed: %v", err)
+	}
+
+	backlog, err := sm.GetSubmissionsWithoutProcessedArticle(ctx)
+	if err != nil {
+		t.Fatalf("GetSubmissionsWithoutProcessedArticle() failed: %v", err)
+	}
+	if len(backlog) != 1 || backlog[0].ID != ids[1] {
+		t.Fatalf("Expected only submission %d without an article, got %+v", ids[1], backlog)
+	}
+	if backlog[0].Content != "Story the server lost" {
+		t.Errorf("Expected submission content to be loaded, got %q", backlog[0].Content)
+	}
+}
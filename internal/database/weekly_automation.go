@@ -2,14 +2,18 @@ package database
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/mattn/go-sqlite3"
 )
 
 // CreateWeeklyNewsletterIssue creates a new newsletter issue for the specified week
 func (db *DB) CreateWeeklyNewsletterIssue(weekNumber, year int) (*WeeklyNewsletterIssue, error) {
 	// Calculate publication date (Thursday of the given week)
-	publicationDate := getThursdayOfWeek(weekNumber, year)
+	publicationDate := getThursdayOfWeek(weekNumber, year, db.Location())
 
 	query := `
 		INSERT INTO newsletter_issues (
@@ -42,7 +46,7 @@ func (db *DB) CreateWeeklyNewsletterIssue(weekNumber, year int) (*WeeklyNewslett
 // GetWeeklyNewsletterIssue retrieves a newsletter issue by ID
 func (db *DB) GetWeeklyNewsletterIssue(id int) (*WeeklyNewsletterIssue, error) {
 	query := `
-		SELECT id, week_number, year, title, content, status, publication_date, published_at, created_at
+		SELECT id, week_number, year, title, content, theme, status, publication_date, published_at, created_at
 		FROM newsletter_issues 
 		WHERE id = ?`
 
@@ -60,6 +64,7 @@ func (db *DB) GetWeeklyNewsletterIssue(id int) (*WeeklyNewsletterIssue, error) {
 		&year,
 		&issue.Title,
 		&issue.Content,
+		&issue.Theme,
 		&status,
 		&issue.PublicationDate,
 		&publishedAt,
@@ -88,9 +93,201 @@ func (db *DB) GetWeeklyNewsletterIssue(id int) (*WeeklyNewsletterIssue, error) {
 		issue.PublishedAt = &publishedAt.Time
 	}
 
+	// Present the stored instant in the publication time zone so the wall clock reads 09:30
+	issue.PublicationDate = issue.PublicationDate.In(db.Location())
+
 	return &issue, nil
 }
 
+// GetWeeklyIssueByWeek retrieves the newsletter issue for a specific week without creating it
+func (db *DB) GetWeeklyIssueByWeek(weekNumber, year int) (*WeeklyNewsletterIssue, error) {
+	query := `
+		SELECT id FROM newsletter_issues 
+		WHERE week_number = ? AND year = ?
+		LIMIT 1`
+
+	var id int
+	if err := db.QueryRow(query, weekNumber, year).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no newsletter issue for week %d, %d", weekNumber, year)
+		}
+		return nil, fmt.Errorf("failed to get newsletter issue for week: %w", err)
+	}
+
+	return db.GetWeeklyNewsletterIssue(id)
+}
+
+// GetIssueByPublicationDate retrieves the newsletter issue published on date's calendar day,
+// read in the publication time zone. The time of day of date is ignored.
+func (db *DB) GetIssueByPublicationDate(date time.Time) (*WeeklyNewsletterIssue, error) {
+	year, month, day := date.Date()
+	dayStart := time.Date(year, month, day, 0, 0, 0, 0, db.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	query := `
+		SELECT id FROM newsletter_issues
+		WHERE datetime(publication_date) >= datetime(?) AND datetime(publication_date) < datetime(?)
+		ORDER BY id
+		LIMIT 1`
+
+	var id int
+	err := db.QueryRow(query,
+		dayStart.UTC().Format(sqliteTimestampLayout),
+		dayEnd.UTC().Format(sqliteTimestampLayout),
+	).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no newsletter issue published on %s", dayStart.Format("2006-01-02"))
+		}
+		return nil, fmt.Errorf("failed to get newsletter issue by publication date: %w", err)
+	}
+
+	return db.GetWeeklyNewsletterIssue(id)
+}
+
+// GetWeeklyIssuesByStatus retrieves all newsletter issues with a status, newest week first
+func (db *DB) GetWeeklyIssuesByStatus(status NewsletterIssueStatus) ([]WeeklyNewsletterIssue, error) {
+	query := `
+		SELECT id FROM newsletter_issues
+		WHERE status = ?
+		ORDER BY year DESC, week_number DESC, id DESC`
+
+	rows, err := db.Query(query, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query newsletter issues by status: %w", err)
+	}
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan newsletter issue ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, fmt.Errorf("error iterating newsletter issues: %w", err)
+	}
+	rows.Close()
+
+	// Reuse GetWeeklyNewsletterIssue so nullable columns are handled in one place
+	var issues []WeeklyNewsletterIssue
+	for _, id := range ids {
+		issue, err := db.GetWeeklyNewsletterIssue(id)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, *issue)
+	}
+
+	return issues, nil
+}
+
+// SetIssueTheme sets the theme of a newsletter issue; an empty theme clears it
+func (db *DB) SetIssueTheme(issueID int, theme string) error {
+	result, err := db.Exec("UPDATE newsletter_issues SET theme = ? WHERE id = ?", strings.TrimSpace(theme), issueID)
+	if err != nil {
+		return fmt.Errorf("failed to set newsletter issue theme: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("newsletter issue with ID %d not found", issueID)
+	}
+
+	return nil
+}
+
+// PublishIssue marks a newsletter issue as published, which makes it public and locks its content.
+// It reports false when the issue was already published, keeping the original publish time.
+func (db *DB) PublishIssue(issueID int) (bool, error) {
+	if _, err := db.GetWeeklyNewsletterIssue(issueID); err != nil {
+		return false, err
+	}
+
+	result, err := db.Exec(
+		"UPDATE newsletter_issues SET status = ?, published_at = ? WHERE id = ? AND status != ?",
+		IssueStatusPublished, time.Now(), issueID, IssueStatusPublished,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to publish newsletter issue: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// GetPublishedIssueForSubmission returns the published issue a submission appears in, through its
+// articles or the assignment it answered, or nil when it isn't part of one
+func (db *DB) GetPublishedIssueForSubmission(submissionID int) (*WeeklyNewsletterIssue, error) {
+	return db.getPublishedIssue(`
+		SELECT id FROM newsletter_issues
+		WHERE status = ? AND (
+			id IN (SELECT newsletter_issue_id FROM processed_articles WHERE submission_id = ?)
+			OR id IN (SELECT issue_id FROM person_assignments WHERE submission_id = ?)
+		)
+		ORDER BY id
+		LIMIT 1`,
+		IssueStatusPublished, submissionID, submissionID)
+}
+
+// GetPublishedIssueForUser returns a published issue holding one of the user's assignments or
+// articles written from their submissions, or nil when there is none
+func (db *DB) GetPublishedIssueForUser(userID string) (*WeeklyNewsletterIssue, error) {
+	return db.getPublishedIssue(`
+		SELECT id FROM newsletter_issues
+		WHERE status = ? AND (
+			id IN (
+				SELECT pa.newsletter_issue_id FROM processed_articles pa
+				JOIN submissions s ON s.id = pa.submission_id
+				WHERE s.user_id = ?
+			)
+			OR id IN (SELECT issue_id FROM person_assignments WHERE person_id = ?)
+		)
+		ORDER BY id
+		LIMIT 1`,
+		IssueStatusPublished, userID, userID)
+}
+
+// getPublishedIssue loads the issue whose ID query selects, or returns nil when it selects none
+func (db *DB) getPublishedIssue(query string, args ...interface{}) (*WeeklyNewsletterIssue, error) {
+	var id int
+	if err := db.QueryRow(query, args...).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to check for published issue: %w", err)
+	}
+
+	return db.GetWeeklyNewsletterIssue(id)
+}
+
+// UpdateWeeklyIssueContent replaces the editorial content (the "In this issue" intro) of a newsletter issue
+func (db *DB) UpdateWeeklyIssueContent(issueID int, content string) error {
+	result, err := db.Exec("UPDATE newsletter_issues SET content = ? WHERE id = ?", content, issueID)
+	if err != nil {
+		return fmt.Errorf("failed to update newsletter issue content: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("newsletter issue with ID %d not found", issueID)
+	}
+
+	return nil
+}
+
 // GetOrCreateWeeklyIssue gets the newsletter issue for a specific week, creating it if it doesn't exist
 func (db *DB) GetOrCreateWeeklyIssue(weekNumber, year int) (*WeeklyNewsletterIssue, error) {
 	// Try to find existing issue first
@@ -109,16 +306,86 @@ func (db *DB) GetOrCreateWeeklyIssue(weekNumber, year int) (*WeeklyNewsletterIss
 	}
 
 	// Issue doesn't exist, create it
-	return db.CreateWeeklyNewsletterIssue(weekNumber, year)
+	issue, err := db.CreateWeeklyNewsletterIssue(weekNumber, year)
+	if err != nil {
+		// Another caller created the week's issue between our check and insert; use theirs
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+			if err := db.QueryRow(query, weekNumber, year).Scan(&existingID); err != nil {
+				return nil, fmt.Errorf("failed to get concurrently created issue: %w", err)
+			}
+			return db.GetWeeklyNewsletterIssue(existingID)
+		}
+		return nil, err
+	}
+	return issue, nil
 }
 
-// CreatePersonAssignment creates a new person assignment for a newsletter issue
+// ErrAssignmentSlotFilled is returned when an issue has no free slot left for a content type
+var ErrAssignmentSlotFilled = errors.New("assignment slot already filled")
+
+// CreatePersonAssignment creates a new person assignment for a newsletter issue,
+// enforcing one assignment per person and the configured slots per content type
 func (db *DB) CreatePersonAssignment(assignment PersonAssignment) (int, error) {
 	// Validate the assignment before inserting
 	if err := assignment.Validate(); err != nil {
 		return 0, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Check and insert in one transaction so two concurrent assigns can't both pass the checks
+	var id int64
+	err := db.WithTx(func(tx *sql.Tx) error {
+		if err := db.checkAssignmentAvailable(tx, assignment); err != nil {
+			return err
+		}
+
+		query := `
+			INSERT INTO person_assignments (
+				issue_id, person_id, content_type, question_id, submission_id, assigned_at
+			) VALUES (?, ?, ?, ?, ?, ?)`
+
+		result, err := tx.Exec(query,
+			assignment.IssueID,
+			assignment.PersonID,
+			assignment.ContentType,
+			assignment.QuestionID,
+			assignment.SubmissionID,
+			assignment.AssignedAt,
+		)
+		if err != nil {
+			// The unique index catches a duplicate the check missed, e.g. one from another process
+			var sqliteErr sqlite3.Error
+			if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+				return fmt.Errorf("user %s already has an assignment for this week (issue ID: %d)",
+					assignment.PersonID, assignment.IssueID)
+			}
+			return fmt.Errorf("failed to create person assignment: %w", err)
+		}
+
+		id, err = result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get assignment ID: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+// CheckAssignmentAvailable reports whether assignment could be created right now: the person has no
+// assignment in the issue yet and a slot of its content type is free. Callers that use up a question
+// for an assignment check first, so a rejected assignment doesn't cost a question.
+func (db *DB) CheckAssignmentAvailable(assignment PersonAssignment) error {
+	return db.WithTx(func(tx *sql.Tx) error {
+		return db.checkAssignmentAvailable(tx, assignment)
+	})
+}
+
+// checkAssignmentAvailable runs the one-per-person and slot checks of CreatePersonAssignment in tx
+func (db *DB) checkAssignmentAvailable(tx *sql.Tx, assignment PersonAssignment) error {
 	// Check for existing assignments for this user in the same issue
 	checkQuery := `
 		SELECT COUNT(*) 
@@ -126,39 +393,33 @@ func (db *DB) CreatePersonAssignment(assignment PersonAssignment) (int, error) {
 		WHERE issue_id = ? AND person_id = ?`
 
 	var count int
-	err := db.QueryRow(checkQuery, assignment.IssueID, assignment.PersonID).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("failed to check existing assignments: %w", err)
+	if err := tx.QueryRow(checkQuery, assignment.IssueID, assignment.PersonID).Scan(&count); err != nil {
+		return fmt.Errorf("failed to check existing assignments: %w", err)
 	}
 
 	if count > 0 {
-		return 0, fmt.Errorf("user %s already has an assignment for this week (issue ID: %d)",
+		return fmt.Errorf("user %s already has an assignment for this week (issue ID: %d)",
 			assignment.PersonID, assignment.IssueID)
 	}
 
-	query := `
-		INSERT INTO person_assignments (
-			issue_id, person_id, content_type, question_id, submission_id, assigned_at
-		) VALUES (?, ?, ?, ?, ?, ?)`
+	// Check the issue still has a free slot for this content type
+	if limit, limited := db.assignmentSlots[assignment.ContentType]; limited {
+		slotQuery := `
+			SELECT COUNT(*)
+			FROM person_assignments
+			WHERE issue_id = ? AND content_type = ?`
 
-	result, err := db.Exec(query,
-		assignment.IssueID,
-		assignment.PersonID,
-		assignment.ContentType,
-		assignment.QuestionID,
-		assignment.SubmissionID,
-		assignment.AssignedAt,
-	)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create person assignment: %w", err)
-	}
+		var filled int
+		if err := tx.QueryRow(slotQuery, assignment.IssueID, assignment.ContentType).Scan(&filled); err != nil {
+			return fmt.Errorf("failed to check assignment slots: %w", err)
+		}
 
-	id, err := result.LastInsertId()
-	if err != nil {
-		return 0, fmt.Errorf("failed to get assignment ID: %w", err)
+		if filled >= limit {
+			return fmt.Errorf("%s slot already filled for this week (%d of %d, issue ID: %d): %w",
+				assignment.ContentType, filled, limit, assignment.IssueID, ErrAssignmentSlotFilled)
+		}
 	}
-
-	return int(id), nil
+	return nil
 }
 
 // GetPersonAssignmentsByIssue retrieves all person assignments for a specific newsletter issue
@@ -178,6 +439,136 @@ func (db *DB) GetPersonAssignmentsByIssue(issueID int) ([]PersonAssignment, erro
 	return db.scanPersonAssignments(rows)
 }
 
+// GetUnsubmittedAssignments retrieves the assignments for an issue that have no linked submission yet
+func (db *DB) GetUnsubmittedAssignments(issueID int) ([]PersonAssignment, error) {
+	query := `
+		SELECT id, issue_id, person_id, content_type, question_id, submission_id, assigned_at, created_at
+		FROM person_assignments 
+		WHERE issue_id = ? AND submission_id IS NULL
+		ORDER BY created_at ASC`
+
+	rows, err := db.Query(query, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unsubmitted assignments: %w", err)
+	}
+	defer rows.Close()
+
+	return db.scanPersonAssignments(rows)
+}
+
+// IssueCompletion counts how many of an issue's assignments have a linked submission
+func (db *DB) IssueCompletion(issueID int) (submitted, total int, err error) {
+	query := `
+		SELECT COUNT(submission_id), COUNT(*)
+		FROM person_assignments
+		WHERE issue_id = ?`
+
+	if err := db.QueryRow(query, issueID).Scan(&submitted, &total); err != nil {
+		return 0, 0, fmt.Errorf("failed to count assignment completion: %w", err)
+	}
+
+	return submitted, total, nil
+}
+
+// GetContentTypeDistribution counts the assignments handed out since the given time by content type
+func (db *DB) GetContentTypeDistribution(since time.Time) (map[ContentType]int, error) {
+	return db.contentTypeDistribution(since, false)
+}
+
+// GetSubmittedContentTypeDistribution is GetContentTypeDistribution limited to assignments with a submission
+func (db *DB) GetSubmittedContentTypeDistribution(since time.Time) (map[ContentType]int, error) {
+	return db.contentTypeDistribution(since, true)
+}
+
+func (db *DB) contentTypeDistribution(since time.Time, submittedOnly bool) (map[ContentType]int, error) {
+	query := `
+		SELECT content_type, COUNT(*)
+		FROM person_assignments
+		WHERE datetime(assigned_at) >= datetime(?)`
+	if submittedOnly {
+		query += ` AND submission_id IS NOT NULL`
+	}
+	query += `
+		GROUP BY content_type`
+
+	rows, err := db.Query(query, since.UTC().Format(sqliteTimestampLayout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query content type distribution: %w", err)
+	}
+	defer rows.Close()
+
+	distribution := make(map[ContentType]int)
+	for rows.Next() {
+		var contentType ContentType
+		var count int
+		if err := rows.Scan(&contentType, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan content type count: %w", err)
+		}
+		distribution[contentType] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over content type counts: %w", err)
+	}
+
+	return distribution, nil
+}
+
+// GetStaleAssignments retrieves unsubmitted assignments handed out before olderThan, oldest week first
+func (db *DB) GetStaleAssignments(olderThan time.Time) ([]PersonAssignment, error) {
+	query := `
+		SELECT pa.id, pa.issue_id, pa.person_id, pa.content_type, pa.question_id, pa.submission_id, pa.assigned_at, pa.created_at
+		FROM person_assignments pa
+		JOIN newsletter_issues ni ON ni.id = pa.issue_id
+		WHERE pa.submission_id IS NULL AND datetime(pa.assigned_at) < datetime(?)
+		ORDER BY ni.year ASC, ni.week_number ASC, pa.assigned_at ASC`
+
+	rows, err := db.Query(query, olderThan.UTC().Format(sqliteTimestampLayout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale assignments: %w", err)
+	}
+	defer rows.Close()
+
+	return db.scanPersonAssignments(rows)
+}
+
+// DeleteStaleAssignments deletes the assignments GetStaleAssignments would return and reports how many were removed
+func (db *DB) DeleteStaleAssignments(olderThan time.Time) (int, error) {
+	result, err := db.Exec(`
+		DELETE FROM person_assignments
+		WHERE submission_id IS NULL AND datetime(assigned_at) < datetime(?)
+		  AND issue_id IN (SELECT id FROM newsletter_issues)`,
+		olderThan.UTC().Format(sqliteTimestampLayout),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale assignments: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// GetAssignmentsByWeek retrieves all assignments for the newsletter issue of a given week and year
+func (db *DB) GetAssignmentsByWeek(weekNumber, year int) ([]PersonAssignment, error) {
+	query := `
+		SELECT pa.id, pa.issue_id, pa.person_id, pa.content_type, pa.question_id, pa.submission_id, pa.assigned_at, pa.created_at
+		FROM person_assignments pa
+		JOIN newsletter_issues ni ON ni.id = pa.issue_id
+		WHERE ni.week_number = ? AND ni.year = ?
+		ORDER BY pa.created_at ASC`
+
+	rows, err := db.Query(query, weekNumber, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignments for week: %w", err)
+	}
+	defer rows.Close()
+
+	return db.scanPersonAssignments(rows)
+}
+
 // GetActiveAssignmentByUser retrieves a person's active assignment for current week by content type
 func (db *DB) GetActiveAssignmentByUser(userID string, contentType ContentType) (*PersonAssignment, error) {
 	// Get current week's issue
@@ -207,6 +598,29 @@ func (db *DB) GetActiveAssignmentByUser(userID string, contentType ContentType)
 	return assignment, nil
 }
 
+// GetAssignmentForUserInWeek retrieves a person's most recent assignment in the given ISO week
+// that has no submission linked yet
+func (db *DB) GetAssignmentForUserInWeek(userID string, week, year int) (*PersonAssignment, error) {
+	query := `
+		SELECT pa.id, pa.issue_id, pa.person_id, pa.content_type, pa.question_id, pa.submission_id, pa.assigned_at, pa.created_at
+		FROM person_assignments pa
+		JOIN newsletter_issues ni ON ni.id = pa.issue_id
+		WHERE pa.person_id = ? AND ni.week_number = ? AND ni.year = ? AND pa.submission_id IS NULL
+		ORDER BY pa.created_at DESC, pa.id DESC
+		LIMIT 1`
+
+	row := db.QueryRow(query, userID, week, year)
+	assignment, err := db.scanSinglePersonAssignment(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no open assignment found for user %s in week %d, %d", userID, week, year)
+		}
+		return nil, fmt.Errorf("failed to get assignment for week: %w", err)
+	}
+
+	return assignment, nil
+}
+
 // GetAssignmentsByUserAndIssue retrieves all assignments for a user in a specific issue
 func (db *DB) GetAssignmentsByUserAndIssue(userID string, issueID int) ([]PersonAssignment, error) {
 	query := `
@@ -306,6 +720,89 @@ func (db *DB) LinkSubmissionToAssignment(assignmentID, submissionID int) error {
 	return nil
 }
 
+// UpdateAssignmentQuestion swaps the question of an assignment that has no submission linked yet
+func (db *DB) UpdateAssignmentQuestion(assignmentID, questionID int) error {
+	query := `
+		UPDATE person_assignments
+		SET question_id = ?
+		WHERE id = ? AND submission_id IS NULL`
+
+	result, err := db.Exec(query, questionID, assignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to update assignment question: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no open assignment with ID %d", assignmentID)
+	}
+
+	return nil
+}
+
+// AcceptAssignment records that userID acknowledged their assignment. Returns false when it was
+// already acknowledged, and an error when the assignment doesn't exist or belongs to someone else.
+func (db *DB) AcceptAssignment(assignmentID int, userID string) (bool, error) {
+	result, err := db.Exec(
+		"UPDATE person_assignments SET accepted_at = CURRENT_TIMESTAMP WHERE id = ? AND person_id = ? AND accepted_at IS NULL",
+		assignmentID, userID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to accept assignment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return true, nil
+	}
+
+	var exists int
+	err = db.QueryRow("SELECT 1 FROM person_assignments WHERE id = ? AND person_id = ?", assignmentID, userID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return false, fmt.Errorf("assignment with ID %d not found for user %s", assignmentID, userID)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check assignment: %w", err)
+	}
+
+	return false, nil
+}
+
+// GetAssignmentAcceptances returns when each acknowledged assignment of an issue was accepted, by assignment ID
+func (db *DB) GetAssignmentAcceptances(issueID int) (map[int]time.Time, error) {
+	rows, err := db.Query(
+		"SELECT id, accepted_at FROM person_assignments WHERE issue_id = ? AND accepted_at IS NOT NULL",
+		issueID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignment acceptances: %w", err)
+	}
+	defer rows.Close()
+
+	acceptances := make(map[int]time.Time)
+	for rows.Next() {
+		var id int
+		var acceptedAt time.Time
+		if err := rows.Scan(&id, &acceptedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan assignment acceptance: %w", err)
+		}
+		acceptances[id] = acceptedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over assignment acceptances: %w", err)
+	}
+
+	return acceptances, nil
+}
+
 // GetPersonAssignmentByID retrieves a specific person assignment by ID
 func (db *DB) GetPersonAssignmentByID(assignmentID int) (*PersonAssignment, error) {
 	query := `
@@ -325,6 +822,72 @@ func (db *DB) GetPersonAssignmentByID(assignmentID int) (*PersonAssignment, erro
 	return assignment, nil
 }
 
+// GetAssignmentWithQuestion retrieves an assignment together with its question text.
+// Body/mind assignments come from the anonymous pool and have no regular question, so the
+// returned question is nil for them.
+func (db *DB) GetAssignmentWithQuestion(assignmentID int) (*PersonAssignment, *Question, error) {
+	query := `
+		SELECT pa.id, pa.issue_id, pa.person_id, pa.content_type, pa.question_id, pa.submission_id,
+		       pa.assigned_at, pa.created_at, q.text, q.category, q.last_used_at, q.created_at
+		FROM person_assignments pa
+		LEFT JOIN questions q ON q.id = pa.question_id
+		WHERE pa.id = ?`
+
+	var assignment PersonAssignment
+	var questionID, submissionID sql.NullInt64
+	var questionText, questionCategory sql.NullString
+	var questionLastUsed, questionCreated sql.NullTime
+
+	err := db.QueryRow(query, assignmentID).Scan(
+		&assignment.ID,
+		&assignment.IssueID,
+		&assignment.PersonID,
+		&assignment.ContentType,
+		&questionID,
+		&submissionID,
+		&assignment.AssignedAt,
+		&assignment.CreatedAt,
+		&questionText,
+		&questionCategory,
+		&questionLastUsed,
+		&questionCreated,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("assignment with ID %d not found", assignmentID)
+		}
+		return nil, nil, fmt.Errorf("failed to get assignment with question: %w", err)
+	}
+
+	if submissionID.Valid {
+		sid := int(submissionID.Int64)
+		assignment.SubmissionID = &sid
+	}
+	if !questionID.Valid {
+		return &assignment, nil, nil
+	}
+
+	qid := int(questionID.Int64)
+	assignment.QuestionID = &qid
+	if !questionText.Valid {
+		// The question was deleted after the assignment was made
+		return &assignment, nil, nil
+	}
+
+	question := &Question{
+		ID:        qid,
+		Text:      questionText.String,
+		Category:  questionCategory.String,
+		CreatedAt: questionCreated.Time,
+	}
+	if questionLastUsed.Valid {
+		lastUsed := questionLastUsed.Time
+		question.LastUsedAt = &lastUsed
+	}
+
+	return &assignment, question, nil
+}
+
 // CreateBodyMindQuestion creates a new anonymous body/mind question for the pool
 func (db *DB) CreateBodyMindQuestion(questionText, category string) (int, error) {
 	query := `
@@ -390,6 +953,29 @@ func (db *DB) MarkBodyMindQuestionUsed(questionID int) error {
 	return nil
 }
 
+// ArchiveUsedQuestionsOlderThan archives used body/mind questions whose used_at is more than d ago,
+// keeping them out of the pool for good, and reports how many were archived
+func (db *DB) ArchiveUsedQuestionsOlderThan(d time.Duration) (int, error) {
+	cutoff := time.Now().Add(-d).UTC().Format(sqliteTimestampLayout)
+
+	result, err := db.Exec(`
+		UPDATE body_mind_questions
+		SET status = 'archived'
+		WHERE status = 'used' AND used_at IS NOT NULL AND datetime(used_at) < datetime(?)`,
+		cutoff,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive used body/mind questions: %w", err)
+	}
+
+	archived, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(archived), nil
+}
+
 // AddPersonRotationHistory adds an entry to track assignment history
 func (db *DB) AddPersonRotationHistory(personID string, contentType ContentType, weekNumber, year int) error {
 	query := `
@@ -406,25 +992,22 @@ func (db *DB) AddPersonRotationHistory(personID string, contentType ContentType,
 
 // GetPersonRotationHistory retrieves recent assignment history for intelligent rotation
 func (db *DB) GetPersonRotationHistory(personID string, contentType ContentType, weeksBack int) ([]PersonRotationHistory, error) {
-	// Calculate the week range to check
-	currentWeek, currentYear := getCurrentWeekAndYear()
-	startWeek := currentWeek - weeksBack
-	startYear := currentYear
+	return db.getPersonRotationHistoryAsOf(personID, contentType, weeksBack, time.Now().In(db.Location()))
+}
 
-	// Handle year boundary (simplified - assumes we don't go back more than 1 year)
-	if startWeek <= 0 {
-		startWeek += 52
-		startYear--
-	}
+// getPersonRotationHistoryAsOf returns history from the ISO week weeksBack weeks before now up to now's ISO week
+func (db *DB) getPersonRotationHistoryAsOf(personID string, contentType ContentType, weeksBack int, now time.Time) ([]PersonRotationHistory, error) {
+	startWeek, startYear, currentWeek, currentYear := rotationWindow(now, weeksBack)
 
 	query := `
 		SELECT id, person_id, content_type, week_number, year, created_at
 		FROM person_rotation_history 
 		WHERE person_id = ? AND content_type = ? 
-		AND ((year = ? AND week_number >= ?) OR (year = ? AND week_number <= ?))
+		AND (year > ? OR (year = ? AND week_number >= ?))
+		AND (year < ? OR (year = ? AND week_number <= ?))
 		ORDER BY year DESC, week_number DESC`
 
-	rows, err := db.Query(query, personID, contentType, startYear, startWeek, currentYear, currentWeek)
+	rows, err := db.Query(query, personID, contentType, startYear, startYear, startWeek, currentYear, currentYear, currentWeek)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query person rotation history: %w", err)
 	}
@@ -455,6 +1038,86 @@ func (db *DB) GetPersonRotationHistory(personID string, contentType ContentType,
 	return history, nil
 }
 
+// GetAssignmentFairness counts assignments of a content type per person over the issues of the last
+// weeksBack weeks. It counts the assignments themselves, so manual assignments are included too.
+func (db *DB) GetAssignmentFairness(contentType ContentType, weeksBack int) (map[string]int, error) {
+	return db.getAssignmentFairnessAsOf(contentType, weeksBack, time.Now().In(db.Location()))
+}
+
+func (db *DB) getAssignmentFairnessAsOf(contentType ContentType, weeksBack int, now time.Time) (map[string]int, error) {
+	startWeek, startYear, currentWeek, currentYear := rotationWindow(now, weeksBack)
+
+	query := `
+		SELECT pa.person_id, COUNT(*)
+		FROM person_assignments pa
+		JOIN newsletter_issues ni ON ni.id = pa.issue_id
+		WHERE pa.content_type = ?
+		AND (ni.year > ? OR (ni.year = ? AND ni.week_number >= ?))
+		AND (ni.year < ? OR (ni.year = ? AND ni.week_number <= ?))
+		GROUP BY pa.person_id`
+
+	rows, err := db.Query(query, contentType, startYear, startYear, startWeek, currentYear, currentYear, currentWeek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignment fairness: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var personID string
+		var count int
+		if err := rows.Scan(&personID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan assignment fairness: %w", err)
+		}
+		counts[personID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over assignment fairness: %w", err)
+	}
+
+	return counts, nil
+}
+
+// SelectNextPersonForRotation picks the candidate who has waited longest for the content type,
+// looking weeksBack weeks into the rotation history. Candidates never assigned in that window
+// come first; ties go to the fewest recent assignments, then to candidate order. Candidates in
+// exclude are skipped.
+func (db *DB) SelectNextPersonForRotation(candidates []string, contentType ContentType, weeksBack int, exclude map[string]bool) (string, error) {
+	return db.selectNextPersonForRotationAsOf(candidates, contentType, weeksBack, exclude, time.Now().In(db.Location()))
+}
+
+func (db *DB) selectNextPersonForRotationAsOf(candidates []string, contentType ContentType, weeksBack int, exclude map[string]bool, now time.Time) (string, error) {
+	best := ""
+	bestCount, bestLast := 0, 0
+	for _, personID := range candidates {
+		if exclude[personID] {
+			continue
+		}
+
+		history, err := db.getPersonRotationHistoryAsOf(personID, contentType, weeksBack, now)
+		if err != nil {
+			return "", err
+		}
+
+		// History is newest first; encode the latest week as year*100+week so it sorts, 0 = never
+		last := 0
+		if len(history) > 0 {
+			last = history[0].Year*100 + history[0].WeekNumber
+		}
+
+		if best == "" || last < bestLast || (last == bestLast && len(history) < bestCount) {
+			best, bestCount, bestLast = personID, len(history), last
+		}
+	}
+
+	if best == "" {
+		return "", fmt.Errorf("no candidate available for %s rotation", contentType)
+	}
+
+	return best, nil
+}
+
 // Helper functions
 
 // scanPersonAssignments scans rows into PersonAssignment structs
@@ -571,10 +1234,11 @@ func (db *DB) scanBodyMindQuestions(rows *sql.Rows) ([]BodyMindQuestion, error)
 	return questions, nil
 }
 
-// getThursdayOfWeek calculates the Thursday of a given ISO week
-func getThursdayOfWeek(weekNumber, year int) time.Time {
+// getThursdayOfWeek calculates the Thursday of a given ISO week at 09:30 local time in loc.
+// AddDate keeps the wall clock fixed, so DST transitions don't shift the publication time.
+func getThursdayOfWeek(weekNumber, year int, loc *time.Location) time.Time {
 	// January 4th is always in week 1 of ISO week numbering
-	jan4 := time.Date(year, 1, 4, 9, 30, 0, 0, time.UTC)
+	jan4 := time.Date(year, 1, 4, 9, 30, 0, 0, loc)
 
 	// Find the Monday of week 1
 	daysFromMonday := int(jan4.Weekday()) - 1
@@ -592,9 +1256,10 @@ func getThursdayOfWeek(weekNumber, year int) time.Time {
 	return thursday
 }
 
-// getCurrentWeekAndYear returns the current ISO week number and year
-func getCurrentWeekAndYear() (int, int) {
-	now := time.Now()
-	year, week := now.ISOWeek()
-	return week, year
+// rotationWindow returns the ISO weeks bounding a lookback of weeksBack weeks from now.
+// Stepping back by whole days lets the time package handle year boundaries and 53-week years.
+func rotationWindow(now time.Time, weeksBack int) (startWeek, startYear, endWeek, endYear int) {
+	endYear, endWeek = now.ISOWeek()
+	startYear, startWeek = now.AddDate(0, 0, -7*weeksBack).ISOWeek()
+	return startWeek, startYear, endWeek, endYear
 }
@@ -0,0 +1,74 @@
+// Package metrics defines the Prometheus metrics the newsletter service exports at /metrics.
+package metrics
+
+import (
+	"log/slog"
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Result labels for AIProcessing
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+var (
+	// SubmissionsReceived counts stored submissions by category
+	SubmissionsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "newsletter_submissions_received_total",
+		Help: "Submissions received, by category.",
+	}, []string{"category"})
+
+	// AIProcessing counts finished AI processing attempts by result
+	AIProcessing = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "newsletter_ai_processing_total",
+		Help: "AI processing attempts, by result.",
+	}, []string{"result"})
+
+	// AIJobsInFlight tracks background AI processing jobs that have started but not finished
+	AIJobsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "newsletter_ai_jobs_in_flight",
+		Help: "Background AI processing jobs currently running.",
+	})
+)
+
+// RecordAIResult counts an AI processing attempt as a success or failure
+func RecordAIResult(err error) {
+	if err != nil {
+		AIProcessing.WithLabelValues(ResultFailure).Inc()
+		return
+	}
+	AIProcessing.WithLabelValues(ResultSuccess).Inc()
+}
+
+// NewRegistry returns a registry with the newsletter metrics and the standard Go and
+// process collectors. poolSize is called on every scrape to report the body/mind pool size.
+func NewRegistry(poolSize func() (int, error)) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		SubmissionsReceived,
+		AIProcessing,
+		AIJobsInFlight,
+	)
+
+	if poolSize != nil {
+		registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "newsletter_bodymind_pool_size",
+			Help: "Active questions in the body/mind pool.",
+		}, func() float64 {
+			size, err := poolSize()
+			if err != nil {
+				slog.Warn("Failed to read body/mind pool size for metrics", "error", err)
+				return math.NaN()
+			}
+			return float64(size)
+		}))
+	}
+
+	return registry
+}
@@ -1,17 +1,27 @@
 package server
 
 import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/config"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/metrics"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/slack"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/templates"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type Server struct {
@@ -21,6 +31,10 @@ type Server struct {
 	mux             *http.ServeMux
 	db              *database.DB
 	templateService *templates.TemplateService
+	slackFiles      *http.Client // Fetches private Slack files for /files/; nil uses slackFileClient
+
+	httpMu     sync.Mutex
+	httpServer *http.Server // Set once Serve starts, so Shutdown can stop it
 }
 
 func New(cfg *config.Config, logger *slog.Logger) *Server {
@@ -47,16 +61,25 @@ func New(cfg *config.Config, logger *slog.Logger) *Server {
 
 func (s *Server) SetupRoutes() {
 	s.mux.HandleFunc("/health", s.healthHandler)
+	s.mux.HandleFunc("/healthz", s.healthzHandler)
+	s.mux.HandleFunc("/readyz", s.readyzHandler)
 	s.mux.HandleFunc("/", s.rootHandler)
 
 	// Static file serving for CSS and assets
 	staticDir := http.Dir("./static/")
 	s.mux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(staticDir)))
 
+	// Read-only metrics endpoints for dashboards
+	if s.db != nil {
+		s.mux.HandleFunc("/metrics/pool", s.poolMetricsHandler)
+		s.mux.Handle("/metrics", s.prometheusHandler())
+	}
+
 	// Newsletter template routes
 	if s.templateService != nil {
 		s.mux.HandleFunc("/newsletter", s.currentNewsletterHandler)
 		s.mux.HandleFunc("/newsletter/", s.newsletterHandler)
+		s.mux.HandleFunc("/files/", s.fileHandler)
 	}
 
 	if s.slack != nil {
@@ -66,10 +89,14 @@ func (s *Server) SetupRoutes() {
 		)
 
 		eventHandler := slack.NewEventCallbackHandler(s.slack, s.config.SlackSigningSecret)
+		interactionHandler := slack.NewInteractionHandler(s.slack, s.config.SlackSigningSecret)
+		weeklyRunHandler := slack.NewWeeklyRunHandler(s.slack, s.config.WeeklyRunToken, s.config.WeeklyRunCandidates)
 
 		// Register the handlers with our custom mux
 		s.mux.Handle("/api/slack/commands", slackHandler)
 		s.mux.Handle("/api/slack/events", eventHandler)
+		s.mux.Handle("/api/slack/interactive", interactionHandler)
+		s.mux.Handle("/admin/run-weekly", weeklyRunHandler)
 		s.logger.Info("Registered Slack command handler at /api/slack/commands")
 	}
 }
@@ -84,6 +111,129 @@ func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, `{"status": "ok", "service": "newsletter"}`)
 }
 
+// healthPingTimeout bounds the database ping so probes stay fast
+const healthPingTimeout = time.Second
+
+// probeStatus is the JSON body returned by /healthz and /readyz
+type probeStatus struct {
+	Status    string `json:"status"`
+	DB        string `json:"db"`
+	Anthropic string `json:"anthropic,omitempty"`
+}
+
+// pingDB reports whether the database answers a ping within healthPingTimeout
+func (s *Server) pingDB(ctx context.Context) bool {
+	if s.db == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthPingTimeout)
+	defer cancel()
+	if err := s.db.PingContext(ctx); err != nil {
+		s.logger.Warn("Database ping failed", "error", err)
+		return false
+	}
+	return true
+}
+
+// healthzHandler is the liveness probe: 200 when the database answers a ping, 503 otherwise
+func (s *Server) healthzHandler(w http.ResponseWriter, r *http.Request) {
+	status := probeStatus{Status: "ok", DB: "up"}
+	if !s.pingDB(r.Context()) {
+		status = probeStatus{Status: "unavailable", DB: "down"}
+	}
+	s.writeProbeStatus(w, status)
+}
+
+// readyzHandler is the readiness probe: like /healthz, but also requires an Anthropic API key.
+// The key is only checked for presence; the API itself is not called.
+func (s *Server) readyzHandler(w http.ResponseWriter, r *http.Request) {
+	status := probeStatus{Status: "ok", DB: "up", Anthropic: "configured"}
+	if !s.pingDB(r.Context()) {
+		status.Status = "unavailable"
+		status.DB = "down"
+	}
+	if s.config.AnthropicAPIKey == "" {
+		status.Status = "unavailable"
+		status.Anthropic = "missing"
+	}
+	s.writeProbeStatus(w, status)
+}
+
+// writeProbeStatus writes a probe body with 200 for "ok" and 503 for anything else
+func (s *Server) writeProbeStatus(w http.ResponseWriter, status probeStatus) {
+	code := http.StatusOK
+	if status.Status != "ok" {
+		code = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		s.logger.Error("Failed to encode probe status", "error", err)
+	}
+}
+
+// metricsTokenHeader carries the shared secret required by metrics endpoints
+const metricsTokenHeader = "X-Metrics-Token"
+
+// authorizeMetrics checks the shared-secret header, or a bearer token as sent by Prometheus scrapers;
+// metrics stay closed when no token is configured
+func (s *Server) authorizeMetrics(r *http.Request) bool {
+	if s.config.MetricsToken == "" {
+		return false
+	}
+	provided := r.Header.Get(metricsTokenHeader)
+	if provided == "" {
+		provided = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(s.config.MetricsToken)) == 1
+}
+
+// prometheusHandler serves Prometheus metrics, including the current body/mind pool size
+func (s *Server) prometheusHandler() http.Handler {
+	registry := metrics.NewRegistry(func() (int, error) {
+		questions, err := s.db.GetActiveBodyMindQuestions()
+		return len(questions), err
+	})
+	promHandler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorizeMetrics(r) {
+			s.logger.Warn("Rejected unauthorized metrics request", slog.String("path", r.URL.Path))
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		promHandler.ServeHTTP(w, r)
+	})
+}
+
+// poolMetricsHandler serves body/mind pool metrics as JSON
+func (s *Server) poolMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !s.authorizeMetrics(r) {
+		s.logger.Warn("Rejected unauthorized metrics request", slog.String("path", r.URL.Path))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	poolManager := database.NewBodyMindPoolManager(s.db)
+	metrics, err := poolManager.GetPoolMetrics()
+	if err != nil {
+		s.logger.Error("Failed to get pool metrics", "error", err)
+		http.Error(w, "Failed to load pool metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(metrics); err != nil {
+		s.logger.Error("Failed to encode pool metrics", "error", err)
+	}
+}
+
 func (s *Server) rootHandler(w http.ResponseWriter, r *http.Request) {
 	s.logger.Info("Root endpoint accessed",
 		slog.String("method", r.Method),
@@ -93,9 +243,66 @@ func (s *Server) rootHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "Newsletter service is running")
 }
 
+// Start listens on the configured port and serves until Shutdown is called
 func (s *Server) Start() error {
 	s.logger.Info("Starting server", slog.String("port", s.config.Port))
-	return http.ListenAndServe(":"+s.config.Port, s.mux)
+	listener, err := net.Listen("tcp", ":"+s.config.Port)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %s: %w", s.config.Port, err)
+	}
+	return s.Serve(listener)
+}
+
+// Serve handles requests on listener until Shutdown is called, which makes it return nil
+func (s *Server) Serve(listener net.Listener) error {
+	s.httpMu.Lock()
+	if s.httpServer == nil {
+		s.httpServer = &http.Server{Handler: s.mux}
+	}
+	httpServer := s.httpServer
+	s.httpMu.Unlock()
+
+	if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown stops accepting requests and waits for in-flight ones, lets the bot's
+// background AI jobs finish, then closes the database. It stops waiting when ctx is done;
+// the database is then left open for the jobs still running.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.httpMu.Lock()
+	if s.httpServer == nil {
+		// Never served; keep Serve from starting after this
+		s.httpServer = &http.Server{Handler: s.mux}
+	}
+	httpServer := s.httpServer
+	s.httpMu.Unlock()
+
+	var errs []error
+	if err := httpServer.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to stop HTTP server: %w", err))
+	}
+
+	drained := true
+	if drainer, ok := s.slack.(slack.Drainer); ok {
+		if err := drainer.Drain(ctx); err != nil {
+			drained = false
+			errs = append(errs, fmt.Errorf("failed to drain slack bot: %w", err))
+		}
+	}
+
+	// Background jobs still running would fail on a closed database, so it's left open for them
+	if s.db != nil && !drained {
+		s.logger.Warn("Leaving database open: background jobs did not finish before shutdown")
+	} else if s.db != nil {
+		if err := s.db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close database: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // Handler returns the server's HTTP handler for testing
@@ -168,12 +375,16 @@ func (s *Server) newsletterHandler(w http.ResponseWriter, r *http.Request) {
 		year, err2 := strconv.Atoi(segments[2])
 
 		if err1 == nil && err2 == nil {
-			issue, err := s.db.GetOrCreateWeeklyIssue(week, year)
+			issue, err := s.db.GetWeeklyIssueByWeek(week, year)
 			if err != nil {
-				s.logger.Error("Failed to get newsletter issue", "week", week, "year", year, "error", err)
+				s.logger.Info("Newsletter issue not found", "week", week, "year", year, "error", err)
 				http.Error(w, "Newsletter not found", http.StatusNotFound)
 				return
 			}
+			if !s.canViewIssue(r, issue) {
+				http.Error(w, "Newsletter not yet published", http.StatusForbidden)
+				return
+			}
 			s.renderNewsletter(w, r, issue)
 			return
 		}
@@ -192,22 +403,58 @@ func (s *Server) newsletterHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Newsletter not found", http.StatusNotFound)
 		return
 	}
+	if !s.canViewIssue(r, issue) {
+		http.Error(w, "Newsletter not yet published", http.StatusForbidden)
+		return
+	}
 
 	s.renderNewsletter(w, r, issue)
 }
 
+// newsletterTokenParam is the query parameter carrying the newsletter admin token
+const newsletterTokenParam = "admin"
+
+// canViewIssue allows published issues to everyone and unpublished ones only with the admin token
+func (s *Server) canViewIssue(r *http.Request, issue *database.WeeklyNewsletterIssue) bool {
+	if issue.Status == database.IssueStatusPublished {
+		return true
+	}
+	if s.config.NewsletterToken == "" {
+		return false
+	}
+	provided := r.URL.Query().Get(newsletterTokenParam)
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(s.config.NewsletterToken)) == 1
+}
+
 // renderNewsletter renders a newsletter issue with its articles
 func (s *Server) renderNewsletter(w http.ResponseWriter, r *http.Request, issue *database.WeeklyNewsletterIssue) {
-	// Get processed articles for this issue
-	articles, err := s.db.GetProcessedArticlesByNewsletterIssue(issue.ID)
+	// Get processed articles for this issue; versions replaced after an edit are kept for history but not shown
+	articles, err := s.db.GetCurrentProcessedArticlesByNewsletterIssue(issue.ID)
 	if err != nil {
 		s.logger.Error("Failed to get articles for newsletter", "issue_id", issue.ID, "error", err)
 		// Continue with empty articles rather than error - show empty newsletter
 		articles = []database.ProcessedArticle{}
 	}
 
+	// Files shared with a submission are shown with its article; a failed lookup only leaves them out
+	files := make(map[int][]database.SubmissionFile)
+	for _, article := range articles {
+		submissionFiles, err := s.db.GetFilesForSubmission(article.SubmissionID)
+		if err != nil {
+			s.logger.Warn("Failed to get files for article", "article_id", article.ID, "submission_id", article.SubmissionID, "error", err)
+			continue
+		}
+		if len(submissionFiles) > 0 {
+			// Slack's private URLs need the bot token, so readers get them through /files/
+			for i := range submissionFiles {
+				submissionFiles[i].URL = s.fileURL(r, issue, submissionFiles[i].ID)
+			}
+			files[article.SubmissionID] = submissionFiles
+		}
+	}
+
 	// Render the newsletter
-	html, err := s.templateService.RenderNewsletter(r.Context(), issue, articles)
+	html, err := s.templateService.RenderNewsletterWithFiles(r.Context(), issue, articles, files)
 	if err != nil {
 		s.logger.Error("Failed to render newsletter template", "issue_id", issue.ID, "error", err)
 		http.Error(w, "Failed to render newsletter", http.StatusInternalServerError)
@@ -225,3 +472,110 @@ func (s *Server) renderNewsletter(w http.ResponseWriter, r *http.Request, issue
 		"year", issue.Year,
 		"articles_count", len(articles))
 }
+
+// slackFileTimeout bounds fetching a shared file from Slack
+const slackFileTimeout = 30 * time.Second
+
+// slackFileClient fetches shared files from Slack when the server has no client of its own
+var slackFileClient = &http.Client{Timeout: slackFileTimeout}
+
+// fileURL is where the newsletter links a shared file. Previews of unpublished issues pass the
+// admin token on, since the file is only served to those who may view its issue.
+func (s *Server) fileURL(r *http.Request, issue *database.WeeklyNewsletterIssue, fileID int) string {
+	path := fmt.Sprintf("/files/%d", fileID)
+	if issue.Status != database.IssueStatusPublished {
+		if token := r.URL.Query().Get(newsletterTokenParam); token != "" {
+			path += "?" + url.Values{newsletterTokenParam: {token}}.Encode()
+		}
+	}
+	return path
+}
+
+// fileHandler serves a file shared with a submission by fetching it from Slack with the bot token.
+// Files are served to whoever may view an issue the submission's article appears in.
+func (s *Server) fileHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	fileID, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/files/"))
+	if err != nil {
+		http.Error(w, "Invalid file ID", http.StatusBadRequest)
+		return
+	}
+
+	file, err := s.db.GetSubmissionFile(fileID)
+	if err != nil {
+		http.Error(w, "File not found", http.StatusNotFound)
+		return
+	}
+
+	if !s.canViewSubmissionFile(r, file.SubmissionID) {
+		http.Error(w, "File not available", http.StatusForbidden)
+		return
+	}
+
+	// Only Slack gets the bot token
+	fileURL, err := url.Parse(file.URL)
+	if err != nil || fileURL.Scheme != "https" || !strings.HasSuffix(fileURL.Host, ".slack.com") {
+		s.logger.Warn("Refusing to fetch file from outside Slack", "file_id", file.ID, "url", file.URL)
+		http.Error(w, "File not available", http.StatusNotFound)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, fileURL.String(), nil)
+	if err != nil {
+		http.Error(w, "File not available", http.StatusInternalServerError)
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.SlackBotToken)
+
+	client := s.slackFiles
+	if client == nil {
+		client = slackFileClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch file from Slack", "file_id", file.ID, "error", err)
+		http.Error(w, "Failed to fetch file", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error("Slack refused file download", "file_id", file.ID, "status", resp.StatusCode)
+		http.Error(w, "Failed to fetch file", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.MimeType)
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		s.logger.Warn("Failed to stream file", "file_id", file.ID, "error", err)
+	}
+}
+
+// canViewSubmissionFile reports whether the request may view an issue containing the submission's article
+func (s *Server) canViewSubmissionFile(r *http.Request, submissionID int) bool {
+	articles, err := s.db.GetProcessedArticlesBySubmissionID(submissionID)
+	if err != nil {
+		s.logger.Warn("Failed to get articles for file", "submission_id", submissionID, "error", err)
+		return false
+	}
+
+	for _, article := range articles {
+		if article.NewsletterIssueID == nil {
+			continue
+		}
+		issue, err := s.db.GetWeeklyNewsletterIssue(*article.NewsletterIssueID)
+		if err != nil {
+			continue
+		}
+		if s.canViewIssue(r, issue) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
+)
+
+// assignmentAcceptAction is the action ID of the "Got it" button on assignment DMs; its value is the assignment ID
+const assignmentAcceptAction = "assignment_accept"
+
+// assignmentMessageBlocks renders an assignment DM with a "Got it" button the assignee presses to
+// acknowledge it. Returns nil when the message is too long for Block Kit, so it goes out as plain text.
+func assignmentMessageBlocks(message string, assignmentID int) []slack.Block {
+	blocks := markdownBlocks(message)
+	if blocks == nil || len(blocks) >= maxBlocksPerMessage {
+		return nil
+	}
+
+	button := slack.NewButtonBlockElement(assignmentAcceptAction, strconv.Itoa(assignmentID),
+		slack.NewTextBlockObject(slack.PlainTextType, "Got it", false, false))
+	button.Style = slack.StylePrimary
+
+	return append(blocks, slack.NewActionBlock("", button))
+}
+
+// sendAssignmentMessage DMs an assignment to the user with a button to acknowledge it
+func (ah *AdminHandler) sendAssignmentMessage(ctx context.Context, userID, message string, assignmentID int) error {
+	if ah.broadcastManager == nil {
+		return fmt.Errorf("broadcast manager not available")
+	}
+	return ah.broadcastManager.sendDirectMessageBlocks(ctx, userID, message, assignmentMessageBlocks(message, assignmentID))
+}
+
+// HandleBlockActions handles button presses in bot messages. Pressing "Got it" on an assignment DM
+// stamps the assignment as accepted and confirms it with a DM.
+func (b *slackBot) HandleBlockActions(ctx context.Context, callback slack.InteractionCallback) error {
+	for _, action := range callback.ActionCallback.BlockActions {
+		if action.ActionID != assignmentAcceptAction {
+			loggerFor(ctx).Warn("Ignoring unknown block action", "action_id", action.ActionID)
+			continue
+		}
+
+		var dbPtr *database.DB
+		if b.db != nil {
+			dbPtr = b.db.GetUnderlyingDB()
+		}
+		if dbPtr == nil {
+			return fmt.Errorf("database not available to accept assignment")
+		}
+
+		userID := callback.User.ID
+		assignmentID, err := strconv.Atoi(action.Value)
+		if err != nil {
+			return fmt.Errorf("invalid assignment ID %q: %w", action.Value, err)
+		}
+
+		reply := "👍 Thanks, noted! We're looking forward to your submission."
+		accepted, err := dbPtr.AcceptAssignment(assignmentID, userID)
+		if err != nil {
+			loggerFor(ctx).Warn("Failed to accept assignment", "assignment_id", assignmentID, "user", userID, "error", err)
+			reply = "❌ That assignment no longer exists or isn't yours."
+		} else if !accepted {
+			reply = "👍 You've already confirmed this assignment."
+		}
+
+		if err := b.SendMessage(ctx, userID, reply); err != nil {
+			loggerFor(ctx).Warn("Failed to confirm assignment acceptance", "user", userID, "error", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
+)
+
+// blockActionPayload builds the interactivity payload Slack posts when a button is pressed
+func blockActionPayload(userID, actionID, value string) string {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type": "block_actions",
+		"user": map[string]string{"id": userID},
+		"actions": []map[string]string{
+			{"action_id": actionID, "block_id": "actions", "value": value, "type": "button"},
+		},
+	})
+	return url.Values{"payload": {string(payload)}}.Encode()
+}
+
+// TDD: Assignment DMs carry a "Got it" button whose value is the assignment ID
+func TestAssignmentMessageBlocks(t *testing.T) {
+	blocks := assignmentMessageBlocks("📝 *Newsletter Assignment*\n\nYour question", 42)
+	if len(blocks) == 0 {
+		t.Fatal("Expected blocks for the assignment DM")
+	}
+
+	actions, ok := blocks[len(blocks)-1].(*slack.ActionBlock)
+	if !ok || len(actions.Elements.ElementSet) != 1 {
+		t.Fatalf("Expected the DM to end with a single button, got %#v", blocks[len(blocks)-1])
+	}
+	button, ok := actions.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	if !ok || button.ActionID != assignmentAcceptAction || button.Value != "42" || button.Text.Text != "Got it" {
+		t.Errorf("Expected a \"Got it\" button for assignment 42, got %#v", actions.Elements.ElementSet[0])
+	}
+}
+
+// TDD: Pressing "Got it" stamps the assignment as accepted, and week-status shows who hasn't acknowledged yet
+func TestAcceptAssignmentButton(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	var mu sync.Mutex
+	var dms []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/chat.postMessage" {
+			r.ParseForm()
+			mu.Lock()
+			dms = append(dms, r.Form.Get("channel")+": "+r.Form.Get("text"))
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	bot := NewBotWithWeeklyAutomation(
+		SlackConfig{Token: "test-token"},
+		&MockQuestionSelector{},
+		[]string{"U999999999"},
+		database.NewSubmissionManager(testDB.DB),
+		nil,
+		testDB,
+	).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentIDs := make(map[string]int)
+	for _, userID := range []string{"U111111111", "U222222222"} {
+		id, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+			IssueID:     issue.ID,
+			PersonID:    userID,
+			ContentType: database.ContentTypeGeneral,
+			AssignedAt:  time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to create assignment: %v", err)
+		}
+		assignmentIDs[userID] = id
+	}
+
+	handler := NewInteractionHandler(bot, "")
+	press := func(userID string, assignmentID int) {
+		t.Helper()
+		req := httptest.NewRequest("POST", "/api/slack/interactive",
+			strings.NewReader(blockActionPayload(userID, assignmentAcceptAction, strconv.Itoa(assignmentID))))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for the button press, got %d: %s", rr.Code, rr.Body.String())
+		}
+	}
+
+	t.Run("StampsAcceptance", func(t *testing.T) {
+		press("U111111111", assignmentIDs["U111111111"])
+
+		acceptances, err := testDB.GetAssignmentAcceptances(issue.ID)
+		if err != nil {
+			t.Fatalf("GetAssignmentAcceptances failed: %v", err)
+		}
+		if acceptedAt, ok := acceptances[assignmentIDs["U111111111"]]; !ok || acceptedAt.IsZero() {
+			t.Errorf("Expected the assignment to be accepted, got %v", acceptances)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(dms) != 1 || !strings.HasPrefix(dms[0], "U111111111: ") || !strings.Contains(dms[0], "Thanks, noted") {
+			t.Errorf("Expected a confirmation DM, got %v", dms)
+		}
+	})
+
+	t.Run("IgnoresOtherUsersAssignment", func(t *testing.T) {
+		press("U111111111", assignmentIDs["U222222222"])
+
+		acceptances, err := testDB.GetAssignmentAcceptances(issue.ID)
+		if err != nil {
+			t.Fatalf("GetAssignmentAcceptances failed: %v", err)
+		}
+		if _, ok := acceptances[assignmentIDs["U222222222"]]; ok {
+			t.Error("Expected someone else's button press not to accept the assignment")
+		}
+	})
+
+	t.Run("WeekStatusShowsAcknowledgement", func(t *testing.T) {
+		response, err := bot.adminHandler.HandleAdminCommand(context.Background(), "U999999999", &AdminCommand{Action: "week-status"})
+		if err != nil {
+			t.Fatalf("HandleAdminCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "<@U111111111>: 1 assignment(s), ✅ acknowledged") {
+			t.Errorf("Expected U111111111 to show as acknowledged, got: %s", response.Text)
+		}
+		if !strings.Contains(response.Text, "<@U222222222>: 1 assignment(s), ⏳ not yet acknowledged") {
+			t.Errorf("Expected U222222222 to show as not yet acknowledged, got: %s", response.Text)
+		}
+		if !strings.Contains(response.Text, "Acknowledged:** 1/2 assignments") {
+			t.Errorf("Expected an acknowledgement summary, got: %s", response.Text)
+		}
+	})
+}
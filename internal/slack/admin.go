@@ -2,23 +2,41 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"github.com/olle-forsslof/kumpan-newspaper/internal/ai"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/metrics"
+	"github.com/slack-go/slack"
 )
 
 type AdminHandler struct {
 	questionSelector  QuestionSelector
-	authorizedUsers   []string // Slack user IDs who can run admin commands
+	authorizedUsers   []string // Slack user IDs with full (super admin) access
+	editorUsers       []string // Slack user IDs limited to read/review commands
 	submissionManager SubmissionManager
 	db                *database.DB                  // Add database access for weekly automation
 	poolManager       *database.BodyMindPoolManager // Body/mind question pool
 	broadcastManager  *BroadcastManager             // Broadcast messaging system
+	usergroupResolver UsergroupResolver             // Expands @usergroup handles for batch assignments
 	aiProcessor       AIProcessor                   // AI processing for rerun functionality
+	aiTimeout         time.Duration                 // Limit for synchronous AI calls such as preview, defaults to DefaultAITimeout
+	debugErrors       bool                          // Include the caller's Slack ID in authorization errors
+	defaultJournalist string                        // Journalist for unlinked submissions, DefaultJournalistType when unset
+	userIDs           userIDCache                   // Remembers username lookups so batch assignments don't refetch the user list
+	jobs              sync.WaitGroup                // Background reruns, which the bot's Drain waits for
+
+	settingsMu sync.RWMutex // Guards the user lists, aiTimeout, debugErrors and defaultJournalist, which a config reload may swap
 }
 
 type AdminCommand struct {
@@ -58,6 +76,7 @@ func NewAdminHandlerWithWeeklyAutomation(questionSelector QuestionSelector, auth
 		db:                db,
 		poolManager:       poolManager,
 		broadcastManager:  broadcastManager,
+		usergroupResolver: broadcastManager,
 		aiProcessor:       nil, // Will be set later if available
 	}
 }
@@ -73,18 +92,224 @@ func NewAdminHandlerWithAI(questionSelector QuestionSelector, authorizedUsers []
 		db:                db,
 		poolManager:       poolManager,
 		broadcastManager:  broadcastManager,
+		usergroupResolver: broadcastManager,
 		aiProcessor:       aiProcessor,
 	}
 }
 
-// isAuthorized checks if a user can run admin commands
-func (ah *AdminHandler) isAuthorized(userID string) bool {
+// AdminRole represents the permission tier of an admin user
+type AdminRole string
+
+const (
+	AdminRoleNone   AdminRole = ""
+	AdminRoleEditor AdminRole = "editor"
+	AdminRoleSuper  AdminRole = "super"
+)
+
+// editorCommands lists the read/review commands editors are allowed to run.
+// Every other command requires a super admin.
+var editorCommands = map[string]bool{
+	"help":                    true,
+	"list-questions":          true,
+	"question-stats":          true,
+	"test-rotation":           true,
+	"list-submissions":        true,
+	"export-range":            true,
+	"list-published-articles": true,
+	"export-csv":              true,
+	"week-status":             true,
+	"cost-report":             true,
+	"list-issues":             true,
+	"pool-status":             true,
+	"plan-week":               true,
+	"article-history":         true,
+	"submission-history":      true,
+	"journalists":             true,
+	"leaderboard":             true,
+	"content-mix":             true,
+	"fairness":                true,
+	"preview":                 true,
+}
+
+// SetEditorUsers configures the users who get editor (read/review) access
+func (ah *AdminHandler) SetEditorUsers(editorUsers []string) {
+	ah.settingsMu.Lock()
+	defer ah.settingsMu.Unlock()
+	ah.editorUsers = editorUsers
+}
+
+// SetAdminUsers replaces the users who get full (super admin) access
+func (ah *AdminHandler) SetAdminUsers(adminUsers []string) {
+	ah.settingsMu.Lock()
+	defer ah.settingsMu.Unlock()
+	ah.authorizedUsers = adminUsers
+}
+
+// adminUsers returns the current super admins, configured ones first and then those granted with grant-role
+func (ah *AdminHandler) adminUsers() []string {
+	ah.settingsMu.RLock()
+	admins := append([]string(nil), ah.authorizedUsers...)
+	ah.settingsMu.RUnlock()
+
+	if ah.db == nil {
+		return admins
+	}
+	grants, err := ah.db.GetAdminRoles()
+	if err != nil {
+		slog.Warn("Failed to load granted admin roles", "error", err)
+		return admins
+	}
+	for _, grant := range grants {
+		if grant.Role == string(AdminRoleSuper) && !slices.Contains(admins, grant.UserID) {
+			admins = append(admins, grant.UserID)
+		}
+	}
+	return admins
+}
+
+// SetAITimeout bounds synchronous AI calls made by admin commands
+func (ah *AdminHandler) SetAITimeout(timeout time.Duration) {
+	ah.settingsMu.Lock()
+	defer ah.settingsMu.Unlock()
+	ah.aiTimeout = timeout
+}
+
+// SetDefaultJournalist sets the journalist that writes up submissions with neither a question nor an assignment
+func (ah *AdminHandler) SetDefaultJournalist(journalistType string) {
+	ah.settingsMu.Lock()
+	defer ah.settingsMu.Unlock()
+	ah.defaultJournalist = journalistType
+}
+
+// SetSlackMaxRetries sets how often the handler's Slack API calls are retried
+func (ah *AdminHandler) SetSlackMaxRetries(maxRetries int) {
+	if ah.broadcastManager != nil {
+		ah.broadcastManager.SetMaxRetries(maxRetries)
+	}
+}
+
+// SetDebugErrors controls whether authorization errors echo the caller's Slack ID, which
+// helps when setting up ADMIN_USERS but is noise for everyone else
+func (ah *AdminHandler) SetDebugErrors(enabled bool) {
+	ah.settingsMu.Lock()
+	defer ah.settingsMu.Unlock()
+	ah.debugErrors = enabled
+}
+
+// unauthorizedText is the reply to someone without admin access, with their Slack ID in debug mode
+func (ah *AdminHandler) unauthorizedText(userID string) string {
+	ah.settingsMu.RLock()
+	defer ah.settingsMu.RUnlock()
+	if ah.debugErrors {
+		return fmt.Sprintf("❌ You are not authorized to use admin commands. Your ID: %s", userID)
+	}
+	return "❌ You are not authorized to use admin commands."
+}
+
+// requestTimeout returns the limit for synchronous AI calls, falling back to DefaultAITimeout
+func (ah *AdminHandler) requestTimeout() time.Duration {
+	ah.settingsMu.RLock()
+	defer ah.settingsMu.RUnlock()
+	if ah.aiTimeout > 0 {
+		return ah.aiTimeout
+	}
+	return DefaultAITimeout
+}
+
+// journalistForUnlinked returns the configured journalist for unlinked submissions, falling back to DefaultJournalistType
+func (ah *AdminHandler) journalistForUnlinked() string {
+	ah.settingsMu.RLock()
+	defer ah.settingsMu.RUnlock()
+	if ah.defaultJournalist != "" {
+		return ah.defaultJournalist
+	}
+	return DefaultJournalistType
+}
+
+// EnableLowPoolBroadcast makes body/mind question selection broadcast a request for new
+// questions when it leaves fewer than threshold in the pool, at most once a day
+func (ah *AdminHandler) EnableLowPoolBroadcast(threshold int) {
+	if ah.poolManager == nil || ah.broadcastManager == nil {
+		return
+	}
+	broadcastManager := ah.broadcastManager
+	ah.poolManager.EnableLowPoolBroadcast(threshold, func(ctx context.Context) error {
+		result, err := broadcastManager.BroadcastBodyMindRequest(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("Sent low-pool body/mind broadcast", "sent", result.SuccessfulSends, "failed", result.FailedSends)
+		return nil
+	}, time.Now)
+}
+
+// SetUsergroupResolver overrides how @usergroup handles are expanded into members
+func (ah *AdminHandler) SetUsergroupResolver(resolver UsergroupResolver) {
+	ah.usergroupResolver = resolver
+}
+
+// roleFor returns the admin role of a user, from configuration or granted with grant-role.
+// Super admins take precedence over editors.
+func (ah *AdminHandler) roleFor(userID string) AdminRole {
+	configured := ah.configuredRoleFor(userID)
+	if configured == AdminRoleSuper {
+		return configured
+	}
+	if granted := ah.grantedRoleFor(userID); granted == AdminRoleSuper || configured == AdminRoleNone {
+		return granted
+	}
+	return configured
+}
+
+// grantedRoleFor returns the role a super admin granted a user at runtime, if any
+func (ah *AdminHandler) grantedRoleFor(userID string) AdminRole {
+	if ah.db == nil {
+		return AdminRoleNone
+	}
+	role, found, err := ah.db.GetAdminRole(userID)
+	if err != nil {
+		slog.Warn("Failed to load granted admin role", "user", userID, "error", err)
+		return AdminRoleNone
+	}
+	if !found {
+		return AdminRoleNone
+	}
+	return AdminRole(role)
+}
+
+// configuredRoleFor returns the admin role a user has from the configured admin and editor lists
+func (ah *AdminHandler) configuredRoleFor(userID string) AdminRole {
+	ah.settingsMu.RLock()
+	defer ah.settingsMu.RUnlock()
+
 	for _, id := range ah.authorizedUsers {
 		if id == userID {
-			return true
+			return AdminRoleSuper
 		}
 	}
-	return false
+	for _, id := range ah.editorUsers {
+		if id == userID {
+			return AdminRoleEditor
+		}
+	}
+	return AdminRoleNone
+}
+
+// isAuthorized checks if a user can run admin commands
+func (ah *AdminHandler) isAuthorized(userID string) bool {
+	return ah.roleFor(userID) != AdminRoleNone
+}
+
+// canRun checks if a role is allowed to run the given admin action
+func canRun(role AdminRole, action string) bool {
+	switch role {
+	case AdminRoleSuper:
+		return true
+	case AdminRoleEditor:
+		return editorCommands[action]
+	default:
+		return false
+	}
 }
 
 // Parse "admin add-question 'What did you do?' work" into structured command
@@ -101,6 +326,31 @@ func parseAdminCommand(text string) (*AdminCommand, error) {
 		return parseAddQuestionCommand(text)
 	}
 
+	// set-journalist-prompt keeps the prompt verbatim, including spacing and line breaks
+	if action == "set-journalist-prompt" {
+		return parseSetJournalistPromptCommand(text, parts)
+	}
+
+	// preview takes free-form sample text after the journalist type
+	if action == "preview" {
+		return parsePreviewCommand(text, parts)
+	}
+
+	// import-questions keeps one question per line after the category
+	if action == "import-questions" {
+		return parseImportQuestionsCommand(text, parts)
+	}
+
+	// set-theme takes a quoted theme, which may be empty, after the optional week and year
+	if action == "set-theme" {
+		return parseSetThemeCommand(text, parts)
+	}
+
+	// refine takes free-form feedback after the submission ID
+	if action == "refine" {
+		return parseRefineCommand(text, parts)
+	}
+
 	// For other commands, use simple field splitting
 	return &AdminCommand{
 		Action: action,
@@ -108,28 +358,61 @@ func parseAdminCommand(text string) (*AdminCommand, error) {
 	}, nil
 }
 
+// Quote characters accepted around quoted arguments. Slack turns straight quotes into curly ones,
+// so both are accepted, and either kind may close a quote the other kind opened.
+const (
+	doubleQuotes = "\"“”„"
+	singleQuotes = "'‘’"
+)
+
+var (
+	errNoQuotedText  = errors.New("no quoted text found")
+	errUnclosedQuote = errors.New("unclosed quote")
+)
+
+// quotedText finds the first quoted span in text and returns its contents and whatever follows it.
+// The span runs to the last matching closing quote, so quotes and apostrophes inside it are kept.
+func quotedText(text string) (quoted, after string, err error) {
+	start := strings.IndexAny(text, doubleQuotes+singleQuotes)
+	if start == -1 {
+		return "", "", errNoQuotedText
+	}
+
+	opening, size := utf8.DecodeRuneInString(text[start:])
+	closing := doubleQuotes
+	if strings.ContainsRune(singleQuotes, opening) {
+		closing = singleQuotes
+	}
+
+	body := text[start+size:]
+	end := strings.LastIndexAny(body, closing)
+	if end == -1 {
+		return "", "", errUnclosedQuote
+	}
+	_, closeSize := utf8.DecodeRuneInString(body[end:])
+
+	return body[:end], body[end+closeSize:], nil
+}
+
 func parseAddQuestionCommand(text string) (*AdminCommand, error) {
 	// Expected format: admin add-question "quoted question text" category
-	// Find the quoted text
-	startQuote := strings.Index(text, "\"")
-	if startQuote == -1 {
+	questionText, afterQuote, err := quotedText(text)
+	switch {
+	case errors.Is(err, errUnclosedQuote):
+		return nil, fmt.Errorf("unclosed quote in question text: admin add-question \"Your question\" category")
+	case err != nil:
 		return nil, fmt.Errorf("add-question requires quoted text: admin add-question \"Your question\" category")
 	}
 
-	endQuote := strings.Index(text[startQuote+1:], "\"")
-	if endQuote == -1 {
-		return nil, fmt.Errorf("unclosed quote in question text")
+	questionText = strings.TrimSpace(questionText)
+	if questionText == "" {
+		return nil, fmt.Errorf("question text is empty: admin add-question \"Your question\" category")
 	}
 
-	// Extract the question text (without quotes)
-	questionText := text[startQuote+1 : startQuote+1+endQuote]
-
-	// Get the category (everything after the closing quote, trimmed)
-	afterQuote := strings.TrimSpace(text[startQuote+1+endQuote+1:])
+	// The category is the first word after the closing quote
 	categoryParts := strings.Fields(afterQuote)
-
 	if len(categoryParts) == 0 {
-		return nil, fmt.Errorf("category required: admin add-question \"Your question\" category")
+		return nil, fmt.Errorf("category required after the quoted question: admin add-question \"%s\" category", questionText)
 	}
 
 	return &AdminCommand{
@@ -138,11 +421,126 @@ func parseAddQuestionCommand(text string) (*AdminCommand, error) {
 	}, nil
 }
 
+func parseSetJournalistPromptCommand(text string, parts []string) (*AdminCommand, error) {
+	// Expected format: admin set-journalist-prompt type "system prompt"
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("usage: admin set-journalist-prompt type \"System prompt\"")
+	}
+
+	journalistType, prompt := splitTypeAndText(text, parts)
+	return &AdminCommand{
+		Action: "set-journalist-prompt",
+		Args:   []string{journalistType, prompt},
+	}, nil
+}
+
+func parsePreviewCommand(text string, parts []string) (*AdminCommand, error) {
+	// Expected format: admin preview type "sample text"
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("usage: admin preview journalist_type \"Sample text\"")
+	}
+
+	journalistType, sample := splitTypeAndText(text, parts)
+	return &AdminCommand{
+		Action: "preview",
+		Args:   []string{journalistType, sample},
+	}, nil
+}
+
+func parseRefineCommand(text string, parts []string) (*AdminCommand, error) {
+	// Expected format: admin refine submission_id [--force] "feedback"
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("usage: admin refine submission_id \"Your feedback\" [--force]")
+	}
+
+	// --force may come before or after the feedback
+	submissionID, feedback := splitTypeAndText(text, parts)
+	feedback, forcedBefore := strings.CutPrefix(feedback, "--force")
+	feedback, forcedAfter := strings.CutSuffix(strings.TrimSpace(feedback), "--force")
+	force := forcedBefore || forcedAfter
+	feedback = strings.TrimSpace(strings.Trim(strings.TrimSpace(feedback), doubleQuotes))
+
+	args := []string{submissionID, feedback}
+	if force {
+		args = append(args, "--force")
+	}
+	return &AdminCommand{
+		Action: "refine",
+		Args:   args,
+	}, nil
+}
+
+func parseImportQuestionsCommand(text string, parts []string) (*AdminCommand, error) {
+	// Expected format: admin import-questions category, then one question per line
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("usage: admin import-questions category, followed by one question per line")
+	}
+
+	category := parts[2]
+	actionEnd := strings.Index(text, parts[1]) + len(parts[1])
+	categoryEnd := actionEnd + strings.Index(text[actionEnd:], category) + len(category)
+
+	// Blank lines between questions are kept so the import can report them as skipped
+	lines := strings.Split(strings.TrimSpace(text[categoryEnd:]), "\n")
+	return &AdminCommand{
+		Action: "import-questions",
+		Args:   append([]string{category}, lines...),
+	}, nil
+}
+
+func parseSetThemeCommand(text string, parts []string) (*AdminCommand, error) {
+	// Expected format: admin set-theme [week] [year] "theme", where "" clears the theme
+	rest := strings.TrimSpace(text[strings.Index(text, parts[1])+len(parts[1]):])
+
+	var args []string
+	for len(args) < 2 {
+		field := strings.Fields(rest)
+		if len(field) == 0 {
+			break
+		}
+		if _, err := strconv.Atoi(field[0]); err != nil {
+			break
+		}
+		args = append(args, field[0])
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, field[0]))
+	}
+
+	if first, _ := utf8.DecodeRuneInString(rest); !strings.ContainsRune(doubleQuotes, first) {
+		return nil, fmt.Errorf("usage: admin set-theme [week] [year] \"Theme\"")
+	}
+
+	theme := strings.TrimSpace(strings.Trim(rest, doubleQuotes))
+	return &AdminCommand{
+		Action: "set-theme",
+		Args:   append(args, theme),
+	}, nil
+}
+
+// splitTypeAndText returns the journalist type following the action and the verbatim,
+// unquoted text after it
+func splitTypeAndText(text string, parts []string) (string, string) {
+	journalistType := parts[2]
+	actionEnd := strings.Index(text, parts[1]) + len(parts[1])
+	typeEnd := actionEnd + strings.Index(text[actionEnd:], journalistType) + len(journalistType)
+	rest := strings.TrimSpace(text[typeEnd:])
+	rest = strings.TrimSpace(strings.Trim(rest, doubleQuotes))
+	return journalistType, rest
+}
+
 func (ah *AdminHandler) HandleAdminCommand(ctx context.Context, userID string, cmd *AdminCommand) (*SlashCommandResponse, error) {
 	// Security check first
-	if !ah.isAuthorized(userID) {
+	role := ah.roleFor(userID)
+	if role == AdminRoleNone {
+		return &SlashCommandResponse{
+			Text:         ah.unauthorizedText(userID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if !canRun(role, cmd.Action) {
+		slog.Warn("Admin command denied for role", "user", userID, "role", role, "action", cmd.Action)
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ You are not authorized to use admin commands. Your ID: %s", userID),
+			Text:         fmt.Sprintf("❌ The '%s' command requires super admin access. Editors can only run read/review commands.", cmd.Action),
 			ResponseType: "ephemeral",
 		}, nil
 	}
@@ -150,24 +548,72 @@ func (ah *AdminHandler) HandleAdminCommand(ctx context.Context, userID string, c
 	switch cmd.Action {
 	case "add-question":
 		return ah.handleAddQuestion(ctx, cmd.Args)
+	case "import-questions":
+		return ah.handleImportQuestions(ctx, cmd.Args)
 	case "list-questions":
 		return ah.handleListQuestions(ctx, cmd.Args)
 	case "remove-question":
 		return ah.handleRemoveQuestion(ctx, cmd.Args)
+	case "question-stats":
+		return ah.handleQuestionStats(ctx, cmd.Args)
+	case "reset-rotation":
+		return ah.handleResetRotation(ctx, cmd.Args)
+	case "content-mix":
+		return ah.handleContentMix(ctx, cmd.Args)
+	case "leaderboard":
+		return ah.handleLeaderboard(ctx, cmd.Args)
+	case "fairness":
+		return ah.handleFairness(ctx, cmd.Args)
 	case "test-rotation":
 		return ah.handleTestRotation(ctx, cmd.Args)
 	case "list-submissions":
 		return ah.handleListSubmissions(ctx, cmd.Args)
 	case "remove-submission":
 		return ah.handleRemoveSubmission(ctx, cmd.Args)
+	case "export-range":
+		return ah.handleExportRange(ctx, cmd.Args)
 
 	// Article management commands
 	case "list-published-articles":
 		return ah.handleListPublishedArticles(ctx, cmd.Args)
+	case "export-csv":
+		return ah.handleExportCSV(ctx, userID, cmd.Args)
 	case "delete-article":
 		return ah.handleDeleteArticle(ctx, cmd.Args)
+	case "refine":
+		return ah.handleRefine(ctx, cmd.Args)
+	case "weekly-digest":
+		return ah.handleWeeklyDigest(ctx, cmd.Args)
+	case "process-backlog":
+		return ah.handleProcessBacklog(ctx, cmd.Args)
 	case "rerun-submission":
 		return ah.handleRerunSubmission(ctx, cmd.Args)
+	case "reprocess":
+		return ah.handleReprocess(ctx, cmd.Args)
+	case "dead-letters":
+		return ah.handleDeadLetters(ctx, cmd.Args)
+	case "preview":
+		return ah.handlePreview(ctx, cmd.Args)
+	case "journalists":
+		return ah.handleJournalists(ctx, cmd.Args)
+	case "article-history":
+		return ah.handleArticleHistory(ctx, cmd.Args)
+	case "submission-history":
+		return ah.handleSubmissionHistory(ctx, cmd.Args)
+	case "set-journalist-prompt":
+		return ah.handleSetJournalistPrompt(ctx, cmd.Args)
+	case "reset-journalist-prompt":
+		return ah.handleResetJournalistPrompt(ctx, cmd.Args)
+	case "add-admin":
+		return ah.handleAddAdmin(ctx, userID, cmd.Args)
+	case "remove-admin":
+		return ah.handleRemoveAdmin(ctx, cmd.Args)
+	case "grant-role":
+		return ah.handleGrantRole(ctx, userID, cmd.Args)
+	case "revoke-role":
+		return ah.handleRevokeRole(ctx, cmd.Args)
+	case "list-roles":
+		return ah.handleListRoles(ctx, cmd.Args)
 
 	// Weekly automation commands
 	case "assign-question":
@@ -176,8 +622,28 @@ func (ah *AdminHandler) HandleAdminCommand(ctx context.Context, userID string, c
 		return ah.handleWeekStatus(ctx, cmd.Args)
 	case "pool-status":
 		return ah.handlePoolStatus(ctx, cmd.Args)
+	case "cost-report":
+		return ah.handleCostReport(ctx, cmd.Args)
+	case "list-issues":
+		return ah.handleListIssues(ctx, cmd.Args)
 	case "broadcast-bodymind":
 		return ah.handleBroadcastBodyMind(ctx, cmd.Args)
+	case "chase":
+		return ah.handleChase(ctx, cmd.Args)
+	case "cleanup-stale":
+		return ah.handleCleanupStale(ctx, cmd.Args)
+	case "archive-questions":
+		return ah.handleArchiveQuestions(ctx, cmd.Args)
+	case "set-theme":
+		return ah.handleSetTheme(ctx, cmd.Args)
+	case "publish-week":
+		return ah.handlePublishWeek(ctx, cmd.Args)
+	case "generate-intro":
+		return ah.handleGenerateIntro(ctx, cmd.Args)
+	case "plan-week":
+		return ah.handlePlanWeek(ctx, cmd.Args)
+	case "promote-anonymous":
+		return ah.handlePromoteAnonymous(ctx, cmd.Args)
 
 	default:
 		return ah.handleHelp()
@@ -210,6 +676,66 @@ func (ah *AdminHandler) handleAddQuestion(ctx context.Context, args []string) (*
 	}, nil
 }
 
+// handleImportQuestions adds one question per line to a category, skipping blank lines and
+// questions the category already has
+func (ah *AdminHandler) handleImportQuestions(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 2 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin import-questions category\nFirst question\nSecond question\n...",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	category := args[0]
+	existing, err := ah.questionSelector.GetQuestionsByCategory(ctx, category)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get questions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	known := make(map[string]bool, len(existing))
+	for _, q := range existing {
+		known[q.Text] = true
+	}
+
+	var added, blank, duplicates, failed int
+	for _, line := range args[1:] {
+		// Pasted lists often come with bullets or quotes around each question
+		text := strings.TrimSpace(line)
+		text = strings.TrimSpace(strings.TrimLeft(text, "-•*"))
+		text = strings.TrimSpace(strings.Trim(text, "\"'“”"))
+
+		switch {
+		case text == "":
+			blank++
+		case known[text]:
+			duplicates++
+		default:
+			if _, err := ah.questionSelector.AddQuestion(ctx, text, category); err != nil {
+				slog.Error("Failed to import question", "category", category, "error", err)
+				failed++
+				continue
+			}
+			known[text] = true
+			added++
+		}
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("✅ Imported %d questions into category '%s'.\n", added, category))
+	response.WriteString(fmt.Sprintf("• Skipped blank lines: %d\n", blank))
+	response.WriteString(fmt.Sprintf("• Skipped duplicates: %d\n", duplicates))
+	if failed > 0 {
+		response.WriteString(fmt.Sprintf("❌ Failed to add: %d (see logs)\n", failed))
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
 func (ah *AdminHandler) handleRemoveQuestion(ctx context.Context, args []string) (*SlashCommandResponse, error) {
 	if len(args) < 1 {
 		return &SlashCommandResponse{
@@ -258,54 +784,104 @@ func (ah *AdminHandler) handleHelp() (*SlashCommandResponse, error) {
 **📝 Question Management:**
      • admin add-question "Question text" category - Add new question to rotation
      • admin list-questions category - View questions by category (work, fun, tech, etc.)
+     • admin import-questions category - Add one question per line from the following lines, skipping blanks and duplicates
      • admin test-rotation category - Preview next question in rotation
      • admin remove-question question_id - Permanently delete a question
+     • admin question-stats category - Show how often each question is answered (least used first)
+     • admin reset-rotation category - Mark every question in a category as never used (e.g. after an import)
 
 **📊 Submission Management:**
      • admin list-submissions - Show all recent news submissions with details
      • admin list-submissions [user_id] - Filter submissions by specific user
-     • admin remove-submission [@username|user_id] - Remove user's submissions and cleanup assignments
+     • admin remove-submission [@username|user_id] [--hard] [--force] - Remove user's submissions and cleanup assignments (--hard deletes for good)
+     • admin export-range YYYY-MM-DD YYYY-MM-DD - Summarize submissions between two dates (inclusive)
+     • admin leaderboard [weeks] - Top contributors by submission count (default: last 4 weeks)
+     • admin content-mix [weeks] [--submitted-only] - Assignments per content type (default: last 12 weeks)
+     • admin fairness [content_type] [weeks] [@user1 @user2 | @usergroup] - Assignments per person, flagging over/under-assigned people; with users or a group, people never assigned are included (default: feature, last 12 weeks)
 
 **📰 Article Management:**
      • admin list-published-articles - View all published articles with IDs for management
-     • admin delete-article article_id - Permanently remove published article from newsletter
-     • admin rerun-submission submission_id - Re-process submission with AI journalist
+     • admin delete-article article_id [--force] - Permanently remove published article from newsletter
+     • admin rerun-submission submission_id [--force] - Re-process submission with AI journalist, approving it if it was flagged for review
+     • admin reprocess submission_id journalist_type [--force] - Re-process with a chosen journalist when a submission landed in the wrong category
+     • admin dead-letters - List submissions whose AI processing failed for good
+     • admin process-backlog [--force] - Process submissions that never got an article, e.g. after a crash
+     • admin dead-letters reprocess submission_id [--force] - Re-process a dead-lettered submission with its assignment's journalist
+     • admin refine submission_id "feedback" [--force] - Have the journalist revise an article, e.g. "make it shorter"
+     • admin article-history submission_id - List every processed version of a submission with word counts and what changed
+     • admin submission-history submission_id - Show what a submission said before each of its author's edits
+     • admin journalists - List the journalist types with their names, word limits and template formats
+     • admin preview journalist_type "Sample text" - Show how a journalist would write up a sample, without saving anything
+     • admin set-journalist-prompt type "System prompt" - Override a journalist's system prompt without a redeploy
+     • admin reset-journalist-prompt [type] - Go back to a journalist's built-in system prompt
+     • admin export-csv issue_id - Receive a CSV overview of an issue's articles as a DM
 
 **📅 Weekly Automation:**
-     • admin assign-question [feature|general|body_mind] [@user1 @user2] - Send personalized assignments
-     • admin week-status - Comprehensive dashboard: assignments, submissions, completion rates
-     • admin pool-status - Body/mind question pool levels, usage analytics, low-pool alerts
-     • admin broadcast-bodymind - Send wellness question request to all workspace users
+     • admin assign-question [feature|general|interview|body_mind] [@user1 @user2] [--force] - Send personalized assignments
+       Pass a @usergroup to assign every member; members already assigned this week are skipped
+     • admin plan-week [@user1 @user2 | @usergroup] - Preview who the rotation would assign to each open slot this week, without assigning
+     • admin week-status [week] [year] - Comprehensive dashboard: assignments, submissions, completion rates (defaults to current week)
+     • admin weekly-digest - DM every super admin this week's plan, pool status and last week's unsubmitted assignments
+     • admin pool-status [category] - Body/mind question pool levels, usage analytics, low-pool alerts
+     • admin promote-anonymous category [page] [--confirm id1,id2] - Review anonymous submissions and add the confirmed ones to the category's body/mind pool (all anonymous submissions are offered; the category is only the destination)
+     • admin set-theme [week] [year] "Theme" - Give an issue a theme for the masthead and intro; "" clears it (defaults to current week)
+     • admin generate-intro [week] [year] - Write the issue's "In this issue" intro from its article headlines (defaults to current week)
+     • admin publish-week [week] [year] - Publish an issue so everyone can read it; it is locked afterwards (defaults to current week)
+     • admin cost-report [week] - AI token usage and estimated cost for a week's issue
+     • admin list-issues [status] - Archive of issues by status (default: published)
+     • admin chase - DM a reminder to everyone assigned this week who hasn't submitted yet
+     • admin cleanup-stale [weeks] [--dry-run] - Delete unsubmitted assignments older than N weeks (default: 4)
+     • admin archive-questions [weeks] - Archive body/mind questions used more than N weeks ago (default: 12)
+     • admin broadcast-bodymind [--dry-run] - Send wellness question request to all workspace users (--dry-run previews recipients and message)
 
 **🎯 Content Categories:**
      • feature - Product launches, major announcements, team achievements
      • general - News updates, interesting articles, team updates, general content
+     • interview - Q&A pieces and conversations with colleagues
      • body_mind - Wellness questions (anonymous pool for privacy)
 
 **📋 Usage Examples:**
      > admin add-question "What innovative solution did your team implement this week?" tech
      > admin list-questions work
+     > admin question-stats work
      > admin assign-question feature @john.doe @jane.smith
+     > admin plan-week @newsletter-crew
      > admin week-status
+     > admin week-status 12 2025
      > admin pool-status
+     > admin promote-anonymous wellness --confirm 12,15,19
      > admin remove-question 42
      > admin list-published-articles
      > admin delete-article 15
      > admin rerun-submission 23
+     > admin reprocess 23 interview
+     > admin preview feature "We shipped the new onboarding flow"
+     > admin refine 23 "make it shorter and less snarky"
+     > admin article-history 23
+
+**🔐 Roles:**
+     • Super admins can run every command
+     • admin add-admin [@user|user_id] - Make someone a super admin without a redeploy (same as grant-role super)
+     • admin remove-admin [@user|user_id] - Take back super admin access given with add-admin
+     • admin grant-role [@user|user_id] [super|editor] - Give someone admin or editor access without a redeploy
+     • admin revoke-role [@user|user_id] - Take back a role given with grant-role (configured roles stay until the config changes)
+     • admin list-roles - Show configured and granted admins and editors
+     • Editors can run read/review commands (list-*, question-stats, export-range, export-csv, test-rotation, week-status, pool-status, cost-report, article-history, submission-history, journalists, leaderboard, content-mix, fairness, preview, plan-week)
 
 **💡 Pro Tips:**
      • Use @username or user IDs for assign-question
      • Week status shows completion rates and helps track engagement
      • Pool status alerts when body/mind questions run low
      • Remove-submission also cleans up weekly assignments automatically
+     • Published issues are locked: commands that would change one refuse unless you add --force
 
 **Other:**
      • admin help - Show this help message`
 
-	return &SlashCommandResponse{
+	return withBlocks(&SlashCommandResponse{
 		Text:         help,
 		ResponseType: "ephemeral",
-	}, nil
+	}), nil
 }
 
 func (ah *AdminHandler) handleListQuestions(ctx context.Context, args []string) (*SlashCommandResponse, error) {
@@ -350,592 +926,3283 @@ func (ah *AdminHandler) handleListQuestions(ctx context.Context, args []string)
 	}, nil
 }
 
-func (ah *AdminHandler) handleTestRotation(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+// handleQuestionStats reports submission counts per question to help prune stale questions
+func (ah *AdminHandler) handleQuestionStats(ctx context.Context, args []string) (*SlashCommandResponse, error) {
 	if len(args) < 1 {
 		return &SlashCommandResponse{
-			Text:         "Usage: admin test-rotation category",
+			Text:         "Usage: admin question-stats category",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
 	category := args[0]
-	question, err := ah.questionSelector.SelectNextQuestion(ctx, category)
+	stats, err := ah.questionSelector.GetQuestionUsageStats(ctx, category)
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to select question: %v", err),
+			Text:         fmt.Sprintf("❌ Failed to get question stats: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	usedStatus := "🆕 Never used"
-	if question.LastUsedAt != nil {
-		usedStatus = fmt.Sprintf("🔄 Last used: %s", question.LastUsedAt.Format("Jan 2, 2006"))
+	if len(stats) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("No questions found in category '%s'", category),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("📊 Question usage for '%s' (least used first):\n\n", category))
+
+	for _, stat := range stats {
+		usedStatus := "Never used"
+		if stat.LastUsedAt != nil {
+			usedStatus = fmt.Sprintf("Last used: %s", stat.LastUsedAt.Format("Jan 2, 2006"))
+		}
+
+		response.WriteString(fmt.Sprintf("#%d: %s\n   _%d submissions • %s_\n\n",
+			stat.Question.ID, stat.Question.Text, stat.UseCount, usedStatus))
 	}
 
 	return &SlashCommandResponse{
-		Text: fmt.Sprintf("🎯 Next question for '%s' category:\n\n> %s\n\n%s",
-			category, question.Text, usedStatus),
+		Text:         response.String(),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// handleListSubmissions handles listing news submissions
-func (ah *AdminHandler) handleListSubmissions(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.submissionManager == nil {
+// handleResetRotation clears last-used timestamps for a category so its questions count as never used
+func (ah *AdminHandler) handleResetRotation(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
 		return &SlashCommandResponse{
-			Text:         "❌ Submission management is not available.",
+			Text:         "Usage: admin reset-rotation category",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	var submissions []database.Submission
-	var err error
-
-	// Check if filtering by specific user
-	if len(args) > 0 {
-		userID := args[0]
-		submissions, err = ah.submissionManager.GetSubmissionsByUser(ctx, userID)
-		if err != nil {
-			return &SlashCommandResponse{
-				Text:         fmt.Sprintf("❌ Failed to get submissions for user %s: %v", userID, err),
-				ResponseType: "ephemeral",
-			}, nil
-		}
-	} else {
-		// Get all submissions
-		submissions, err = ah.submissionManager.GetAllSubmissions(ctx)
-		if err != nil {
-			return &SlashCommandResponse{
-				Text:         fmt.Sprintf("❌ Failed to get submissions: %v", err),
-				ResponseType: "ephemeral",
-			}, nil
-		}
+	category := args[0]
+	questions, err := ah.questionSelector.GetQuestionsByCategory(ctx, category)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get questions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	if len(submissions) == 0 {
+	if len(questions) == 0 {
 		return &SlashCommandResponse{
-			Text:         "📰 No news submissions found.",
+			Text:         fmt.Sprintf("No questions found in category '%s'", category),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Format the response
-	var response strings.Builder
-	if len(args) > 0 {
-		response.WriteString(fmt.Sprintf("📰 News submissions for user %s:\n\n", args[0]))
-	} else {
-		response.WriteString(fmt.Sprintf("📰 All news submissions (%d total):\n\n", len(submissions)))
+	used := 0
+	for _, q := range questions {
+		if q.LastUsedAt != nil {
+			used++
+		}
 	}
 
-	for i, submission := range submissions {
-		response.WriteString(fmt.Sprintf("**#%d** (ID: %d)\n", i+1, submission.ID))
-		response.WriteString(fmt.Sprintf("👤 User: %s\n", submission.UserID))
-		response.WriteString(fmt.Sprintf("📅 Submitted: %s\n", submission.CreatedAt.Format("Jan 2, 2006 15:04")))
-		response.WriteString(fmt.Sprintf("📝 Content: %s\n\n", submission.Content))
-
-		// Add separator for readability (except for last item)
-		if i < len(submissions)-1 {
-			response.WriteString("---\n\n")
-		}
+	if err := ah.questionSelector.ResetCategoryRotation(ctx, category); err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to reset rotation: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
+	slog.Info("Reset question rotation", "category", category, "reset", used)
+
 	return &SlashCommandResponse{
-		Text:         response.String(),
+		Text:         fmt.Sprintf("✅ Reset rotation for %d of %d questions in '%s'. They will now be picked as if never used.", used, len(questions), category),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// handleRemoveSubmission handles removing news submissions for a specific user
-func (ah *AdminHandler) handleRemoveSubmission(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.submissionManager == nil {
-		return &SlashCommandResponse{
-			Text:         "❌ Submission management is not available.",
-			ResponseType: "ephemeral",
-		}, nil
-	}
+// defaultContentMixWeeks is how far back content-mix looks when no period is given
+const defaultContentMixWeeks = 12
 
-	if len(args) < 1 {
+// handleContentMix shows how assignments were spread over content types in the last [weeks],
+// counting only assignments that got a submission with --submitted-only
+func (ah *AdminHandler) handleContentMix(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
 		return &SlashCommandResponse{
-			Text:         "Usage: admin remove-submission [@username|user_id]",
+			Text:         "❌ Database not available",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	userIdentifier := args[0]
-
-	// Resolve username to user ID (handles both usernames and user IDs)
-	userID, err := ah.resolveUserIdentifier(ctx, userIdentifier)
-	if err != nil {
-		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to resolve user '%s': %v", userIdentifier, err),
-			ResponseType: "ephemeral",
-		}, nil
-	}
-
-	// Clean up assignment records FIRST (to allow new assignments)
-	// Do this regardless of whether there are submissions or not
-	if ah.db != nil {
-		now := time.Now()
-		currentYear, currentWeek := now.ISOWeek()
-		slog.Info("remove-submission: cleaning up assignments",
-			"user", userID, "week", currentWeek, "year", currentYear)
-
-		issue, err := ah.db.GetOrCreateWeeklyIssue(currentWeek, currentYear)
-		if err == nil {
-			slog.Info("remove-submission: found issue for cleanup",
-				"issue_id", issue.ID, "week", issue.WeekNumber, "year", issue.Year)
-
-			// Delete assignments for this user in current week
-			// This allows them to get new assignments after removal
-			err = ah.db.DeletePersonAssignmentsByUser(userID, issue.ID)
-			if err != nil {
-				// Log error but don't fail the whole operation
-				slog.Warn("Failed to clean up assignments during remove-submission",
-					"user", userID, "issue", issue.ID, "error", err)
-			} else {
-				slog.Info("remove-submission: successfully cleaned up assignments",
-					"user", userID, "issue_id", issue.ID)
-			}
-		} else {
-			slog.Warn("remove-submission: failed to get issue for cleanup",
-				"week", currentWeek, "year", currentYear, "error", err)
+	weeks := defaultContentMixWeeks
+	submittedOnly := false
+	for _, arg := range args {
+		if arg == "--submitted-only" {
+			submittedOnly = true
+			continue
 		}
-
-		// As an additional safety measure, clean up ALL assignments for this user
-		// This handles edge cases where assignments might exist in other issues
-		err = ah.db.DeleteAllPersonAssignmentsByUser(userID)
-		if err != nil {
-			slog.Warn("remove-submission: failed to clean up all assignments",
-				"user", userID, "error", err)
-		} else {
-			slog.Info("remove-submission: cleaned up all assignments for user",
-				"user", userID)
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 1 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid number of weeks '%s'. Usage: admin content-mix [weeks] [--submitted-only]", arg),
+				ResponseType: "ephemeral",
+			}, nil
 		}
+		weeks = n
 	}
 
-	// Get all submissions for this user
-	submissions, err := ah.submissionManager.GetSubmissionsByUser(ctx, userID)
+	since := time.Now().AddDate(0, 0, -7*weeks)
+	var distribution map[database.ContentType]int
+	var err error
+	if submittedOnly {
+		distribution, err = ah.db.GetSubmittedContentTypeDistribution(since)
+	} else {
+		distribution, err = ah.db.GetContentTypeDistribution(since)
+	}
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to get submissions for user %s: %v", userIdentifier, err),
+			Text:         fmt.Sprintf("❌ Failed to get content mix: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Delete each submission
-	var deletedCount int
-	var errors []string
-
-	for _, submission := range submissions {
-		err := ah.submissionManager.DeleteSubmission(ctx, submission.ID)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Failed to delete submission %d: %v", submission.ID, err))
-			continue
-		}
-		deletedCount++
+	total := 0
+	for _, count := range distribution {
+		total += count
 	}
-
-	// Format response
-	var responseText strings.Builder
-
-	if deletedCount > 0 {
-		responseText.WriteString(fmt.Sprintf("✅ Successfully removed %d submission(s) for user %s.\n", deletedCount, userIdentifier))
+	kind := "assignments"
+	if submittedOnly {
+		kind = "submitted assignments"
+	}
+	if total == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No %s in the last %d weeks.", kind, weeks),
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	if len(errors) > 0 {
-		if deletedCount > 0 {
-			responseText.WriteString("\n")
-		}
-		responseText.WriteString("❌ Errors occurred:\n")
-		for _, errMsg := range errors {
-			responseText.WriteString(fmt.Sprintf("• %s\n", errMsg))
+	// Known types in plan-week order, then anything else alphabetically
+	contentTypes := append([]database.ContentType(nil), planWeekContentTypes...)
+	var others []database.ContentType
+	for contentType := range distribution {
+		if !containsContentType(planWeekContentTypes, contentType) {
+			others = append(others, contentType)
 		}
 	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	contentTypes = append(contentTypes, others...)
 
-	if deletedCount == 0 && len(errors) == 0 {
-		responseText.WriteString(fmt.Sprintf("✅ Cleaned up assignments for user %s. No submissions found to remove.", userIdentifier))
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("📊 *Content mix (last %d weeks)*\n%d %s\n\n", weeks, total, kind))
+	for _, contentType := range contentTypes {
+		count := distribution[contentType]
+		response.WriteString(fmt.Sprintf("• %s: %d (%d%%)\n", contentType, count, (count*100+total/2)/total))
 	}
 
 	return &SlashCommandResponse{
-		Text:         responseText.String(),
+		Text:         response.String(),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// Weekly automation command handlers
-
-// handleAssignQuestion handles sending questions to users for current week assignments
-func (ah *AdminHandler) handleAssignQuestion(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.db == nil {
-		return &SlashCommandResponse{
-			Text:         "❌ Weekly automation is not available.",
-			ResponseType: "ephemeral",
-		}, nil
+// containsContentType reports whether contentTypes includes contentType
+func containsContentType(contentTypes []database.ContentType, contentType database.ContentType) bool {
+	for _, ct := range contentTypes {
+		if ct == contentType {
+			return true
+		}
 	}
+	return false
+}
 
-	if len(args) < 2 {
+// defaultLeaderboardWeeks is how far back the leaderboard looks when no period is given
+const defaultLeaderboardWeeks = 4
+
+// leaderboardSize caps how many contributors the leaderboard lists
+const leaderboardSize = 10
+
+// handleLeaderboard ranks contributors by how many submissions they made in the last N weeks
+func (ah *AdminHandler) handleLeaderboard(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.submissionManager == nil {
 		return &SlashCommandResponse{
-			Text:         "Usage: admin assign-question [feature|general|body_mind] [@user1 @user2 ...]\nExample: admin assign-question feature @john.doe",
+			Text:         "❌ Submission management not available",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	contentType := args[0]
-	users := args[1:]
-
-	// Validate content type
-	validContentTypes := map[string]database.ContentType{
-		"feature":   database.ContentTypeFeature,
-		"general":   database.ContentTypeGeneral,
-		"body_mind": database.ContentTypeBodyMind,
+	weeks := defaultLeaderboardWeeks
+	if len(args) > 0 {
+		requestedWeeks, err := strconv.Atoi(args[0])
+		if err != nil || requestedWeeks < 1 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid number of weeks '%s'. Use a positive number.", args[0]),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		weeks = requestedWeeks
 	}
 
-	dbContentType, valid := validContentTypes[contentType]
-	if !valid {
+	since := time.Now().AddDate(0, 0, -7*weeks)
+	counts, err := ah.submissionManager.GetUserSubmissionCounts(ctx, since)
+	if err != nil {
 		return &SlashCommandResponse{
-			Text:         "❌ Content type must be 'feature', 'general', or 'body_mind'",
+			Text:         fmt.Sprintf("❌ Failed to get submission counts: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get current week and create issue if needed
-	now := time.Now()
-	currentYear, currentWeek := now.ISOWeek()
-	slog.Info("assign-question: attempting assignment",
-		"week", currentWeek, "year", currentYear, "users", len(users))
-
-	issue, err := ah.db.GetOrCreateWeeklyIssue(currentWeek, currentYear)
-	if err != nil {
+	if len(counts) == 0 {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to get weekly issue: %v", err),
+			Text:         fmt.Sprintf("📭 No submissions in the last %d weeks.", weeks),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	slog.Info("assign-question: using issue",
-		"issue_id", issue.ID, "week", issue.WeekNumber, "year", issue.Year)
-
-	var successfulAssignments []string
-	var errors []string
-
-	for _, userArg := range users {
-		// Resolve user identifier (handles both user IDs and usernames)
-		userID, err := ah.resolveUserIdentifier(ctx, userArg)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("User %s: %v", userArg, err))
-			continue
+	userIDs := make([]string, 0, len(counts))
+	for userID := range counts {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool {
+		if counts[userIDs[i]] != counts[userIDs[j]] {
+			return counts[userIDs[i]] > counts[userIDs[j]]
 		}
+		return userIDs[i] < userIDs[j]
+	})
 
-		// Select question based on content type
-		var question *database.Question
-		var questionText string
-
-		if contentType == "body_mind" {
-			// For body_mind, use anonymous question pool
-			if ah.poolManager == nil {
-				errors = append(errors, fmt.Sprintf("User %s: Body/mind pool not available", userID))
-				continue
-			}
-			bodyMindQuestions, err := ah.db.GetActiveBodyMindQuestions()
-			if err != nil || len(bodyMindQuestions) == 0 {
-				errors = append(errors, fmt.Sprintf("User %s: No body/mind questions available", userID))
-				continue
-			}
-			// Use first available question (could be improved with better selection)
-			bodyMindQ := bodyMindQuestions[0]
-			questionText = bodyMindQ.QuestionText
-			// Mark as used
-			if err := ah.db.MarkBodyMindQuestionUsed(bodyMindQ.ID); err != nil {
-				errors = append(errors, fmt.Sprintf("User %s: Failed to mark question as used", userID))
-				continue
-			}
-		} else {
-			// For feature/general, use regular question rotation
-			question, err = ah.questionSelector.SelectNextQuestion(ctx, contentType)
-			if err != nil {
-				errors = append(errors, fmt.Sprintf("User %s: Failed to select question: %v", userID, err))
-				continue
-			}
-			questionText = question.Text
-
-			// Mark question as used
-			if err := ah.questionSelector.MarkQuestionUsed(ctx, question.ID); err != nil {
-				errors = append(errors, fmt.Sprintf("User %s: Failed to mark question as used", userID))
-				continue
-			}
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("🏆 *Top Contributors (last %d weeks)*\n\n", weeks))
+	for i, userID := range userIDs {
+		if i == leaderboardSize {
+			break
 		}
+		response.WriteString(fmt.Sprintf("%d. <@%s> - %d submission(s)\n", i+1, userID, counts[userID]))
+	}
 
-		// Create assignment record
-		assignment := database.PersonAssignment{
-			IssueID:     issue.ID,
-			PersonID:    userID,
-			ContentType: dbContentType,
-			AssignedAt:  now,
-		}
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
 
-		if question != nil {
-			assignment.QuestionID = &question.ID
-		}
+// defaultFairnessWeeks is how far back fairness looks in the assignments when no period is given
+const defaultFairnessWeeks = 12
 
-		slog.Info("assign-question: creating assignment",
-			"user", userID, "issue_id", assignment.IssueID, "content_type", assignment.ContentType)
+// handleFairness counts how often each person was assigned a content type in the last N weeks and
+// flags anyone at 1.5x the average or more as over-assigned, and at half the average or less as under-assigned
+func (ah *AdminHandler) handleFairness(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Weekly automation not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-		_, err = ah.db.CreatePersonAssignment(assignment)
-		if err != nil {
-			slog.Warn("assign-question: assignment creation failed",
-				"user", userID, "issue_id", assignment.IssueID, "error", err)
-			errors = append(errors, fmt.Sprintf("User %s: Failed to create assignment: %v", userID, err))
+	contentType := database.ContentTypeFeature
+	weeks := defaultFairnessWeeks
+	var candidateArgs []string
+	for _, arg := range args {
+		if n, err := strconv.Atoi(arg); err == nil {
+			if n < 1 {
+				return &SlashCommandResponse{
+					Text:         fmt.Sprintf("❌ Invalid number of weeks '%s'. Use a positive number.", arg),
+					ResponseType: "ephemeral",
+				}, nil
+			}
+			weeks = n
 			continue
 		}
-
-		slog.Info("assign-question: assignment created successfully",
-			"user", userID, "issue_id", assignment.IssueID)
-
-		// Send direct message to user with question
-		message := ah.createQuestionMessage(questionText, contentType, currentWeek, currentYear)
-		var messageError error
-		if ah.broadcastManager != nil {
-			messageError = ah.sendDirectMessage(ctx, userID, message)
+		if looksLikeUserArg(arg) {
+			candidateArgs = append(candidateArgs, arg)
+			continue
+		}
+		if !containsContentType(planWeekContentTypes, database.ContentType(arg)) {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid content type '%s'. Usage: admin fairness [feature|general|interview|body_mind] [weeks] [@user1 @user2 ... | @usergroup]", arg),
+				ResponseType: "ephemeral",
+			}, nil
 		}
+		contentType = database.ContentType(arg)
+	}
 
-		// Always mark as successful assignment if we got this far (database operations succeeded)
-		successfulAssignments = append(successfulAssignments, userID)
+	counts, err := ah.db.GetAssignmentFairness(contentType, weeks)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get assignment fairness: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-		// But note message sending errors separately
-		if messageError != nil {
-			errors = append(errors, fmt.Sprintf("User %s: Assignment created but message failed: %v", userID, messageError))
+	// With candidates, fairness is judged within that group, including people never assigned
+	var resolveErrors []string
+	if len(candidateArgs) > 0 {
+		var candidates []string
+		candidates, resolveErrors = ah.resolveCandidates(ctx, candidateArgs)
+		groupCounts := make(map[string]int, len(candidates))
+		for _, personID := range candidates {
+			groupCounts[personID] = counts[personID]
 		}
+		counts = groupCounts
 	}
 
-	// Format response
-	var responseText strings.Builder
+	total := 0
+	personIDs := make([]string, 0, len(counts))
+	for personID, count := range counts {
+		total += count
+		personIDs = append(personIDs, personID)
+	}
 
-	if len(successfulAssignments) > 0 {
-		responseText.WriteString("✅ Successfully assigned questions:\n")
-		for _, userID := range successfulAssignments {
-			responseText.WriteString(fmt.Sprintf("• %s content → %s\n", contentType, userID))
+	if total == 0 {
+		text := fmt.Sprintf("📭 No %s assignments in the last %d weeks.", contentType, weeks)
+		if len(resolveErrors) > 0 {
+			text += fmt.Sprintf("\n❌ Could not resolve: %s", strings.Join(resolveErrors, "; "))
 		}
+		return &SlashCommandResponse{
+			Text:         text,
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	if len(errors) > 0 {
-		if len(successfulAssignments) > 0 {
-			responseText.WriteString("\n")
+	sort.Slice(personIDs, func(i, j int) bool {
+		if counts[personIDs[i]] != counts[personIDs[j]] {
+			return counts[personIDs[i]] > counts[personIDs[j]]
 		}
-		responseText.WriteString("❌ Errors:\n")
-		for _, errMsg := range errors {
-			responseText.WriteString(fmt.Sprintf("• %s\n", errMsg))
+		return personIDs[i] < personIDs[j]
+	})
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("⚖️ *%s assignment fairness (last %d weeks)*\n%d assignments across %d people, %.1f on average\n\n",
+		contentType, weeks, total, len(counts), float64(total)/float64(len(counts))))
+	for _, personID := range personIDs {
+		count := counts[personID]
+		// Compare count against the average total/len(counts) without rounding
+		marker := ""
+		switch {
+		case 2*count*len(counts) >= 3*total:
+			marker = " ⬆️ over-assigned"
+		case 2*count*len(counts) <= total:
+			marker = " ⬇️ under-assigned"
 		}
+		response.WriteString(fmt.Sprintf("• <@%s> - %d%s\n", personID, count, marker))
 	}
-
-	if len(successfulAssignments) == 0 && len(errors) == 0 {
-		responseText.WriteString("❌ No assignments were processed.")
+	if len(candidateArgs) == 0 {
+		response.WriteString("\nPeople with no assignments in the period are not listed; pass @users or a @usergroup to include them.")
+	}
+	if len(resolveErrors) > 0 {
+		response.WriteString(fmt.Sprintf("\n❌ Could not resolve: %s", strings.Join(resolveErrors, "; ")))
 	}
 
 	return &SlashCommandResponse{
-		Text:         responseText.String(),
+		Text:         response.String(),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// contentTypeToCategory maps admin contentType to submission category
-func contentTypeToCategory(contentType string) string {
-	switch contentType {
-	case "feature":
-		return "feature"
-	case "general":
-		return "general"
-	case "body_mind":
-		return "body_mind"
-	default:
-		return "general" // fallback
-	}
+// looksLikeUserArg reports whether an argument names a user or usergroup rather than an option
+func looksLikeUserArg(arg string) bool {
+	return strings.HasPrefix(arg, "@") || strings.HasPrefix(arg, "<") || (strings.HasPrefix(arg, "U") && len(arg) > 5)
 }
 
-// createQuestionMessage creates the DM message with the question
-func (ah *AdminHandler) createQuestionMessage(questionText, contentType string, week, year int) string {
-	category := contentTypeToCategory(contentType)
-	return fmt.Sprintf("📝 *Newsletter Assignment - Week %d, %d*\n\n"+
-		"You've been assigned to write %s content for this week's newsletter.\n\n"+
-		"*Your question:*\n> %s\n\n"+
-		"Please submit your response using: `/pp submit %s \"your content here\"`\n\n"+
-		"You can also simply reply to this message with your content.\n\n"+
-		"Need help? Contact an admin or check `/pp help` for more options.",
-		week, year, contentType, questionText, category)
-}
+func (ah *AdminHandler) handleTestRotation(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin test-rotation category",
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-// sendDirectMessage sends a direct message to a user (wrapper for broadcast manager)
-func (ah *AdminHandler) sendDirectMessage(ctx context.Context, userID, message string) error {
-	if ah.broadcastManager == nil {
-		return fmt.Errorf("broadcast manager not available")
+	category := args[0]
+	question, err := ah.questionSelector.SelectNextQuestion(ctx, category)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to select question: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
 	}
-	// Use the broadcast manager's sendDirectMessage method (it's private but we can call it from same package)
-	return ah.broadcastManager.sendDirectMessage(ctx, userID, message)
-}
 
-// resolveUserIdentifier converts a username or user identifier to a Slack user ID
-func (ah *AdminHandler) resolveUserIdentifier(ctx context.Context, userArg string) (string, error) {
-	// Strip @ prefix if present
+	usedStatus := "🆕 Never used"
+	if question.LastUsedAt != nil {
+		usedStatus = fmt.Sprintf("🔄 Last used: %s", question.LastUsedAt.Format("Jan 2, 2006"))
+	}
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🎯 Next question for '%s' category:\n\n> %s\n\n%s",
+			category, question.Text, usedStatus),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleListSubmissions handles listing news submissions
+func (ah *AdminHandler) handleListSubmissions(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.submissionManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Submission management is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(args) == 0 {
+		return ah.listAllSubmissions(ctx)
+	}
+
+	// Filtering by specific user
+	userID := args[0]
+	submissions, err := ah.submissionManager.GetSubmissionsByUser(ctx, userID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get submissions for user %s: %v", userID, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(submissions) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📰 No news submissions found.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Format the response
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("📰 News submissions for user %s:\n\n", userID))
+
+	for i, submission := range submissions {
+		writeSubmissionListEntry(&response, i+1, submission)
+
+		// Add separator for readability (except for last item)
+		if i < len(submissions)-1 {
+			response.WriteString("---\n\n")
+		}
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// listAllSubmissions streams every live submission, oldest first, straight into the response
+// instead of loading the whole table first
+func (ah *AdminHandler) listAllSubmissions(ctx context.Context) (*SlashCommandResponse, error) {
+	var entries strings.Builder
+	count := 0
+	err := ah.submissionManager.IterateSubmissions(ctx, func(submission database.Submission) error {
+		// Add separator for readability (between items)
+		if count > 0 {
+			entries.WriteString("---\n\n")
+		}
+		count++
+		writeSubmissionListEntry(&entries, count, submission)
+		return nil
+	})
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get submissions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if count == 0 {
+		return &SlashCommandResponse{
+			Text:         "📰 No news submissions found.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("📰 All news submissions (%d total, oldest first):\n\n", count) + entries.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// writeSubmissionListEntry formats one list-submissions entry
+func writeSubmissionListEntry(response *strings.Builder, n int, submission database.Submission) {
+	response.WriteString(fmt.Sprintf("**#%d** (ID: %d)\n", n, submission.ID))
+	response.WriteString(fmt.Sprintf("👤 User: %s\n", submission.UserID))
+	response.WriteString(fmt.Sprintf("📅 Submitted: %s\n", submission.CreatedAt.Format("Jan 2, 2006 15:04")))
+	response.WriteString(fmt.Sprintf("📝 Content: %s\n\n", submission.Content))
+}
+
+// handleExportRange summarizes submissions created between two dates, both days inclusive
+func (ah *AdminHandler) handleExportRange(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.submissionManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Submission management is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(args) < 2 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin export-range YYYY-MM-DD YYYY-MM-DD",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Interpret dates in the publication time zone when available
+	loc := time.UTC
+	if ah.db != nil {
+		loc = ah.db.Location()
+	}
+
+	start, err := time.ParseInLocation("2006-01-02", args[0], loc)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid start date '%s'. Use YYYY-MM-DD.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	endDay, err := time.ParseInLocation("2006-01-02", args[1], loc)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid end date '%s'. Use YYYY-MM-DD.", args[1]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if endDay.Before(start) {
+		return &SlashCommandResponse{
+			Text:         "❌ End date must be on or after the start date.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Include the whole end day
+	end := endDay.AddDate(0, 0, 1)
+
+	// Summarize per user and submission type, streaming so long ranges aren't loaded at once
+	perUser := make(map[string]int)
+	var users []string
+	var first, last time.Time
+	total, newsCount := 0, 0
+	err = ah.submissionManager.IterateSubmissionsByDateRange(ctx, start, end, func(submission database.Submission) error {
+		if total == 0 {
+			first = submission.CreatedAt
+		}
+		last = submission.CreatedAt
+		total++
+
+		userID := submission.UserID
+		if userID == "" {
+			userID = "anonymous"
+		}
+		if perUser[userID] == 0 {
+			users = append(users, userID)
+		}
+		perUser[userID]++
+
+		if submission.QuestionID == nil {
+			newsCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get submissions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if total == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📰 No submissions found between %s and %s.", args[0], args[1]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("📦 Submissions from %s to %s: %d total\n\n", args[0], args[1], total))
+	response.WriteString(fmt.Sprintf("📰 News: %d\n", newsCount))
+	response.WriteString(fmt.Sprintf("❓ Question answers: %d\n", total-newsCount))
+	response.WriteString(fmt.Sprintf("📅 First: %s\n", first.In(loc).Format("Jan 2, 2006 15:04")))
+	response.WriteString(fmt.Sprintf("📅 Last: %s\n\n", last.In(loc).Format("Jan 2, 2006 15:04")))
+
+	response.WriteString("👥 *By contributor:*\n")
+	for _, userID := range users {
+		response.WriteString(fmt.Sprintf("└─ %s: %d\n", userID, perUser[userID]))
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleRemoveSubmission handles removing news submissions for a specific user
+func (ah *AdminHandler) handleRemoveSubmission(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.submissionManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Submission management is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// --hard deletes for good instead of soft-deleting, and may come before or after the user
+	force, args := forceFlag(args)
+	var hard bool
+	var rest []string
+	for _, arg := range args {
+		if arg == "--hard" {
+			hard = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+
+	if len(rest) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin remove-submission [@username|user_id] [--hard] [--force]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if hard && ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Permanent deletion needs the database, which is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	userIdentifier := rest[0]
+
+	// Resolve username to user ID (handles both usernames and user IDs)
+	userID, err := ah.resolveUserIdentifier(ctx, userIdentifier)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to resolve user '%s': %v", userIdentifier, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Removing the user's submissions and assignments would take them out of issues already sent
+	if ah.db != nil && !force {
+		published, err := ah.db.GetPublishedIssueForUser(userID)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to check for published issues: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		if published != nil {
+			return publishedIssueResponse(published, fmt.Sprintf("removing %s's submissions", userIdentifier)), nil
+		}
+	}
+
+	// Clean up assignment records FIRST (to allow new assignments)
+	// Do this regardless of whether there are submissions or not
+	if ah.db != nil {
+		now := time.Now()
+		currentYear, currentWeek := now.ISOWeek()
+		slog.Info("remove-submission: cleaning up assignments",
+			"user", userID, "week", currentWeek, "year", currentYear)
+
+		issue, err := ah.db.GetOrCreateWeeklyIssue(currentWeek, currentYear)
+		if err == nil {
+			slog.Info("remove-submission: found issue for cleanup",
+				"issue_id", issue.ID, "week", issue.WeekNumber, "year", issue.Year)
+
+			// Delete assignments for this user in current week
+			// This allows them to get new assignments after removal
+			err = ah.db.DeletePersonAssignmentsByUser(userID, issue.ID)
+			if err != nil {
+				// Log error but don't fail the whole operation
+				slog.Warn("Failed to clean up assignments during remove-submission",
+					"user", userID, "issue", issue.ID, "error", err)
+			} else {
+				slog.Info("remove-submission: successfully cleaned up assignments",
+					"user", userID, "issue_id", issue.ID)
+			}
+		} else {
+			slog.Warn("remove-submission: failed to get issue for cleanup",
+				"week", currentWeek, "year", currentYear, "error", err)
+		}
+
+		// As an additional safety measure, clean up ALL assignments for this user
+		// This handles edge cases where assignments might exist in other issues
+		err = ah.db.DeleteAllPersonAssignmentsByUser(userID)
+		if err != nil {
+			slog.Warn("remove-submission: failed to clean up all assignments",
+				"user", userID, "error", err)
+		} else {
+			slog.Info("remove-submission: cleaned up all assignments for user",
+				"user", userID)
+		}
+	}
+
+	// Get all submissions for this user
+	submissions, err := ah.submissionManager.GetSubmissionsByUser(ctx, userID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get submissions for user %s: %v", userIdentifier, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submissionIDs := make([]int, 0, len(submissions))
+	for _, submission := range submissions {
+		submissionIDs = append(submissionIDs, submission.ID)
+	}
+
+	// A hard delete also purges submissions that were soft-deleted earlier
+	if hard {
+		deletedIDs, err := ah.db.GetDeletedSubmissionIDsByUser(userID)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to get deleted submissions for user %s: %v", userIdentifier, err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		submissionIDs = append(submissionIDs, deletedIDs...)
+	}
+
+	// Delete each submission
+	var deletedCount int
+	var errors []string
+
+	for _, submissionID := range submissionIDs {
+		// Soft deletes keep the rows for audit; hard deletes cascade so processed articles aren't left orphaned
+		if hard {
+			err = ah.db.DeleteSubmissionCascade(submissionID)
+		} else {
+			err = ah.submissionManager.DeleteSubmission(ctx, submissionID)
+		}
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("Failed to delete submission %d: %v", submissionID, err))
+			continue
+		}
+		deletedCount++
+	}
+
+	// Format response
+	var responseText strings.Builder
+
+	if deletedCount > 0 && hard {
+		responseText.WriteString(fmt.Sprintf("✅ Permanently deleted %d submission(s) for user %s.\n", deletedCount, userIdentifier))
+	} else if deletedCount > 0 {
+		responseText.WriteString(fmt.Sprintf("✅ Successfully removed %d submission(s) for user %s.\n", deletedCount, userIdentifier))
+	}
+
+	if len(errors) > 0 {
+		if deletedCount > 0 {
+			responseText.WriteString("\n")
+		}
+		responseText.WriteString("❌ Errors occurred:\n")
+		for _, errMsg := range errors {
+			responseText.WriteString(fmt.Sprintf("• %s\n", errMsg))
+		}
+	}
+
+	if deletedCount == 0 && len(errors) == 0 {
+		responseText.WriteString(fmt.Sprintf("✅ Cleaned up assignments for user %s. No submissions found to remove.", userIdentifier))
+	}
+
+	return &SlashCommandResponse{
+		Text:         responseText.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// forceFlag reports whether args contain --force, which overrides the lock on published issues,
+// and returns the remaining args
+func forceFlag(args []string) (bool, []string) {
+	var force bool
+	rest := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--force" {
+			force = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return force, rest
+}
+
+// publishedIssueResponse refuses a change that would alter an issue that has already been published
+func publishedIssueResponse(issue *database.WeeklyNewsletterIssue, change string) *SlashCommandResponse {
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🔒 Week %d, %d has already been published, and %s would change it. Add --force to do it anyway.",
+			issue.WeekNumber, issue.Year, change),
+		ResponseType: "ephemeral",
+	}
+}
+
+// Weekly automation command handlers
+
+// handleAssignQuestion handles sending questions to users for current week assignments
+func (ah *AdminHandler) handleAssignQuestion(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Weekly automation is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	force, args := forceFlag(args)
+	if len(args) < 2 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin assign-question [feature|general|interview|body_mind] [@user1 @user2 ... | @usergroup] [--force]\nExample: admin assign-question feature @john.doe",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	contentType := args[0]
+	users := args[1:]
+
+	// Validate content type
+	validContentTypes := map[string]database.ContentType{
+		"feature":   database.ContentTypeFeature,
+		"general":   database.ContentTypeGeneral,
+		"interview": database.ContentTypeInterview,
+		"body_mind": database.ContentTypeBodyMind,
+	}
+
+	dbContentType, valid := validContentTypes[contentType]
+	if !valid {
+		return &SlashCommandResponse{
+			Text:         "❌ Content type must be 'feature', 'general', 'interview', or 'body_mind'",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Get current week and create issue if needed
+	now := time.Now()
+	currentYear, currentWeek := now.ISOWeek()
+	slog.Info("assign-question: attempting assignment",
+		"week", currentWeek, "year", currentYear, "users", len(users))
+
+	issue, err := ah.db.GetOrCreateWeeklyIssue(currentWeek, currentYear)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get weekly issue: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	slog.Info("assign-question: using issue",
+		"issue_id", issue.ID, "week", issue.WeekNumber, "year", issue.Year)
+
+	if issue.Status == database.IssueStatusPublished && !force {
+		return publishedIssueResponse(issue, "new assignments"), nil
+	}
+
+	var successfulAssignments []string
+	var skippedUsers []string
+	var errors []string
+
+	// Expand @usergroup handles so a whole team can be assigned in one command
+	targets, expandedGroups := ah.expandAssignmentTargets(ctx, users)
+
+	// Usergroup members who already have an assignment this week are skipped
+	alreadyAssigned := make(map[string]bool)
+	if len(expandedGroups) > 0 {
+		existing, err := ah.db.GetPersonAssignmentsByIssue(issue.ID)
+		if err != nil {
+			slog.Warn("assign-question: failed to load existing assignments", "issue_id", issue.ID, "error", err)
+		}
+		for _, assignment := range existing {
+			alreadyAssigned[assignment.PersonID] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		// Resolve user identifier (handles both user IDs and usernames)
+		userID, err := ah.resolveUserIdentifier(ctx, target.userArg)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("User %s: %v", target.userArg, err))
+			continue
+		}
+
+		// The same person can appear in several groups or be listed explicitly as well
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		if target.usergroup != "" && alreadyAssigned[userID] {
+			skippedUsers = append(skippedUsers, userID)
+			continue
+		}
+
+		assignmentID, questionText, err := ah.assignQuestion(ctx, issue, userID, dbContentType, now)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("User %s: %v", userID, err))
+			continue
+		}
+
+		// Send direct message to user with question
+		message := ah.createQuestionMessage(questionText, contentType, currentWeek, currentYear)
+		var messageError error
+		if ah.broadcastManager != nil {
+			messageError = ah.sendAssignmentMessage(ctx, userID, message, assignmentID)
+		}
+
+		// Always mark as successful assignment if we got this far (database operations succeeded)
+		successfulAssignments = append(successfulAssignments, userID)
+
+		// But note message sending errors separately
+		if messageError != nil {
+			errors = append(errors, fmt.Sprintf("User %s: Assignment created but message failed: %v", userID, messageError))
+		}
+	}
+
+	// Format response
+	var responseText strings.Builder
+
+	if len(successfulAssignments) > 0 {
+		responseText.WriteString("✅ Successfully assigned questions:\n")
+		for _, userID := range successfulAssignments {
+			responseText.WriteString(fmt.Sprintf("• %s content → %s\n", contentType, userID))
+		}
+	}
+
+	if len(skippedUsers) > 0 {
+		if len(successfulAssignments) > 0 {
+			responseText.WriteString("\n")
+		}
+		responseText.WriteString("⏭️ Skipped (already assigned this week):\n")
+		for _, userID := range skippedUsers {
+			responseText.WriteString(fmt.Sprintf("• %s\n", userID))
+		}
+	}
+
+	if len(errors) > 0 {
+		if len(successfulAssignments) > 0 || len(skippedUsers) > 0 {
+			responseText.WriteString("\n")
+		}
+		responseText.WriteString("❌ Errors:\n")
+		for _, errMsg := range errors {
+			responseText.WriteString(fmt.Sprintf("• %s\n", errMsg))
+		}
+	}
+
+	if len(expandedGroups) > 0 {
+		responseText.WriteString(fmt.Sprintf("\n📊 Usergroups %s: %d created, %d skipped\n",
+			strings.Join(expandedGroups, ", "), len(successfulAssignments), len(skippedUsers)))
+	}
+
+	if len(successfulAssignments) == 0 && len(skippedUsers) == 0 && len(errors) == 0 {
+		responseText.WriteString("❌ No assignments were processed.")
+	}
+
+	return &SlashCommandResponse{
+		Text:         responseText.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// assignQuestion picks the next question for the content type, marks it used and creates the
+// user's assignment in the issue. Body/mind questions come from the anonymous pool. Returns the
+// assignment ID and question text for the assignment DM.
+func (ah *AdminHandler) assignQuestion(ctx context.Context, issue *database.WeeklyNewsletterIssue, userID string, contentType database.ContentType, now time.Time) (int, string, error) {
+	var question *database.Question
+	var questionText string
+
+	// Turn a taken slot or an existing assignment away before a question is used up on it
+	if err := ah.db.CheckAssignmentAvailable(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    userID,
+		ContentType: contentType,
+	}); err != nil {
+		slog.Warn("assign-question: assignment not available",
+			"user", userID, "issue_id", issue.ID, "content_type", contentType, "error", err)
+		return 0, "", fmt.Errorf("Failed to create assignment: %v", err)
+	}
+
+	if contentType == database.ContentTypeBodyMind {
+		// For body_mind, use anonymous question pool
+		if ah.poolManager == nil {
+			return 0, "", fmt.Errorf("Body/mind pool not available")
+		}
+		// The pool manager takes the oldest question and asks for more once the pool runs low
+		bodyMindQ, err := ah.poolManager.SelectQuestionForNewsletter()
+		if err != nil {
+			return 0, "", fmt.Errorf("No body/mind questions available: %v", err)
+		}
+		questionText = bodyMindQ.QuestionText
+	} else {
+		// For feature/general/interview, use regular question rotation
+		var err error
+		question, err = ah.questionSelector.SelectNextQuestion(ctx, string(contentType))
+		if err != nil {
+			return 0, "", fmt.Errorf("Failed to select question: %v", err)
+		}
+		questionText = question.Text
+
+		// Mark question as used
+		if err := ah.questionSelector.MarkQuestionUsed(ctx, question.ID); err != nil {
+			return 0, "", fmt.Errorf("Failed to mark question as used")
+		}
+	}
+
+	// Create assignment record
+	assignment := database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    userID,
+		ContentType: contentType,
+		AssignedAt:  now,
+	}
+
+	if question != nil {
+		assignment.QuestionID = &question.ID
+	}
+
+	slog.Info("assign-question: creating assignment",
+		"user", userID, "issue_id", assignment.IssueID, "content_type", assignment.ContentType)
+
+	assignmentID, err := ah.db.CreatePersonAssignment(assignment)
+	if err != nil {
+		slog.Warn("assign-question: assignment creation failed",
+			"user", userID, "issue_id", assignment.IssueID, "error", err)
+		return 0, "", fmt.Errorf("Failed to create assignment: %v", err)
+	}
+
+	slog.Info("assign-question: assignment created successfully",
+		"user", userID, "issue_id", assignment.IssueID)
+
+	return assignmentID, questionText, nil
+}
+
+// contentTypeToCategory maps admin contentType to submission category
+func contentTypeToCategory(contentType string) string {
+	switch contentType {
+	case "feature":
+		return "feature"
+	case "general":
+		return "general"
+	case "interview":
+		return "interview"
+	case "body_mind":
+		return "body_mind"
+	default:
+		return "general" // fallback
+	}
+}
+
+// createQuestionMessage creates the DM message with the question
+func (ah *AdminHandler) createQuestionMessage(questionText, contentType string, week, year int) string {
+	category := contentTypeToCategory(contentType)
+	return fmt.Sprintf("📝 *Newsletter Assignment - Week %d, %d*\n\n"+
+		"You've been assigned to write %s content for this week's newsletter.\n\n"+
+		"*Your question:*\n> %s\n\n"+
+		"Please submit your response using: `/pp submit %s \"your content here\"`\n\n"+
+		"You can also simply reply to this message with your content.\n\n"+
+		"Need help? Contact an admin or check `/pp help` for more options.",
+		week, year, contentType, questionText, category)
+}
+
+// createChaseMessage creates the reminder DM for an assignment that has no submission yet
+func (ah *AdminHandler) createChaseMessage(questionText, contentType string, week, year int) string {
+	category := contentTypeToCategory(contentType)
+	message := fmt.Sprintf("⏰ *Reminder - Newsletter Week %d, %d*\n\n"+
+		"We haven't received your %s content for this week's newsletter yet.\n\n", week, year, contentType)
+	if questionText != "" {
+		message += fmt.Sprintf("*Your question:*\n> %s\n\n", questionText)
+	}
+	return message + fmt.Sprintf("Please submit your response using: `/pp submit %s \"your content here\"`\n\n"+
+		"You can also simply reply to the original assignment message.", category)
+}
+
+// sendDirectMessage sends a direct message to a user (wrapper for broadcast manager)
+func (ah *AdminHandler) sendDirectMessage(ctx context.Context, userID, message string) error {
+	if ah.broadcastManager == nil {
+		return fmt.Errorf("broadcast manager not available")
+	}
+	// Use the broadcast manager's sendDirectMessage method (it's private but we can call it from same package)
+	return ah.broadcastManager.sendDirectMessage(ctx, userID, message)
+}
+
+// assignmentTarget is a single user to assign, remembering which usergroup it came from (if any)
+type assignmentTarget struct {
+	userArg   string
+	usergroup string
+}
+
+// expandAssignmentTargets replaces usergroup handles with their members. Arguments that are not
+// a known usergroup are kept as-is and resolved as users later. Returns the expanded group handles.
+// The workspace's usergroups are listed at most once, and only if an argument looks like a group.
+func (ah *AdminHandler) expandAssignmentTargets(ctx context.Context, args []string) ([]assignmentTarget, []string) {
+	var targets []assignmentTarget
+	var groups []string
+
+	var usergroups []slack.UserGroup
+	listed := false
+
+	for _, arg := range args {
+		handle := parseUsergroupMention(arg)
+
+		// Only "@handle" and "<!subteam^ID>" can name a group, and user IDs never need a usergroup lookup
+		if ah.usergroupResolver == nil || !looksLikeUsergroup(arg) || (strings.HasPrefix(handle, "U") && len(handle) > 5) {
+			targets = append(targets, assignmentTarget{userArg: arg})
+			continue
+		}
+
+		if !listed {
+			listed = true
+			var err error
+			usergroups, err = ah.usergroupResolver.ListUsergroups(ctx)
+			if err != nil {
+				// Fall back to user lookup, e.g. when the bot lacks the usergroups:read scope
+				slog.Warn("assign-question: usergroup lookup failed", "error", err)
+			}
+		}
+
+		group, found := findUsergroup(usergroups, handle)
+		if !found {
+			targets = append(targets, assignmentTarget{userArg: arg})
+			continue
+		}
+
+		members, err := ah.usergroupResolver.GetUsergroupMembers(ctx, group.ID)
+		if err != nil {
+			slog.Warn("assign-question: usergroup member lookup failed", "handle", handle, "error", err)
+			targets = append(targets, assignmentTarget{userArg: arg})
+			continue
+		}
+
+		groups = append(groups, "@"+handle)
+		for _, member := range members {
+			targets = append(targets, assignmentTarget{userArg: member, usergroup: handle})
+		}
+	}
+
+	return targets, groups
+}
+
+// looksLikeUsergroup reports whether an assignment argument could name a usergroup
+func looksLikeUsergroup(arg string) bool {
+	return strings.HasPrefix(arg, "@") || strings.HasPrefix(arg, "<!subteam^")
+}
+
+// findUsergroup matches a usergroup by ID or case-insensitive handle
+func findUsergroup(usergroups []slack.UserGroup, handle string) (slack.UserGroup, bool) {
+	for _, group := range usergroups {
+		if group.ID == handle || strings.EqualFold(group.Handle, handle) {
+			return group, true
+		}
+	}
+	return slack.UserGroup{}, false
+}
+
+// parseUsergroupMention extracts a usergroup handle or ID from "@handle" or an escaped "<!subteam^ID|@handle>"
+func parseUsergroupMention(arg string) string {
+	if strings.HasPrefix(arg, "<!subteam^") && strings.HasSuffix(arg, ">") {
+		id := strings.TrimSuffix(strings.TrimPrefix(arg, "<!subteam^"), ">")
+		if pipe := strings.Index(id, "|"); pipe >= 0 {
+			id = id[:pipe]
+		}
+		return id
+	}
+	return strings.TrimPrefix(arg, "@")
+}
+
+// resolveUserIdentifier converts a username or user identifier to a Slack user ID
+func (ah *AdminHandler) resolveUserIdentifier(ctx context.Context, userArg string) (string, error) {
+	// Strip @ prefix if present
 	cleanInput := strings.TrimPrefix(userArg, "@")
 
-	// If it's already a user ID (starts with "U" and has reasonable length), return it
-	if strings.HasPrefix(cleanInput, "U") && len(cleanInput) > 5 {
-		return cleanInput, nil
+	// If it's already a user ID (starts with "U" and has reasonable length), return it
+	if strings.HasPrefix(cleanInput, "U") && len(cleanInput) > 5 {
+		return cleanInput, nil
+	}
+
+	// Otherwise, try to look up the user by name
+	if ah.broadcastManager == nil {
+		return "", fmt.Errorf("cannot lookup user: broadcast manager not available")
+	}
+
+	if userID, ok := ah.userIDs.get(cleanInput, time.Now()); ok {
+		return userID, nil
+	}
+
+	userID, err := ah.broadcastManager.lookupUserByName(ctx, cleanInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user '%s': %w", cleanInput, err)
+	}
+	ah.userIDs.put(cleanInput, userID, time.Now())
+
+	return userID, nil
+}
+
+// userIDCacheTTL is how long a resolved username is trusted before Slack is asked again
+const userIDCacheTTL = time.Hour
+
+// userIDCache maps usernames to Slack user IDs, case-insensitively. The zero value is ready to use.
+type userIDCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cachedUserID
+}
+
+type cachedUserID struct {
+	userID     string
+	resolvedAt time.Time
+}
+
+// get returns the cached ID for name, or false when it is unknown or older than the TTL
+func (c *userIDCache) get(name string, now time.Time) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := strings.ToLower(name)
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if now.Sub(entry.resolvedAt) > c.timeToLive() {
+		delete(c.entries, key)
+		return "", false
+	}
+	return entry.userID, true
+}
+
+// put remembers that name resolved to userID at now
+func (c *userIDCache) put(name, userID string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]cachedUserID)
+	}
+	c.entries[strings.ToLower(name)] = cachedUserID{userID: userID, resolvedAt: now}
+}
+
+func (c *userIDCache) timeToLive() time.Duration {
+	if c.ttl == 0 {
+		return userIDCacheTTL
+	}
+	return c.ttl
+}
+
+// weekAndYearFromArgs reads optional [week] [year] arguments, defaulting to the current week.
+// An invalid argument yields a ready-made error response.
+func (ah *AdminHandler) weekAndYearFromArgs(args []string) (int, int, *SlashCommandResponse) {
+	year, week := time.Now().In(ah.db.Location()).ISOWeek()
+	if len(args) == 0 {
+		return week, year, nil
+	}
+
+	requestedWeek, err := strconv.Atoi(args[0])
+	if err != nil || requestedWeek < 1 || requestedWeek > 53 {
+		return 0, 0, &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid week '%s'. Use a week number between 1 and 53.", args[0]),
+			ResponseType: "ephemeral",
+		}
+	}
+	week = requestedWeek
+
+	if len(args) > 1 {
+		requestedYear, err := strconv.Atoi(args[1])
+		if err != nil || requestedYear < 1 {
+			return 0, 0, &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid year '%s'.", args[1]),
+				ResponseType: "ephemeral",
+			}
+		}
+		year = requestedYear
+	}
+
+	return week, year, nil
+}
+
+// weekStatusArticleStatuses is the order week-status lists article counts in
+var weekStatusArticleStatuses = []string{
+	database.ProcessingStatusSuccess,
+	database.ProcessingStatusPending,
+	database.ProcessingStatusProcessing,
+	database.ProcessingStatusRetry,
+	database.ProcessingStatusFailed,
+	database.ProcessingStatusSuperseded,
+}
+
+// handleWeekStatus shows the week dashboard with assignments and submission status,
+// for the current week or a past week given as [week] [year]
+func (ah *AdminHandler) handleWeekStatus(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Weekly automation is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Default to the current week; an explicit week (and optional year) audits a past week
+	week, year, invalid := ah.weekAndYearFromArgs(args)
+	if invalid != nil {
+		return invalid, nil
+	}
+	historical := len(args) > 0
+
+	var issue *database.WeeklyNewsletterIssue
+	var err error
+	if historical {
+		issue, err = ah.db.GetWeeklyIssueByWeek(week, year)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("📭 No newsletter issue found for week %d, %d.", week, year),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+	} else {
+		issue, err = ah.db.GetOrCreateWeeklyIssue(week, year)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to get current week issue: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+	}
+
+	// Get all assignments for the week
+	assignments, err := ah.db.GetAssignmentsByWeek(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get current week assignments: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	acceptances, err := ah.db.GetAssignmentAcceptances(issue.ID)
+	if err != nil {
+		slog.Warn("Failed to get assignment acceptances for week status", "issue_id", issue.ID, "error", err)
+		acceptances = map[int]time.Time{} // Show everyone as not yet acknowledged
+	}
+
+	// Count submissions for the current issue
+	submissionCount := 0
+	err = ah.submissionManager.IterateSubmissions(ctx, func(database.Submission) error {
+		submissionCount++
+		return nil
+	})
+	if err != nil {
+		slog.Warn("Failed to count submissions for week status", "error", err)
+		submissionCount = 0 // Continue as if there were none
+	}
+
+	// Build status message
+	var statusText strings.Builder
+	title := "Current Week Status"
+	if historical {
+		title = "Week Status"
+	}
+	statusText.WriteString(fmt.Sprintf("📊 **%s (Week %d, %d)**\n\n", title, issue.WeekNumber, issue.Year))
+
+	if len(assignments) == 0 {
+		statusText.WriteString("📝 **Assignments:** None active\n")
+	} else {
+		statusText.WriteString(fmt.Sprintf("📝 **Assignments:** %d active\n", len(assignments)))
+
+		// Group assignments by user
+		userAssignments := make(map[string][]database.PersonAssignment)
+		for _, assignment := range assignments {
+			userAssignments[assignment.PersonID] = append(userAssignments[assignment.PersonID], assignment)
+		}
+
+		// Show assignment summary, marking who has pressed "Got it" on their assignment DM
+		for userID, userAssgns := range userAssignments {
+			acknowledged := 0
+			for _, assignment := range userAssgns {
+				if _, ok := acceptances[assignment.ID]; ok {
+					acknowledged++
+				}
+			}
+			ack := "✅ acknowledged"
+			if acknowledged < len(userAssgns) {
+				ack = "⏳ not yet acknowledged"
+			}
+			statusText.WriteString(fmt.Sprintf("  • <@%s>: %d assignment(s), %s\n", userID, len(userAssgns), ack))
+		}
+		statusText.WriteString(fmt.Sprintf("👀 **Acknowledged:** %d/%d assignments\n", len(acceptances), len(assignments)))
+
+		// Flag answers that read like another category; they are left linked for an admin to judge
+		for _, assignment := range assignments {
+			if assignment.SubmissionID == nil {
+				continue
+			}
+			submission, err := ah.db.GetSubmission(*assignment.SubmissionID)
+			if err != nil {
+				slog.Warn("Failed to get linked submission for week status", "submission_id", *assignment.SubmissionID, "error", err)
+				continue
+			}
+			if suggested, mismatch := assignmentMismatch(assignment.ContentType, submission.Content); mismatch {
+				statusText.WriteString(fmt.Sprintf("⚠️ Possible mis-categorization: <@%s> answered their %s assignment with something that reads like %s (submission #%d)\n",
+					assignment.PersonID, contentTypeToSubmissionCategory(assignment.ContentType), suggested, submission.ID))
+			}
+		}
+	}
+
+	statusText.WriteString(fmt.Sprintf("\n📨 **Submissions:** %d total this week\n", submissionCount))
+
+	// Count submitted vs assigned
+	submitted, total, err := ah.db.IssueCompletion(issue.ID)
+	if err != nil {
+		slog.Warn("Failed to get completion for week status", "issue_id", issue.ID, "error", err)
+	} else if total > 0 {
+		statusText.WriteString(fmt.Sprintf("✅ **Completion:** %s (%d/%d assignments submitted)\n",
+			completionBar(submitted, total), submitted, total))
+	}
+
+	// Summarize the issue's articles by processing status
+	articleStats, err := ah.db.GetProcessedArticleStats(issue.ID)
+	if err != nil {
+		slog.Warn("Failed to get article stats for week status", "issue_id", issue.ID, "error", err)
+	} else if len(articleStats) > 0 {
+		var parts []string
+		for _, status := range weekStatusArticleStatuses {
+			if count := articleStats[status]; count > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", count, status))
+			}
+		}
+		statusText.WriteString(fmt.Sprintf("📰 **Articles:** %s\n", strings.Join(parts, ", ")))
+	}
+
+	statusText.WriteString(fmt.Sprintf("\n🗓️ **Issue ID:** %d", issue.ID))
+
+	return withBlocks(&SlashCommandResponse{
+		Text:         statusText.String(),
+		ResponseType: "ephemeral",
+	}), nil
+}
+
+// completionBarWidth is the number of cells in the week-status progress bar
+const completionBarWidth = 10
+
+// completionBar renders submitted/total as a progress bar with a percentage, e.g. "▓▓▓▓▓▓░░░░ 60%"
+func completionBar(submitted, total int) string {
+	if total <= 0 {
+		return strings.Repeat("░", completionBarWidth) + " 0%"
+	}
+	if submitted > total {
+		submitted = total
+	}
+
+	filled := (submitted*completionBarWidth + total/2) / total
+	percent := (submitted*100 + total/2) / total
+	return fmt.Sprintf("%s%s %d%%", strings.Repeat("▓", filled), strings.Repeat("░", completionBarWidth-filled), percent)
+}
+
+// handlePoolStatus shows anonymous body/mind question pool levels and activity metrics,
+// or the level of a single category given as [category]
+func (ah *AdminHandler) handlePoolStatus(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.poolManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Body/mind pool management is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// A category narrows the status down to that part of the pool
+	if len(args) > 0 {
+		category := args[0]
+		if !containsString(database.BodyMindCategories(), category) {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Unknown body/mind category '%s'.\nUsage: admin pool-status [category]\nCategories: %s", category, strings.Join(database.BodyMindCategories(), ", ")),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+
+		activeCount, oldestAge, err := ah.poolManager.GetPoolStatusForCategory(category)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to get pool status for %s: %v", category, err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+
+		return withBlocks(&SlashCommandResponse{
+			Text:         ah.poolManager.FormatCategoryStatusForSlack(category, activeCount, oldestAge),
+			ResponseType: "ephemeral",
+		}), nil
+	}
+
+	status, err := ah.poolManager.GetPoolStatus()
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get pool status: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	slackMessage := ah.poolManager.FormatPoolStatusForSlack(status)
+
+	return withBlocks(&SlashCommandResponse{
+		Text:         slackMessage,
+		ResponseType: "ephemeral",
+	}), nil
+}
+
+// promoteAnonymousPageSize is how many anonymous submissions promote-anonymous offers at a time
+const promoteAnonymousPageSize = 10
+
+// handlePromoteAnonymous offers recent anonymous submissions for the body/mind question pool.
+// Without --confirm it only lists them; --confirm adds exactly the submissions whose IDs follow it,
+// so anything that arrived after the preview is never promoted unseen. Anonymous submissions carry
+// no pool category, so the category only picks where the promoted questions go.
+func (ah *AdminHandler) handlePromoteAnonymous(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil || ah.poolManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Body/mind pool management is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	usage := "Usage: admin promote-anonymous category [page] | admin promote-anonymous category --confirm id1,id2,...\n" +
+		"The category is the pool the questions are added to; all anonymous submissions are offered.\n" +
+		"Categories: " + strings.Join(database.BodyMindCategories(), ", ")
+	if len(args) == 0 {
+		return &SlashCommandResponse{
+			Text:         usage,
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	category := args[0]
+	if !containsString(database.BodyMindCategories(), category) {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Unknown body/mind category '%s'.\n%s", category, usage),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(args) > 1 && args[1] == "--confirm" {
+		ids, err := parseSubmissionIDList(strings.Join(args[2:], ","))
+		if err != nil || len(ids) == 0 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ --confirm needs the IDs of the submissions to add, as shown in the preview.\n%s", usage),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		return ah.promoteAnonymousSubmissions(category, ids), nil
+	}
+
+	page := 1
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 || len(args) > 2 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid page '%s'.\n%s", strings.Join(args[1:], " "), usage),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		page = n
+	}
+
+	submissions, err := ah.db.GetPromotableAnonymousSubmissions(promoteAnonymousPageSize, (page-1)*promoteAnonymousPageSize)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get anonymous submissions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(submissions) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📭 No anonymous submissions left to promote.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("🔍 *%d anonymous submissions could be added to the %s pool (page %d)*\n", len(submissions), category, page))
+	ids := make([]string, len(submissions))
+	for i, submission := range submissions {
+		response.WriteString(fmt.Sprintf("• #%d (%s): %s\n", submission.ID, submission.CreatedAt.In(ah.db.Location()).Format("2006-01-02"), submission.Content))
+		ids[i] = strconv.Itoa(submission.ID)
+	}
+	response.WriteString(fmt.Sprintf("\nRun `admin promote-anonymous %s --confirm %s` to add them, leaving out any you don't want.", category, strings.Join(ids, ",")))
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// promoteAnonymousSubmissions adds the given anonymous submissions to the category's pool.
+// IDs that are not promotable anonymous submissions are reported rather than added.
+func (ah *AdminHandler) promoteAnonymousSubmissions(category string, ids []int) *SlashCommandResponse {
+	promoted := 0
+	var failures []string
+	for _, id := range ids {
+		submission, err := ah.db.GetPromotableAnonymousSubmission(id)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("#%d: %v", id, err))
+			continue
+		}
+		if _, err := ah.poolManager.AddQuestionToPool(submission.Content, category); err != nil {
+			failures = append(failures, fmt.Sprintf("#%d: %v", id, err))
+			continue
+		}
+		// The question is already in the pool, so a marking failure only risks offering it again
+		if err := ah.db.MarkSubmissionPromoted(id); err != nil {
+			slog.Warn("Failed to mark anonymous submission promoted", "submission_id", id, "error", err)
+		}
+		promoted++
+	}
+	slog.Info("Promoted anonymous submissions to body/mind pool", "category", category, "promoted", promoted, "failed", len(failures))
+
+	text := fmt.Sprintf("✅ Added %d anonymous submissions to the %s pool.", promoted, category)
+	if len(failures) > 0 {
+		text += fmt.Sprintf("\n❌ Failed: %s", strings.Join(failures, "; "))
+	}
+	return &SlashCommandResponse{
+		Text:         text,
+		ResponseType: "ephemeral",
+	}
+}
+
+// parseSubmissionIDList parses a comma-separated list of submission IDs such as "12,15,19"
+func parseSubmissionIDList(list string) ([]int, error) {
+	var ids []int
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(field), "#"))
+		if field == "" {
+			continue
+		}
+		id, err := strconv.Atoi(field)
+		if err != nil || id < 1 {
+			return nil, fmt.Errorf("invalid submission ID '%s'", field)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// handleCostReport summarizes AI token usage for a week's newsletter issue (defaults to the current week)
+func (ah *AdminHandler) handleCostReport(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	year, week := time.Now().In(ah.db.Location()).ISOWeek()
+	if len(args) > 0 {
+		requestedWeek, err := strconv.Atoi(args[0])
+		if err != nil || requestedWeek < 1 || requestedWeek > 53 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid week '%s'. Use a week number between 1 and 53.", args[0]),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		week = requestedWeek
+	}
+
+	issue, err := ah.db.GetWeeklyIssueByWeek(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No newsletter issue found for week %d, %d.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	usage, err := ah.db.GetTokenUsageByIssue(issue.ID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get token usage: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var report strings.Builder
+	report.WriteString(fmt.Sprintf("💰 *AI Cost Report (Week %d, %d)*\n\n", week, year))
+	report.WriteString(fmt.Sprintf("*Articles processed:* %d\n", usage.ArticleCount))
+	report.WriteString(fmt.Sprintf("*Prompt tokens:* %d\n", usage.PromptTokens))
+	report.WriteString(fmt.Sprintf("*Completion tokens:* %d\n", usage.CompletionTokens))
+	report.WriteString(fmt.Sprintf("*Total tokens:* %d\n", usage.TotalTokens()))
+	report.WriteString(fmt.Sprintf("*Estimated cost:* $%.4f", ai.EstimateCostUSD(usage.PromptTokens, usage.CompletionTokens)))
+
+	return &SlashCommandResponse{
+		Text:         report.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleListIssues lists newsletter issues in a status, defaulting to the published archive
+func (ah *AdminHandler) handleListIssues(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	status := database.IssueStatusPublished
+	if len(args) > 0 {
+		status = database.NewsletterIssueStatus(args[0])
+		if !database.ValidIssueStatuses[status] {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid status '%s'. Use draft, assigning, in_progress, ready, or published.", args[0]),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+	}
+
+	issues, err := ah.db.GetWeeklyIssuesByStatus(status)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get issues: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(issues) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No %s issues found.", status),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("🗂️ *Newsletter Issues (%s)* - %d found\n\n", status, len(issues)))
+	for _, issue := range issues {
+		assignmentCount := 0
+		if assignments, err := ah.db.GetPersonAssignmentsByIssue(issue.ID); err == nil {
+			assignmentCount = len(assignments)
+		} else {
+			slog.Warn("list-issues: failed to count assignments", "issue_id", issue.ID, "error", err)
+		}
+		response.WriteString(fmt.Sprintf("• Week %d, %d - %s (%d assignments)\n",
+			issue.WeekNumber, issue.Year, issue.Title, assignmentCount))
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleSetTheme sets or clears the theme of a week's issue, creating the issue if needed so
+// themes can be planned ahead. The theme is the last argument, after the optional week and year.
+func (ah *AdminHandler) handleSetTheme(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin set-theme [week] [year] \"Theme\" (use \"\" to clear it)",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	week, year, invalid := ah.weekAndYearFromArgs(args[:len(args)-1])
+	if invalid != nil {
+		return invalid, nil
+	}
+	theme := strings.TrimSpace(args[len(args)-1])
+
+	issue, err := ah.db.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get issue for week %d, %d: %v", week, year, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if err := ah.db.SetIssueTheme(issue.ID, theme); err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to save theme: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if theme == "" {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("✅ Cleared the theme for week %d, %d", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ Week %d, %d has the theme *%s*. Run `admin generate-intro %d %d` to work it into the intro.", week, year, theme, week, year),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handlePublishWeek publishes a week's issue, making it readable without the admin token
+// and locking it against changes that are not forced
+func (ah *AdminHandler) handlePublishWeek(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	week, year, invalid := ah.weekAndYearFromArgs(args)
+	if invalid != nil {
+		return invalid, nil
+	}
+
+	issue, err := ah.db.GetWeeklyIssueByWeek(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No newsletter issue found for week %d, %d.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	published, err := ah.db.PublishIssue(issue.ID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to publish week %d, %d: %v", week, year, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if !published {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📰 Week %d, %d is already published.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	slog.Info("Published newsletter issue", "issue_id", issue.ID, "week", week, "year", year)
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ Published week %d, %d. It is now readable at /newsletter/%d/%d and locked against changes without --force.", week, year, week, year),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleGenerateIntro asks the AI for a short Swedish intro summarizing an issue's headlines and stores it on the issue
+func (ah *AdminHandler) handleGenerateIntro(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	generator, ok := ah.aiProcessor.(ai.IntroGenerator)
+	if !ok {
+		return &SlashCommandResponse{
+			Text:         "❌ AI processor not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	week, year, invalid := ah.weekAndYearFromArgs(args)
+	if invalid != nil {
+		return invalid, nil
+	}
+
+	issue, err := ah.db.GetWeeklyIssueByWeek(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No newsletter issue found for week %d, %d.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	headlines, err := ah.db.GetIssueHeadlines(issue.ID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get articles: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	if len(headlines) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 Week %d, %d has no articles yet - nothing to introduce.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	introCtx, cancel := context.WithTimeout(ctx, ah.requestTimeout())
+	defer cancel()
+
+	metrics.AIJobsInFlight.Inc()
+	intro, err := generator.GenerateIssueIntro(introCtx, headlines, issue.Theme)
+	metrics.AIJobsInFlight.Dec()
+	metrics.RecordAIResult(err)
+	if err != nil {
+		slog.Error("Issue intro generation failed", "issue_id", issue.ID, "error", err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to generate intro: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if err := ah.db.UpdateWeeklyIssueContent(issue.ID, intro); err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to save intro: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ *In this issue* intro saved for week %d, %d (%d articles):\n\n> %s", week, year, len(headlines), intro),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// planWeekRotationWeeks is how far back plan-week looks in the rotation history
+const planWeekRotationWeeks = 8
+
+// planWeekContentTypes is the order plan-week fills slots in, so scarce slots get the longest-waiting people
+var planWeekContentTypes = []database.ContentType{
+	database.ContentTypeFeature,
+	database.ContentTypeInterview,
+	database.ContentTypeGeneral,
+	database.ContentTypeBodyMind,
+}
+
+// handlePlanWeek proposes who the rotation would assign to each open slot this week,
+// without creating any assignments
+func (ah *AdminHandler) handlePlanWeek(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Weekly automation is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(args) == 0 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin plan-week [@user1 @user2 ... | @usergroup]\nExample: admin plan-week @newsletter-crew",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	slots := ah.db.AssignmentSlots()
+	if len(slots) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📭 No assignment slots are configured (ASSIGNMENT_SLOTS), so there is nothing to plan.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	candidates, resolveErrors := ah.resolveCandidates(ctx, args)
+
+	// Slots already taken this week shrink the plan, and those people aren't planned again
+	year, week := time.Now().In(ah.db.Location()).ISOWeek()
+	plan, err := ah.planWeekSlots(candidates, week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get this week's assignments: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("🗓️ *Proposed assignments for week %d, %d* (nothing has been assigned)\n", week, year))
+
+	for _, slot := range plan {
+		if len(slot.people) == 0 && slot.unfilled == 0 {
+			response.WriteString(fmt.Sprintf("• %s: all %d slot(s) already assigned\n", slot.contentType, slot.limit))
+			continue
+		}
+		for _, personID := range slot.people {
+			response.WriteString(fmt.Sprintf("• %s: <@%s>\n", slot.contentType, personID))
+		}
+		if slot.unfilled > 0 {
+			response.WriteString(fmt.Sprintf("• %s: ⚠️ no candidate left for %d slot(s)\n", slot.contentType, slot.unfilled))
+		}
+	}
+
+	if len(resolveErrors) > 0 {
+		response.WriteString(fmt.Sprintf("\n❌ Could not resolve: %s\n", strings.Join(resolveErrors, "; ")))
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// resolveCandidates resolves users and usergroups to user IDs in the order given, skipping
+// duplicates. Arguments that can't be resolved are returned as readable errors.
+func (ah *AdminHandler) resolveCandidates(ctx context.Context, args []string) ([]string, []string) {
+	targets, _ := ah.expandAssignmentTargets(ctx, args)
+	var candidates []string
+	var resolveErrors []string
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		userID, err := ah.resolveUserIdentifier(ctx, target.userArg)
+		if err != nil {
+			resolveErrors = append(resolveErrors, fmt.Sprintf("%s: %v", target.userArg, err))
+			continue
+		}
+		if !seen[userID] {
+			seen[userID] = true
+			candidates = append(candidates, userID)
+		}
+	}
+	return candidates, resolveErrors
+}
+
+// slotPlan is who the rotation picks for the open slots of one content type
+type slotPlan struct {
+	contentType database.ContentType
+	limit       int
+	people      []string // Picked for the open slots, longest-waiting first
+	unfilled    int      // Open slots no candidate was left for
+}
+
+// planWeekSlots picks candidates for the slots still open in the given week. People who already
+// have an assignment that week aren't picked again, and nobody is picked for two slots.
+func (ah *AdminHandler) planWeekSlots(candidates []string, week, year int) ([]slotPlan, error) {
+	existing, err := ah.db.GetAssignmentsByWeek(week, year)
+	if err != nil {
+		return nil, err
+	}
+	planned := make(map[string]bool)
+	filled := make(map[database.ContentType]int)
+	for _, assignment := range existing {
+		planned[assignment.PersonID] = true
+		filled[assignment.ContentType]++
+	}
+
+	slots := ah.db.AssignmentSlots()
+	var plan []slotPlan
+	for _, contentType := range planWeekContentTypes {
+		limit, limited := slots[contentType]
+		if !limited {
+			continue
+		}
+
+		slot := slotPlan{contentType: contentType, limit: limit}
+		open := limit - filled[contentType]
+		for i := 0; i < open; i++ {
+			personID, err := ah.db.SelectNextPersonForRotation(candidates, contentType, planWeekRotationWeeks, planned)
+			if err != nil {
+				slot.unfilled = open - i
+				break
+			}
+			planned[personID] = true
+			slot.people = append(slot.people, personID)
+		}
+		plan = append(plan, slot)
+	}
+	return plan, nil
+}
+
+// defaultStaleAssignmentWeeks is how old an unsubmitted assignment must be before cleanup-stale removes it
+const defaultStaleAssignmentWeeks = 4
+
+// handleCleanupStale deletes unsubmitted assignments from weeks ago, or lists them with --dry-run
+func (ah *AdminHandler) handleCleanupStale(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	weeks := defaultStaleAssignmentWeeks
+	dryRun := false
+	for _, arg := range args {
+		if arg == "--dry-run" {
+			dryRun = true
+			continue
+		}
+		n, err := strconv.Atoi(arg)
+		if err != nil || n <= 0 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid number of weeks '%s'. Usage: admin cleanup-stale [weeks] [--dry-run]", arg),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		weeks = n
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -7*weeks)
+	stale, err := ah.db.GetStaleAssignments(cutoff)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get stale assignments: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(stale) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No unsubmitted assignments older than %d weeks.", weeks),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	if dryRun {
+		response.WriteString(fmt.Sprintf("🔍 *Dry run: %d stale assignments older than %d weeks would be deleted*\n", len(stale), weeks))
+		for _, assignment := range stale {
+			response.WriteString(fmt.Sprintf("• #%d %s (%s), assigned %s\n",
+				assignment.ID, assignment.PersonID, assignment.ContentType, assignment.AssignedAt.In(ah.db.Location()).Format("2006-01-02")))
+		}
+		return &SlashCommandResponse{
+			Text:         response.String(),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	deleted, err := ah.db.DeleteStaleAssignments(cutoff)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to delete stale assignments: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	slog.Info("Deleted stale assignments", "count", deleted, "older_than_weeks", weeks)
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("🧹 Deleted %d unsubmitted assignments older than %d weeks.", deleted, weeks),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// defaultArchiveQuestionWeeks is how long ago a body/mind question must have been used before archive-questions archives it
+const defaultArchiveQuestionWeeks = 12
+
+// handleArchiveQuestions archives body/mind questions used more than [weeks] ago
+func (ah *AdminHandler) handleArchiveQuestions(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	weeks := defaultArchiveQuestionWeeks
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n <= 0 {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Invalid number of weeks '%s'. Usage: admin archive-questions [weeks]", args[0]),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		weeks = n
+	}
+
+	archived, err := ah.db.ArchiveUsedQuestionsOlderThan(time.Duration(weeks) * 7 * 24 * time.Hour)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to archive questions: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if archived == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No body/mind questions used more than %d weeks ago.", weeks),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	slog.Info("Archived used body/mind questions", "count", archived, "older_than_weeks", weeks)
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("🗄️ Archived %d body/mind questions used more than %d weeks ago.", archived, weeks),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleChase reminds everyone with an unsubmitted assignment for the current week
+func (ah *AdminHandler) handleChase(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil || ah.broadcastManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Weekly automation is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	year, week := time.Now().In(ah.db.Location()).ISOWeek()
+	issue, err := ah.db.GetWeeklyIssueByWeek(week, year)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No newsletter issue found for week %d, %d.", week, year),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	assignments, err := ah.db.GetUnsubmittedAssignments(issue.ID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get unsubmitted assignments: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(assignments) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("🎉 Everyone assigned for week %d has submitted - nobody to chase!", week),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var chased []string
+	var errors []string
+
+	for _, assignment := range assignments {
+		// Body/mind assignments come from the anonymous pool and have no question to repeat
+		questionText := ""
+		if assignment.QuestionID != nil && ah.questionSelector != nil {
+			if question, err := ah.questionSelector.GetQuestionByID(ctx, *assignment.QuestionID); err == nil {
+				questionText = question.Text
+			} else {
+				slog.Warn("chase: failed to load question", "question_id", *assignment.QuestionID, "error", err)
+			}
+		}
+
+		message := ah.createChaseMessage(questionText, string(assignment.ContentType), week, year)
+		if err := ah.sendDirectMessage(ctx, assignment.PersonID, message); err != nil {
+			errors = append(errors, fmt.Sprintf("User %s: %v", assignment.PersonID, err))
+			continue
+		}
+		chased = append(chased, fmt.Sprintf("%s (%s)", assignment.PersonID, assignment.ContentType))
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("⏰ *Chased %d of %d unsubmitted assignments (Week %d, %d)*\n", len(chased), len(assignments), week, year))
+	for _, entry := range chased {
+		response.WriteString(fmt.Sprintf("• %s\n", entry))
+	}
+	if len(errors) > 0 {
+		response.WriteString("\n❌ Errors:\n")
+		for _, errMsg := range errors {
+			response.WriteString(fmt.Sprintf("• %s\n", errMsg))
+		}
+	}
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleBroadcastBodyMind sends anonymous wellness question request to all users
+func (ah *AdminHandler) handleBroadcastBodyMind(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.broadcastManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Broadcast messaging is not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(args) > 0 && args[0] == "--dry-run" {
+		plan, err := ah.broadcastManager.PlanBodyMindBroadcast(ctx)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to plan broadcast: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+
+		return &SlashCommandResponse{
+			Text: fmt.Sprintf("🧪 *Body/Mind Question Broadcast - Dry Run*\n\nWould send to %d users. Nothing was sent.\n\n*Message preview:*\n%s",
+				len(plan.Recipients), plan.Message),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Send the broadcast
+	result, err := ah.broadcastManager.BroadcastBodyMindRequest(ctx)
+	if err != nil {
+		// Even if some sends failed, we still want to report what happened
+		if result != nil {
+			return &SlashCommandResponse{
+				Text: fmt.Sprintf("⚠️ *Body/Mind Question Broadcast - Partial Success*\n\n%s\n\nError: %v",
+					result.GetDetailedReport(), err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to send broadcast: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Success - return summary
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ *Body/Mind Question Broadcast Complete*\n\n%s", result.GetSummary()),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleListPublishedArticles lists all published articles with IDs for management
+func (ah *AdminHandler) handleListPublishedArticles(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Get all successful processed articles
+	articles, err := ah.db.GetProcessedArticlesByStatus("success")
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to retrieve articles: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(articles) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📰 No published articles found.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Format the articles for display
+	var responseText strings.Builder
+	responseText.WriteString(fmt.Sprintf("📰 *Published Articles* (%d total):\n\n", len(articles)))
+
+	for _, article := range articles {
+		// Extract headline from JSON content
+		headline := "Unknown Title"
+		if content, err := article.ParseJSONContent(); err == nil {
+			if h, ok := content["headline"].(string); ok {
+				headline = h
+			}
+		}
+
+		// Format publish date
+		publishDate := "Unknown"
+		if article.ProcessedAt != nil {
+			publishDate = article.ProcessedAt.Format("Jan 2, 2006")
+		}
+
+		// Show article info
+		responseText.WriteString(fmt.Sprintf("• **ID %d**: %s\n", article.ID, headline))
+		responseText.WriteString(fmt.Sprintf("  └─ %s journalist • %d words • %s\n\n",
+			article.JournalistType, article.WordCount, publishDate))
+	}
+
+	responseText.WriteString("💡 *Use `admin delete-article [ID]` to remove articles*")
+
+	return &SlashCommandResponse{
+		Text:         responseText.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleExportCSV sends the requesting admin a CSV overview of a newsletter issue
+func (ah *AdminHandler) handleExportCSV(ctx context.Context, userID string, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin export-csv [issue_id]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.broadcastManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ File uploads are not available.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	issueID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid issue ID '%s'. Must be a number.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	csvData, err := ah.db.ExportIssueCSV(issueID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to export issue %d: %v", issueID, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	filename := fmt.Sprintf("newsletter-issue-%d.csv", issueID)
+	if err := ah.broadcastManager.uploadFileToUser(ctx, userID, filename, fmt.Sprintf("Newsletter issue %d", issueID), csvData); err != nil {
+		slog.Error("Failed to upload issue CSV", "issue_id", issueID, "user", userID, "error", err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to upload CSV: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ Sent `%s` to you as a direct message.", filename),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleDeleteArticle permanently removes a published article from the database
+func (ah *AdminHandler) handleDeleteArticle(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	force, args := forceFlag(args)
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin delete-article [article_id] [--force]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Parse article ID
+	articleID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid article ID '%s'. Must be a number.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Get article details before deletion for confirmation
+	article, err := ah.db.GetProcessedArticle(articleID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Article ID %d not found: %v", articleID, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if article.NewsletterIssueID != nil && !force {
+		if issue, err := ah.db.GetWeeklyNewsletterIssue(*article.NewsletterIssueID); err == nil && issue.Status == database.IssueStatusPublished {
+			return publishedIssueResponse(issue, fmt.Sprintf("deleting article %d", articleID)), nil
+		}
+	}
+
+	// Extract headline for confirmation
+	headline := "Unknown Title"
+	if content, err := article.ParseJSONContent(); err == nil {
+		if h, ok := content["headline"].(string); ok {
+			headline = h
+		}
+	}
+
+	// Delete the article
+	err = ah.db.DeleteProcessedArticle(articleID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to delete article: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("✅ *Article Deleted Successfully*\n\n"+
+			"**ID**: %d\n"+
+			"**Title**: %s\n"+
+			"**Type**: %s journalist\n"+
+			"**Words**: %d\n\n"+
+			"The article has been permanently removed from the newsletter database.",
+			articleID, headline, article.JournalistType, article.WordCount),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleArticleHistory lists every processed version of a submission, oldest first, with the words
+// each version changed from the one before
+func (ah *AdminHandler) handleArticleHistory(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin article-history [submission_id]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submissionID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	versions, err := ah.db.GetArticleVersions(submissionID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get article history: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(versions) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 No processed articles found for submission %d.", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	const timeLayout = "2006-01-02 15:04"
+	loc := ah.db.Location()
+
+	var history strings.Builder
+	history.WriteString(fmt.Sprintf("📜 *Article History for Submission %d* (%d versions)\n\n", submissionID, len(versions)))
+	for i, version := range versions {
+		history.WriteString(fmt.Sprintf("• v%d (article %d) - %s - %s, %d words",
+			i+1, version.ID, version.CreatedAt.In(loc).Format(timeLayout), version.JournalistType, version.WordCount))
+		if i > 0 {
+			history.WriteString(fmt.Sprintf(" (%+d)", version.WordCount-versions[i-1].WordCount))
+		}
+
+		if version.IsSuperseded() {
+			history.WriteString(" - superseded")
+			if version.SupersededAt != nil {
+				history.WriteString(" " + version.SupersededAt.In(loc).Format(timeLayout))
+			}
+		} else {
+			history.WriteString(fmt.Sprintf(" - *%s*", version.ProcessingStatus))
+		}
+		history.WriteString("\n")
+		if i > 0 {
+			history.WriteString(formatArticleDiff(&versions[i-1], &versions[i]))
+		}
+	}
+
+	return &SlashCommandResponse{
+		Text:         history.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// maxRevisionPreviewLength truncates each version of a submission shown in submission-history
+const maxRevisionPreviewLength = 200
+
+// handleSubmissionHistory lists the earlier contents of an edited submission, followed by its current content
+func (ah *AdminHandler) handleSubmissionHistory(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin submission-history [submission_id]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submissionID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submission, err := ah.db.GetSubmission(submissionID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Submission %d not found", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	revisions, err := ah.db.GetSubmissionRevisions(submissionID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get submission history: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(revisions) == 0 {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 Submission %d has not been edited.", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	const timeLayout = "2006-01-02 15:04"
+	loc := ah.db.Location()
+
+	var history strings.Builder
+	history.WriteString(fmt.Sprintf("📝 *Edit History for Submission %d* (%d edits)\n\n", submissionID, len(revisions)))
+	for i, revision := range revisions {
+		history.WriteString(fmt.Sprintf("• v%d - replaced %s\n    %s\n",
+			i+1, revision.RevisedAt.In(loc).Format(timeLayout), truncateRunes(revision.Content, maxRevisionPreviewLength)))
+	}
+	history.WriteString(fmt.Sprintf("• *current*\n    %s\n", truncateRunes(submission.Content, maxRevisionPreviewLength)))
+
+	return &SlashCommandResponse{
+		Text:         history.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleJournalists lists every journalist type with the profile fields editors pick journalists by
+func (ah *AdminHandler) handleJournalists(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	types := ai.GetAvailableJournalistTypes()
+	sort.Strings(types)
+
+	var list strings.Builder
+	list.WriteString(fmt.Sprintf("🖋️ *Journalists* (%d)\n\n", len(types)))
+	for _, journalistType := range types {
+		profile, err := ai.GetJournalistProfile(journalistType)
+		if err != nil {
+			continue
+		}
+		list.WriteString(fmt.Sprintf("• *%s* - %s - up to %d words, %s format\n",
+			journalistType, profile.Name, profile.MaxWords, profile.TemplateFormat))
+	}
+	list.WriteString("\nUse a type with `admin reprocess` or `admin preview`.")
+
+	return &SlashCommandResponse{
+		Text:         list.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleSetJournalistPrompt stores a runtime system prompt override for a built-in journalist type
+func (ah *AdminHandler) handleSetJournalistPrompt(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 2 || args[1] == "" {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin set-journalist-prompt [type] \"System prompt\"",
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	// Otherwise, try to look up the user by name
-	if ah.broadcastManager == nil {
-		return "", fmt.Errorf("cannot lookup user: broadcast manager not available")
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	userID, err := ah.broadcastManager.lookupUserByName(ctx, cleanInput)
+	journalistType := args[0]
+	if !ai.ValidateJournalistType(journalistType) {
+		types := ai.GetAvailableJournalistTypes()
+		sort.Strings(types)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Unknown journalist type '%s'. Valid types: %s", journalistType, strings.Join(types, ", ")),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if err := ah.db.SetJournalistPromptOverride(journalistType, args[1]); err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to save journalist prompt: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ Updated the %s journalist's system prompt. New submissions will use it right away:\n> %s", journalistType, args[1]),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleResetJournalistPrompt removes a runtime system prompt override so the built-in prompt is used again
+func (ah *AdminHandler) handleResetJournalistPrompt(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin reset-journalist-prompt [type]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	journalistType := args[0]
+	if !ai.ValidateJournalistType(journalistType) {
+		types := ai.GetAvailableJournalistTypes()
+		sort.Strings(types)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Unknown journalist type '%s'. Valid types: %s", journalistType, strings.Join(types, ", ")),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	removed, err := ah.db.DeleteJournalistPromptOverride(journalistType)
 	if err != nil {
-		return "", fmt.Errorf("failed to find user '%s': %w", cleanInput, err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to reset journalist prompt: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	if !removed {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 The %s journalist is already using its built-in system prompt", journalistType),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         fmt.Sprintf("✅ The %s journalist is back on its built-in system prompt", journalistType),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleRerunSubmission re-processes a submission with AI journalist
+func (ah *AdminHandler) handleRerunSubmission(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	force, args := forceFlag(args)
+	if len(args) < 1 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin rerun-submission [submission_id] [--force]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Default to the configured journalist for news submissions; use reprocess to pick another one
+	return ah.reprocessSubmission(ctx, args[0], ah.journalistForUnlinked(), force)
+}
+
+// handleReprocess re-processes a submission with an explicitly chosen journalist type,
+// for submissions that landed in the wrong category
+func (ah *AdminHandler) handleReprocess(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	force, args := forceFlag(args)
+	if len(args) < 2 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin reprocess [submission_id] [journalist_type] [--force]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	journalistType := args[1]
+	if !ai.ValidateJournalistType(journalistType) {
+		types := ai.GetAvailableJournalistTypes()
+		sort.Strings(types)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Unknown journalist type '%s'. Valid types: %s", journalistType, strings.Join(types, ", ")),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return ah.reprocessSubmission(ctx, args[0], journalistType, force)
+}
+
+// deadLetterErrorLength is how much of a dead letter's last error dead-letters shows
+const deadLetterErrorLength = 120
+
+// handleDeadLetters lists submissions whose AI processing failed for good, or with
+// reprocess submission_id re-runs one with the journalist its assignment calls for
+func (ah *AdminHandler) handleDeadLetters(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	force, args := forceFlag(args)
+	if len(args) > 0 {
+		if args[0] != "reprocess" || len(args) < 2 {
+			return &SlashCommandResponse{
+				Text:         "Usage: admin dead-letters [reprocess submission_id [--force]]",
+				ResponseType: "ephemeral",
+			}, nil
+		}
+
+		// Unlinked submissions are news, written up by the configured journalist, except anonymous
+		// ones, which are body/mind pieces
+		journalistType := ah.journalistForUnlinked()
+		if submissionID, err := strconv.Atoi(args[1]); err == nil {
+			if assignment, err := ah.db.GetAssignmentBySubmissionID(submissionID); err == nil && assignment != nil {
+				journalistType = contentTypeToJournalistType(assignment.ContentType)
+			} else if submission, err := ah.db.GetSubmission(submissionID); err == nil && submission.UserID == "" {
+				journalistType = "body_mind"
+			}
+		}
+		return ah.reprocessSubmission(ctx, args[1], journalistType, force)
+	}
+
+	deadLetters, err := ah.db.GetDeadLetters()
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get dead letters: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if len(deadLetters) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📭 No submissions have failed processing for good.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("🪦 *Dead Letters* (%d)\n\n", len(deadLetters)))
+	for _, dl := range deadLetters {
+		lastError := dl.LastError
+		if runes := []rune(lastError); len(runes) > deadLetterErrorLength {
+			lastError = string(runes[:deadLetterErrorLength]) + "..."
+		}
+		response.WriteString(fmt.Sprintf("• Submission #%d - %d attempts, last failed %s\n  > %s\n",
+			dl.SubmissionID, dl.Attempts, dl.FailedAt.In(ah.db.Location()).Format("Jan 2, 2006 15:04"), lastError))
+	}
+	response.WriteString("\nRe-run one with `admin dead-letters reprocess submission_id`.")
+
+	return &SlashCommandResponse{
+		Text:         response.String(),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// reprocessSubmission re-runs AI on a submission in the background and supersedes earlier article versions.
+// A submission in a published issue is only reprocessed when force is set.
+func (ah *AdminHandler) reprocessSubmission(ctx context.Context, submissionArg, journalistType string, force bool) (*SlashCommandResponse, error) {
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.aiProcessor == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ AI processor not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Parse submission ID
+	submissionID, err := strconv.Atoi(submissionArg)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.", submissionArg),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Get original submission
+	submission, err := ah.db.GetSubmission(submissionID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Submission ID %d not found: %v", submissionID, err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if !force {
+		published, err := ah.db.GetPublishedIssueForSubmission(submissionID)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to check for published issues: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		if published != nil {
+			return publishedIssueResponse(published, fmt.Sprintf("reprocessing submission %d", submissionID)), nil
+		}
+	}
+
+	// Get user info for processing (use fallback values for admin rerun)
+	authorName := "Team Member"
+	authorDepartment := "Unknown"
+
+	// Note: For admin rerun, we use fallback user info.
+	// Could be enhanced later to lookup user details if needed.
+
+	// Get current newsletter issue for assignment
+	var newsletterIssueID *int
+	now := time.Now()
+	year, week := now.ISOWeek()
+
+	issue, err := ah.db.GetOrCreateWeeklyIssue(week, year)
+	if err == nil {
+		newsletterIssueID = &issue.ID
+	}
+
+	// Launch async reprocessing, keeping the command's values but not its cancellation
+	jobCtx := context.WithoutCancel(ctx)
+	ah.jobs.Add(1)
+	go func() {
+		defer ah.jobs.Done()
+		ah.runReprocess(jobCtx, *submission, authorName, authorDepartment, journalistType, newsletterIssueID)
+	}()
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🤖 *Reprocessing Submission*\n\n"+
+			"**Submission ID**: %d\n"+
+			"**Author**: %s (%s)\n"+
+			"**Content**: %.100s...\n"+
+			"**Journalist**: %s\n\n"+
+			"✅ Reprocessing started in the background. The new article will appear in the current week's newsletter when complete.",
+			submissionID, authorName, authorDepartment, submission.Content, journalistType),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleRefine has the journalist revise a submission's live article following an editor's feedback,
+// in the background. The revision becomes the live version and the earlier ones are kept as history.
+func (ah *AdminHandler) handleRefine(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	force, args := forceFlag(args)
+	if len(args) < 2 || strings.TrimSpace(args[1]) == "" {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin refine [submission_id] \"feedback\" [--force]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if ah.db == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Database not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	refiner, ok := ah.aiProcessor.(ai.ArticleRefiner)
+	if !ok {
+		return &SlashCommandResponse{
+			Text:         "❌ AI processor not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submissionID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.", args[0]),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	feedback := strings.TrimSpace(args[1])
+
+	versions, err := ah.db.GetArticleVersions(submissionID)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to get articles: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	var previous *database.ProcessedArticle
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].ProcessingStatus == database.ProcessingStatusSuccess {
+			previous = &versions[i]
+			break
+		}
+	}
+	if previous == nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 Submission %d has no article to refine. Use `admin rerun-submission %d` to write one first.", submissionID, submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if !force {
+		published, err := ah.db.GetPublishedIssueForSubmission(submissionID)
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to check for published issues: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		if published != nil {
+			return publishedIssueResponse(published, fmt.Sprintf("refining submission %d", submissionID)), nil
+		}
+	}
+
+	jobCtx := context.WithoutCancel(ctx)
+	ah.jobs.Add(1)
+	go func() {
+		defer ah.jobs.Done()
+		ah.runRefine(jobCtx, refiner, previous, feedback)
+	}()
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🤖 Refining the %s article for submission %d:\n> %s\n\nThe revision replaces the current version when it's done. Compare them with `admin article-history %d`.",
+			previous.JournalistType, submissionID, feedback, submissionID),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// runRefine revises an article with AI, saves the revision and supersedes earlier versions.
+// A failed revision leaves the current version live.
+func (ah *AdminHandler) runRefine(ctx context.Context, refiner ai.ArticleRefiner, previous *database.ProcessedArticle, feedback string) {
+	metrics.AIJobsInFlight.Inc()
+	defer metrics.AIJobsInFlight.Dec()
+
+	article, err := refiner.RefineArticle(ctx, previous, feedback)
+	metrics.RecordAIResult(err)
+	if err != nil {
+		slog.Error("Article refinement failed", "submission_id", previous.SubmissionID, "article_id", previous.ID, "error", err)
+		return
+	}
+
+	articleID, err := ah.db.CreateProcessedArticle(*article)
+	if err != nil {
+		slog.Error("Failed to save refined article", "submission_id", previous.SubmissionID, "error", err)
+		return
+	}
+
+	if _, err := ah.db.SupersedeEarlierArticleVersions(previous.SubmissionID); err != nil {
+		slog.Warn("Failed to supersede earlier article versions", "submission_id", previous.SubmissionID, "error", err)
 	}
 
-	return userID, nil
+	slog.Info("Article refined", "submission_id", previous.SubmissionID, "previous_article_id", previous.ID, "article_id", articleID)
 }
 
-// handleWeekStatus shows current week dashboard with assignments and submission status
-func (ah *AdminHandler) handleWeekStatus(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.db == nil {
+// backlogGracePeriod is how old a submission without an article has to be before process-backlog
+// picks it up, so one still being processed in the background isn't run twice
+const backlogGracePeriod = 10 * time.Minute
+
+// handleProcessBacklog runs AI on every submission that never got an article, such as ones whose
+// processing died with the server, one at a time in the background. Submissions in a published
+// issue are skipped unless --force is given.
+func (ah *AdminHandler) handleProcessBacklog(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	force, _ := forceFlag(args)
+
+	if ah.db == nil || ah.submissionManager == nil {
 		return &SlashCommandResponse{
-			Text:         "❌ Weekly automation is not available.",
+			Text:         "❌ Database not available",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get current week's issue
-	now := time.Now()
-	currentYear, currentWeek := now.ISOWeek()
-	issue, err := ah.db.GetOrCreateWeeklyIssue(currentWeek, currentYear)
-	if err != nil {
+	if ah.aiProcessor == nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to get current week issue: %v", err),
+			Text:         "❌ AI processor not available",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get all assignments for the current issue
-	assignments, err := ah.db.GetPersonAssignmentsByIssue(issue.ID)
+	submissions, err := ah.submissionManager.GetSubmissionsWithoutProcessedArticle(ctx)
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to get current week assignments: %v", err),
+			Text:         fmt.Sprintf("❌ Failed to get submissions without an article: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get all submissions for the current issue
-	submissions, err := ah.submissionManager.GetAllSubmissions(ctx)
-	if err != nil {
-		slog.Warn("Failed to get submissions for week status", "error", err)
-		submissions = []database.Submission{} // Continue with empty list
+	cutoff := time.Now().Add(-backlogGracePeriod)
+	var backlog []database.Submission
+	recent, locked := 0, 0
+	for _, submission := range submissions {
+		if submission.CreatedAt.After(cutoff) {
+			recent++
+			continue
+		}
+		if !force {
+			published, err := ah.db.GetPublishedIssueForSubmission(submission.ID)
+			if err != nil {
+				return &SlashCommandResponse{
+					Text:         fmt.Sprintf("❌ Failed to check for published issues: %v", err),
+					ResponseType: "ephemeral",
+				}, nil
+			}
+			if published != nil {
+				locked++
+				continue
+			}
+		}
+		backlog = append(backlog, submission)
 	}
 
-	// Build status message
-	var statusText strings.Builder
-	statusText.WriteString(fmt.Sprintf("📊 **Current Week Status (Week %d, %d)**\n\n", issue.WeekNumber, issue.Year))
+	var notes strings.Builder
+	if recent > 0 {
+		notes.WriteString(fmt.Sprintf("\n⏳ %d submission(s) from the last %d minutes were left alone, they may still be processing.", recent, int(backlogGracePeriod.Minutes())))
+	}
+	if locked > 0 {
+		notes.WriteString(fmt.Sprintf("\n🔒 %d submission(s) in published issues were skipped. Add --force to process them anyway.", locked))
+	}
 
-	if len(assignments) == 0 {
-		statusText.WriteString("📝 **Assignments:** None active\n")
-	} else {
-		statusText.WriteString(fmt.Sprintf("📝 **Assignments:** %d active\n", len(assignments)))
+	if len(backlog) == 0 {
+		return &SlashCommandResponse{
+			Text:         "📭 No submissions are waiting for an article." + notes.String(),
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-		// Group assignments by user
-		userAssignments := make(map[string][]database.PersonAssignment)
-		for _, assignment := range assignments {
-			userAssignments[assignment.PersonID] = append(userAssignments[assignment.PersonID], assignment)
-		}
+	ids := make([]string, len(backlog))
+	for i, submission := range backlog {
+		ids[i] = fmt.Sprintf("#%d", submission.ID)
+	}
 
-		// Show assignment summary
-		for userID, userAssgns := range userAssignments {
-			statusText.WriteString(fmt.Sprintf("  • <@%s>: %d assignment(s)\n", userID, len(userAssgns)))
+	// One at a time, so a large backlog doesn't fire every AI request at once
+	jobCtx := context.WithoutCancel(ctx)
+	ah.jobs.Add(1)
+	go func() {
+		defer ah.jobs.Done()
+		processed := 0
+		for _, submission := range backlog {
+			authorName, authorDepartment, journalistType, newsletterIssueID := ah.backlogProcessing(submission)
+			if ah.runReprocess(jobCtx, submission, authorName, authorDepartment, journalistType, newsletterIssueID) {
+				processed++
+			}
 		}
+		slog.Info("Processed submission backlog", "processed", processed, "failed", len(backlog)-processed)
+	}()
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🤖 Processing %d submission(s) without an article in the background: %s\nFailures end up in `admin dead-letters`.%s",
+			len(backlog), strings.Join(ids, ", "), notes.String()),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// backlogProcessing picks how process-backlog writes up a submission the way it would have been
+// on arrival: with its assignment's journalist and issue, as an anonymous wellness piece, or as news
+func (ah *AdminHandler) backlogProcessing(submission database.Submission) (authorName, authorDepartment, journalistType string, newsletterIssueID *int) {
+	if assignment, err := ah.db.GetAssignmentBySubmissionID(submission.ID); err == nil && assignment != nil {
+		issueID := assignment.IssueID
+		return "Team Member", "Unknown", contentTypeToJournalistType(assignment.ContentType), &issueID
 	}
 
-	statusText.WriteString(fmt.Sprintf("\n📨 **Submissions:** %d total this week\n", len(submissions)))
+	// An unassigned submission belongs to the issue of the week it was sent in
+	year, week := submission.CreatedAt.In(ah.db.Location()).ISOWeek()
+	if issue, err := ah.db.GetOrCreateWeeklyIssue(week, year); err == nil {
+		newsletterIssueID = &issue.ID
+	}
+	if submission.UserID == "" {
+		return "Community Member", "Wellness", "body_mind", newsletterIssueID
+	}
+	return "Team Member", "Unknown", ah.journalistForUnlinked(), newsletterIssueID
+}
 
-	// Count submitted vs assigned
-	submittedCount := 0
-	for _, assignment := range assignments {
-		if assignment.SubmissionID != nil {
-			submittedCount++
-		}
+// runReprocess runs AI on a submission and saves the article, superseding earlier versions.
+// A failure is dead-lettered; it reports whether the article was saved.
+func (ah *AdminHandler) runReprocess(ctx context.Context, submission database.Submission, authorName, authorDepartment, journalistType string, newsletterIssueID *int) bool {
+	metrics.AIJobsInFlight.Inc()
+	defer metrics.AIJobsInFlight.Dec()
+
+	submissionID := submission.ID
+	dbPtr := ah.db.GetUnderlyingDB()
+	if dbPtr == nil {
+		slog.Error("Admin rerun: underlying DB not available", "submission_id", submissionID)
+		return false
 	}
 
-	if len(assignments) > 0 {
-		statusText.WriteString(fmt.Sprintf("✅ **Completion:** %d/%d assignments submitted (%.1f%%)\n",
-			submittedCount, len(assignments), float64(submittedCount)/float64(len(assignments))*100))
+	// The job outlives the command, so bound the AI call like the command's own AI calls
+	aiCtx, cancel := context.WithTimeout(ctx, ah.requestTimeout())
+	defer cancel()
+
+	err := ah.aiProcessor.ProcessAndSaveSubmission(
+		aiCtx,
+		dbPtr,
+		submission,
+		authorName,
+		authorDepartment,
+		journalistType,
+		newsletterIssueID,
+	)
+	metrics.RecordAIResult(err)
+
+	if err != nil {
+		slog.Error("Admin rerun failed", "submission_id", submissionID, "error", err)
+		recordDeadLetter(ctx, dbPtr, submissionID, err.Error(), ai.AttemptsOf(err))
+		return false
 	}
 
-	statusText.WriteString(fmt.Sprintf("\n🗓️ **Issue ID:** %d", issue.ID))
+	if err := dbPtr.DeleteDeadLetter(submissionID); err != nil {
+		slog.Warn("Failed to clear dead letter", "submission_id", submissionID, "error", err)
+	}
 
-	return &SlashCommandResponse{
-		Text:         statusText.String(),
-		ResponseType: "ephemeral",
-	}, nil
+	// An admin rerun is the review of a flagged submission, so it is no longer held back
+	if err := dbPtr.ClearSubmissionReview(submissionID); err != nil {
+		slog.Warn("Failed to clear review flag", "submission_id", submissionID, "error", err)
+	}
+
+	// Keep earlier versions for article-history, but only the new one is live
+	if superseded, err := dbPtr.SupersedeEarlierArticleVersions(submissionID); err != nil {
+		slog.Warn("Failed to supersede earlier article versions", "submission_id", submissionID, "error", err)
+	} else if superseded > 0 {
+		slog.Info("Superseded earlier article versions", "submission_id", submissionID, "count", superseded)
+	}
+
+	slog.Info("Admin rerun completed successfully", "submission_id", submissionID, "journalist_type", journalistType)
+	return true
 }
 
-// handlePoolStatus shows anonymous body/mind question pool levels and activity metrics
-func (ah *AdminHandler) handlePoolStatus(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.poolManager == nil {
+// handlePreview runs a journalist on sample text and shows the result without storing anything
+func (ah *AdminHandler) handlePreview(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 2 || strings.TrimSpace(args[1]) == "" {
 		return &SlashCommandResponse{
-			Text:         "❌ Body/mind pool management is not available.",
+			Text:         "Usage: admin preview [journalist_type] \"Sample text\"",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	status, err := ah.poolManager.GetPoolStatus()
-	if err != nil {
+	journalistType := args[0]
+	if !ai.ValidateJournalistType(journalistType) {
+		types := ai.GetAvailableJournalistTypes()
+		sort.Strings(types)
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to get pool status: %v", err),
+			Text:         fmt.Sprintf("❌ Unknown journalist type '%s'. Valid types: %s", journalistType, strings.Join(types, ", ")),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	slackMessage := ah.poolManager.FormatPoolStatusForSlack(status)
+	if ah.aiProcessor == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ AI processor not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-	return &SlashCommandResponse{
-		Text:         slackMessage,
-		ResponseType: "ephemeral",
-	}, nil
-}
+	previewCtx, cancel := context.WithTimeout(ctx, ah.requestTimeout())
+	defer cancel()
 
-// handleBroadcastBodyMind sends anonymous wellness question request to all users
-func (ah *AdminHandler) handleBroadcastBodyMind(ctx context.Context, args []string) (*SlashCommandResponse, error) {
-	if ah.broadcastManager == nil {
+	// The sample never touches the database, so it has no submission ID
+	sample := database.Submission{Content: args[1]}
+	metrics.AIJobsInFlight.Inc()
+	article, err := ah.aiProcessor.ProcessSubmissionWithUserInfo(previewCtx, sample, "Preview Author", "Editorial", journalistType)
+	metrics.AIJobsInFlight.Dec()
+	metrics.RecordAIResult(err)
+	if err != nil {
+		slog.Error("Admin preview failed", "journalist_type", journalistType, "error", err)
 		return &SlashCommandResponse{
-			Text:         "❌ Broadcast messaging is not available.",
+			Text:         fmt.Sprintf("❌ Preview failed: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Send the broadcast
-	result, err := ah.broadcastManager.BroadcastBodyMindRequest(ctx)
+	fields, err := article.ParseJSONContent()
 	if err != nil {
-		// Even if some sends failed, we still want to report what happened
-		if result != nil {
-			return &SlashCommandResponse{
-				Text: fmt.Sprintf("⚠️ *Body/Mind Question Broadcast - Partial Success*\n\n%s\n\nError: %v",
-					result.GetDetailedReport(), err),
-				ResponseType: "ephemeral",
-			}, nil
-		}
-
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to send broadcast: %v", err),
+			Text:         fmt.Sprintf("❌ Journalist returned unreadable content: %v\n\n```%s```", err, article.ProcessedContent),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Success - return summary
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var response strings.Builder
+	response.WriteString(fmt.Sprintf("👀 *Preview: %s journalist* (%d words, not saved)\n\n", journalistType, article.WordCount))
+	for _, key := range keys {
+		value, ok := fields[key].(string)
+		if !ok {
+			encoded, err := json.Marshal(fields[key])
+			if err != nil {
+				continue
+			}
+			value = string(encoded)
+		}
+		response.WriteString(fmt.Sprintf("**%s**: %s\n", key, value))
+	}
+
 	return &SlashCommandResponse{
-		Text:         fmt.Sprintf("✅ *Body/Mind Question Broadcast Complete*\n\n%s", result.GetSummary()),
+		Text:         response.String(),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// handleListPublishedArticles lists all published articles with IDs for management
-func (ah *AdminHandler) handleListPublishedArticles(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+// handleGrantRole gives a user the super admin or editor role, stored so it survives restarts and config reloads
+func (ah *AdminHandler) handleGrantRole(ctx context.Context, grantedBy string, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 2 {
+		return &SlashCommandResponse{
+			Text:         "Usage: admin grant-role [@user|user_id] [super|editor]",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
 	if ah.db == nil {
 		return &SlashCommandResponse{
 			Text:         "❌ Database not available",
@@ -943,60 +4210,49 @@ func (ah *AdminHandler) handleListPublishedArticles(ctx context.Context, args []
 		}, nil
 	}
 
-	// Get all successful processed articles
-	articles, err := ah.db.GetProcessedArticlesByStatus("success")
-	if err != nil {
+	role := AdminRole(strings.ToLower(args[1]))
+	if role != AdminRoleSuper && role != AdminRoleEditor {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to retrieve articles: %v", err),
+			Text:         fmt.Sprintf("❌ Unknown role '%s'. Valid roles: super, editor", args[1]),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	if len(articles) == 0 {
+	userID, err := ah.resolveUserIdentifier(ctx, args[0])
+	if err != nil {
 		return &SlashCommandResponse{
-			Text:         "📰 No published articles found.",
+			Text:         fmt.Sprintf("❌ %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Format the articles for display
-	var responseText strings.Builder
-	responseText.WriteString(fmt.Sprintf("📰 *Published Articles* (%d total):\n\n", len(articles)))
-
-	for _, article := range articles {
-		// Extract headline from JSON content
-		headline := "Unknown Title"
-		if content, err := article.ParseJSONContent(); err == nil {
-			if h, ok := content["headline"].(string); ok {
-				headline = h
-			}
-		}
-
-		// Format publish date
-		publishDate := "Unknown"
-		if article.ProcessedAt != nil {
-			publishDate = article.ProcessedAt.Format("Jan 2, 2006")
-		}
+	if ah.configuredRoleFor(userID) == AdminRoleSuper {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 <@%s> is already a super admin through the configuration", userID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-		// Show article info
-		responseText.WriteString(fmt.Sprintf("• **ID %d**: %s\n", article.ID, headline))
-		responseText.WriteString(fmt.Sprintf("  └─ %s journalist • %d words • %s\n\n",
-			article.JournalistType, article.WordCount, publishDate))
+	if err := ah.db.SetAdminRole(userID, string(role), grantedBy); err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to grant role: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
 	}
 
-	responseText.WriteString("💡 *Use `admin delete-article [ID]` to remove articles*")
+	slog.Info("Admin role granted", "user", userID, "role", role, "granted_by", grantedBy)
 
 	return &SlashCommandResponse{
-		Text:         responseText.String(),
+		Text:         fmt.Sprintf("✅ <@%s> is now %s", userID, roleDescription(role)),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// handleDeleteArticle permanently removes a published article from the database
-func (ah *AdminHandler) handleDeleteArticle(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+// handleRevokeRole removes a role given with grant-role. Configured roles can only be changed in the configuration.
+func (ah *AdminHandler) handleRevokeRole(ctx context.Context, args []string) (*SlashCommandResponse, error) {
 	if len(args) < 1 {
 		return &SlashCommandResponse{
-			Text:         "Usage: admin delete-article [article_id]",
+			Text:         "Usage: admin revoke-role [@user|user_id]",
 			ResponseType: "ephemeral",
 		}, nil
 	}
@@ -1008,152 +4264,156 @@ func (ah *AdminHandler) handleDeleteArticle(ctx context.Context, args []string)
 		}, nil
 	}
 
-	// Parse article ID
-	articleID, err := strconv.Atoi(args[0])
+	userID, err := ah.resolveUserIdentifier(ctx, args[0])
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Invalid article ID '%s'. Must be a number.", args[0]),
+			Text:         fmt.Sprintf("❌ %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get article details before deletion for confirmation
-	article, err := ah.db.GetProcessedArticle(articleID)
+	removed, err := ah.db.DeleteAdminRole(userID)
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Article ID %d not found: %v", articleID, err),
+			Text:         fmt.Sprintf("❌ Failed to revoke role: %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Extract headline for confirmation
-	headline := "Unknown Title"
-	if content, err := article.ParseJSONContent(); err == nil {
-		if h, ok := content["headline"].(string); ok {
-			headline = h
+	configured := ah.configuredRoleFor(userID)
+	switch {
+	case configured != AdminRoleNone:
+		text := fmt.Sprintf("📭 <@%s> is %s through the configuration, which only a config change can revoke", userID, roleDescription(configured))
+		if removed {
+			text = "✅ Removed the granted role. " + text
 		}
-	}
-
-	// Delete the article
-	err = ah.db.DeleteProcessedArticle(articleID)
-	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Failed to delete article: %v", err),
+			Text:         text,
+			ResponseType: "ephemeral",
+		}, nil
+	case !removed:
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 <@%s> has no granted role", userID),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
+	slog.Info("Admin role revoked", "user", userID)
+
 	return &SlashCommandResponse{
-		Text: fmt.Sprintf("✅ *Article Deleted Successfully*\n\n"+
-			"**ID**: %d\n"+
-			"**Title**: %s\n"+
-			"**Type**: %s journalist\n"+
-			"**Words**: %d\n\n"+
-			"The article has been permanently removed from the newsletter database.",
-			articleID, headline, article.JournalistType, article.WordCount),
+		Text:         fmt.Sprintf("✅ <@%s> no longer has admin access", userID),
 		ResponseType: "ephemeral",
 	}, nil
 }
 
-// handleRerunSubmission re-processes a submission with AI journalist
-func (ah *AdminHandler) handleRerunSubmission(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+// handleAddAdmin makes a user a super admin; it is grant-role with the role filled in
+func (ah *AdminHandler) handleAddAdmin(ctx context.Context, grantedBy string, args []string) (*SlashCommandResponse, error) {
 	if len(args) < 1 {
 		return &SlashCommandResponse{
-			Text:         "Usage: admin rerun-submission [submission_id]",
+			Text:         "Usage: admin add-admin [@user|user_id]",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	if ah.db == nil {
+	return ah.handleGrantRole(ctx, grantedBy, []string{args[0], string(AdminRoleSuper)})
+}
+
+// handleRemoveAdmin takes back a granted super admin role. A granted editor role is left alone,
+// so remove-admin never takes away more than add-admin gave.
+func (ah *AdminHandler) handleRemoveAdmin(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	if len(args) < 1 {
 		return &SlashCommandResponse{
-			Text:         "❌ Database not available",
+			Text:         "Usage: admin remove-admin [@user|user_id]",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	if ah.aiProcessor == nil {
+	if ah.db == nil {
 		return &SlashCommandResponse{
-			Text:         "❌ AI processor not available",
+			Text:         "❌ Database not available",
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Parse submission ID
-	submissionID, err := strconv.Atoi(args[0])
+	userID, err := ah.resolveUserIdentifier(ctx, args[0])
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.", args[0]),
+			Text:         fmt.Sprintf("❌ %v", err),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get original submission
-	submission, err := ah.db.GetSubmission(submissionID)
+	role, found, err := ah.db.GetAdminRole(userID)
 	if err != nil {
 		return &SlashCommandResponse{
-			Text:         fmt.Sprintf("❌ Submission ID %d not found: %v", submissionID, err),
+			Text:         fmt.Sprintf("❌ Failed to get role: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	if found && AdminRole(role) == AdminRoleEditor {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 <@%s> is not an admin but was granted the editor role; use revoke-role to take that back", userID),
 			ResponseType: "ephemeral",
 		}, nil
 	}
 
-	// Get user info for processing (use fallback values for admin rerun)
-	authorName := "Team Member"
-	authorDepartment := "Unknown"
+	return ah.handleRevokeRole(ctx, []string{userID})
+}
 
-	// Note: For admin rerun, we use fallback user info.
-	// Could be enhanced later to lookup user details if needed.
+// handleListRoles shows the configured and granted super admins and editors
+func (ah *AdminHandler) handleListRoles(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	ah.settingsMu.RLock()
+	configuredAdmins := append([]string(nil), ah.authorizedUsers...)
+	configuredEditors := append([]string(nil), ah.editorUsers...)
+	ah.settingsMu.RUnlock()
 
-	// Determine journalist type (default to general for news submissions)
-	journalistType := "general"
-	if submission.QuestionID != nil {
-		// Could enhance this to determine type from question category
-		// For now, default to general
-		journalistType = "general"
+	var grants []database.AdminRoleGrant
+	if ah.db != nil {
+		var err error
+		grants, err = ah.db.GetAdminRoles()
+		if err != nil {
+			return &SlashCommandResponse{
+				Text:         fmt.Sprintf("❌ Failed to get roles: %v", err),
+				ResponseType: "ephemeral",
+			}, nil
+		}
 	}
 
-	// Get current newsletter issue for assignment
-	var newsletterIssueID *int
-	now := time.Now()
-	year, week := now.ISOWeek()
-
-	issue, err := ah.db.GetOrCreateWeeklyIssue(week, year)
-	if err == nil {
-		newsletterIssueID = &issue.ID
-	}
+	var text strings.Builder
+	text.WriteString("🔐 *Admin roles*\n")
 
-	// Launch async reprocessing
-	go func() {
-		dbPtr := ah.db.GetUnderlyingDB()
-		if dbPtr == nil {
-			slog.Error("Admin rerun: underlying DB not available", "submission_id", submissionID)
+	writeUsers := func(title string, userIDs []string) {
+		text.WriteString(fmt.Sprintf("\n*%s:*\n", title))
+		if len(userIDs) == 0 {
+			text.WriteString("     _none_\n")
 			return
 		}
-
-		err := ah.aiProcessor.ProcessAndSaveSubmission(
-			context.Background(),
-			dbPtr,
-			*submission,
-			authorName,
-			authorDepartment,
-			journalistType,
-			newsletterIssueID,
-		)
-
-		if err != nil {
-			slog.Error("Admin rerun failed", "submission_id", submissionID, "error", err)
-		} else {
-			slog.Info("Admin rerun completed successfully", "submission_id", submissionID, "journalist_type", journalistType)
+		for _, userID := range userIDs {
+			text.WriteString(fmt.Sprintf("     • <@%s>\n", userID))
 		}
-	}()
+	}
+	writeUsers("Configured super admins", configuredAdmins)
+	writeUsers("Configured editors", configuredEditors)
+
+	text.WriteString("\n*Granted with grant-role:*\n")
+	if len(grants) == 0 {
+		text.WriteString("     _none_\n")
+	}
+	for _, grant := range grants {
+		text.WriteString(fmt.Sprintf("     • <@%s> - %s (by <@%s> on %s)\n",
+			grant.UserID, grant.Role, grant.GrantedBy, grant.GrantedAt.Format("2006-01-02")))
+	}
 
 	return &SlashCommandResponse{
-		Text: fmt.Sprintf("🤖 *Reprocessing Submission*\n\n"+
-			"**Submission ID**: %d\n"+
-			"**Author**: %s (%s)\n"+
-			"**Content**: %.100s...\n"+
-			"**Journalist**: %s\n\n"+
-			"✅ Reprocessing started in the background. The new article will appear in the current week's newsletter when complete.",
-			submissionID, authorName, authorDepartment, submission.Content, journalistType),
+		Text:         text.String(),
 		ResponseType: "ephemeral",
 	}, nil
 }
+
+// roleDescription returns a role as it reads in a sentence, e.g. "a super admin"
+func roleDescription(role AdminRole) string {
+	if role == AdminRoleEditor {
+		return "an editor"
+	}
+	return "a super admin"
+}
@@ -5,8 +5,10 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
 )
 
 // TestResolveUserIdentifierLogic tests the core logic for user ID resolution
@@ -161,3 +163,171 @@ func TestAssignQuestionWithUsernameLookup(t *testing.T) {
 		t.Errorf("Expected user lookup error for 'olle', got: %s", response2.Text)
 	}
 }
+
+// mockUsergroupResolver resolves usergroup handles from a fixed map, using the handle as group ID
+type mockUsergroupResolver struct {
+	groups    map[string][]string
+	listCalls int
+}
+
+func (m *mockUsergroupResolver) ListUsergroups(ctx context.Context) ([]slack.UserGroup, error) {
+	m.listCalls++
+	var groups []slack.UserGroup
+	for handle := range m.groups {
+		groups = append(groups, slack.UserGroup{ID: handle, Handle: handle})
+	}
+	return groups, nil
+}
+
+func (m *mockUsergroupResolver) GetUsergroupMembers(ctx context.Context, groupID string) ([]string, error) {
+	return m.groups[groupID], nil
+}
+
+// TDD: assign-question expands a usergroup and skips members already assigned this week
+func TestAssignQuestionToUsergroup(t *testing.T) {
+	ctx := context.Background()
+	db := createTestDB(t)
+	defer db.Close()
+
+	mockQuestionSel := &mockQuestionSelector{}
+	handler := NewAdminHandlerWithWeeklyAutomation(
+		mockQuestionSel,
+		[]string{"U123ADMIN"},
+		&mockSubmissionManager{},
+		db,
+		"fake-token",
+	)
+	handler.broadcastManager = nil // Don't try to DM anyone
+	resolver := &mockUsergroupResolver{
+		groups: map[string][]string{
+			"editors": {"U100EDITOR", "U200EDITOR", "U300EDITOR"},
+			"writers": {"U500WRITER"},
+		},
+	}
+	handler.SetUsergroupResolver(resolver)
+
+	// U200EDITOR already has an assignment this week
+	year, week := time.Now().ISOWeek()
+	issue, err := db.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to get current week issue: %v", err)
+	}
+	if _, err := db.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U200EDITOR",
+		ContentType: database.ContentTypeGeneral,
+	}); err != nil {
+		t.Fatalf("Failed to create existing assignment: %v", err)
+	}
+
+	response, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{
+		Action: "assign-question",
+		Args:   []string{"feature", "@editors"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to handle assign-question command: %v", err)
+	}
+
+	for _, userID := range []string{"U100EDITOR", "U300EDITOR"} {
+		if !strings.Contains(response.Text, "feature content → "+userID) {
+			t.Errorf("Expected %s to be assigned, got: %s", userID, response.Text)
+		}
+	}
+	if strings.Contains(response.Text, "feature content → U200EDITOR") {
+		t.Errorf("Expected U200EDITOR to be skipped, got: %s", response.Text)
+	}
+	if !strings.Contains(response.Text, "2 created, 1 skipped") {
+		t.Errorf("Expected created/skipped summary, got: %s", response.Text)
+	}
+
+	assignments, err := db.GetPersonAssignmentsByIssue(issue.ID)
+	if err != nil {
+		t.Fatalf("Failed to get assignments: %v", err)
+	}
+	if len(assignments) != 3 {
+		t.Errorf("Expected 3 assignments (1 existing + 2 new), got %d", len(assignments))
+	}
+
+	// Unknown handles are still treated as usernames
+	response, err = handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{
+		Action: "assign-question",
+		Args:   []string{"feature", "@U400SOLO"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to handle assign-question command: %v", err)
+	}
+	if !strings.Contains(response.Text, "feature content → U400SOLO") || strings.Contains(response.Text, "Usergroups") {
+		t.Errorf("Expected plain user assignment without usergroup summary, got: %s", response.Text)
+	}
+
+	// Several groups in one command list the workspace's usergroups once; user IDs never list them
+	resolver.listCalls = 0
+	if _, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{
+		Action: "assign-question",
+		Args:   []string{"general", "@editors", "@writers", "U600SOLO"},
+	}); err != nil {
+		t.Fatalf("Failed to handle assign-question command: %v", err)
+	}
+	if resolver.listCalls != 1 {
+		t.Errorf("Expected usergroups to be listed once, got %d", resolver.listCalls)
+	}
+
+	resolver.listCalls = 0
+	if _, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{
+		Action: "assign-question",
+		Args:   []string{"general", "U700SOLO", "U800SOLO"},
+	}); err != nil {
+		t.Fatalf("Failed to handle assign-question command: %v", err)
+	}
+	if resolver.listCalls != 0 {
+		t.Errorf("Expected no usergroup lookup for plain user IDs, got %d", resolver.listCalls)
+	}
+}
+
+// TDD: Resolving the same username twice only asks Slack once
+func TestResolveUserIdentifierCachesLookups(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mockSlackClient{
+		users: []slack.User{{ID: "U111111111", Name: "olle"}},
+	}
+	handler := &AdminHandler{
+		broadcastManager: &BroadcastManager{client: mockClient},
+	}
+
+	for _, name := range []string{"@olle", "olle", "OLLE"} {
+		userID, err := handler.resolveUserIdentifier(ctx, name)
+		if err != nil {
+			t.Fatalf("resolveUserIdentifier(%q) failed: %v", name, err)
+		}
+		if userID != "U111111111" {
+			t.Errorf("Expected U111111111 for %q, got %s", name, userID)
+		}
+	}
+	if mockClient.getUsersCalls != 1 {
+		t.Errorf("Expected 1 Slack user lookup, got %d", mockClient.getUsersCalls)
+	}
+
+	// Failed lookups are not cached
+	for i := 0; i < 2; i++ {
+		if _, err := handler.resolveUserIdentifier(ctx, "nobody"); err == nil {
+			t.Error("Expected an error for an unknown user")
+		}
+	}
+	if mockClient.getUsersCalls != 3 {
+		t.Errorf("Expected unknown users to be looked up every time, got %d lookups", mockClient.getUsersCalls)
+	}
+}
+
+// TDD: Cached usernames expire after the TTL
+func TestUserIDCacheExpiry(t *testing.T) {
+	cache := userIDCache{ttl: time.Minute}
+	now := time.Now()
+
+	cache.put("olle", "U111111111", now)
+	if userID, ok := cache.get("Olle", now.Add(30*time.Second)); !ok || userID != "U111111111" {
+		t.Errorf("Expected cached ID within the TTL, got %q, %v", userID, ok)
+	}
+	if _, ok := cache.get("olle", now.Add(2*time.Minute)); ok {
+		t.Error("Expected the entry to expire after the TTL")
+	}
+}
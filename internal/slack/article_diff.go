@@ -0,0 +1,155 @@
+package slack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+const (
+	// maxDiffCells bounds the word-diff table, so very long articles are not compared word by word
+	maxDiffCells = 400000
+	// maxDiffHunks is how many changes article-history shows per version
+	maxDiffHunks = 5
+	// maxDiffHunkLength truncates each side of a change shown in article-history
+	maxDiffHunkLength = 80
+)
+
+// wordChange is one run of words replaced between two article versions; either side may be empty
+type wordChange struct {
+	removed string
+	added   string
+}
+
+// wordDiff compares two texts word by word and returns the runs of words that changed, in order.
+// ok is false when the texts are too long to compare.
+func wordDiff(before, after string) (changes []wordChange, ok bool) {
+	a, b := strings.Fields(before), strings.Fields(after)
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		return nil, false
+	}
+
+	// lcs[i][j] is the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var removed, added []string
+	flush := func() {
+		if len(removed) > 0 || len(added) > 0 {
+			changes = append(changes, wordChange{removed: strings.Join(removed, " "), added: strings.Join(added, " ")})
+			removed, added = nil, nil
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	flush()
+
+	return changes, true
+}
+
+// articleText returns the words of an article for comparing versions: the headline followed by
+// every other text field in key order, or the raw content when it isn't JSON
+func articleText(article *database.ProcessedArticle) string {
+	content, err := article.ParseJSONContent()
+	if err != nil {
+		return article.ProcessedContent
+	}
+
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		if key != "headline" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var text []string
+	var collect func(value interface{})
+	collect = func(value interface{}) {
+		switch v := value.(type) {
+		case string:
+			text = append(text, v)
+		case []interface{}:
+			for _, item := range v {
+				collect(item)
+			}
+		case map[string]interface{}:
+			nested := make([]string, 0, len(v))
+			for key := range v {
+				nested = append(nested, key)
+			}
+			sort.Strings(nested)
+			for _, key := range nested {
+				collect(v[key])
+			}
+		}
+	}
+
+	collect(content["headline"])
+	for _, key := range keys {
+		collect(content[key])
+	}
+	return strings.Join(text, " ")
+}
+
+// formatArticleDiff describes what changed between two article versions, one indented line per change
+func formatArticleDiff(previous, current *database.ProcessedArticle) string {
+	changes, ok := wordDiff(articleText(previous), articleText(current))
+	if !ok {
+		return "    _too long to compare word by word_\n"
+	}
+	if len(changes) == 0 {
+		return "    _no text changes_\n"
+	}
+
+	var diff strings.Builder
+	for i, change := range changes {
+		if i == maxDiffHunks {
+			diff.WriteString(fmt.Sprintf("    _…and %d more changes_\n", len(changes)-maxDiffHunks))
+			break
+		}
+		if change.removed != "" {
+			diff.WriteString(fmt.Sprintf("    − ~%s~\n", truncateRunes(change.removed, maxDiffHunkLength)))
+		}
+		if change.added != "" {
+			diff.WriteString(fmt.Sprintf("    + %s\n", truncateRunes(change.added, maxDiffHunkLength)))
+		}
+	}
+	return diff.String()
+}
+
+// truncateRunes shortens text to at most limit characters, marking the cut with "..."
+func truncateRunes(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit]) + "..."
+}
@@ -41,6 +41,8 @@ func TestSlackBot_AutoProcessSubmission(t *testing.T) {
 		mockAIService,
 		db, // Use real database for AI processing
 	)
+	processed := make(chan int, 1)
+	bot.(*slackBot).onProcessed = func(submissionID int) { processed <- submissionID }
 
 	// Simulate news submission command
 	command := SlashCommand{
@@ -54,15 +56,8 @@ func TestSlackBot_AutoProcessSubmission(t *testing.T) {
 		t.Fatalf("HandleSlashCommand failed: %v", err)
 	}
 
-	// Give async processing more time to complete and retry if needed
-	var processCallCount int
-	for i := 0; i < 10; i++ { // Try up to 10 times with 200ms intervals
-		time.Sleep(200 * time.Millisecond)
-		processCallCount = len(mockAIService.ProcessAndSaveCalls)
-		if processCallCount > 0 {
-			break
-		}
-	}
+	waitForProcessed(t, processed, 1)
+	processCallCount := len(mockAIService.ProcessAndSaveCalls)
 
 	// Verify submission was stored
 	if len(mockSubmissionManager.CreatedSubmissions) != 1 {
@@ -86,6 +81,55 @@ func TestSlackBot_AutoProcessSubmission(t *testing.T) {
 	}
 }
 
+// waitForProcessed waits until async processing reports it finished with submissionID
+func waitForProcessed(t *testing.T, processed <-chan int, submissionID int) {
+	t.Helper()
+	select {
+	case id := <-processed:
+		if id != submissionID {
+			t.Fatalf("Expected processing of submission %d to finish, got %d", submissionID, id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for submission %d to be processed", submissionID)
+	}
+}
+
+// TDD: Test the processed hook fires exactly once per submission, whether the AI succeeds or fails
+func TestSlackBot_OnProcessedFiresOncePerSubmission(t *testing.T) {
+	db, err := database.NewSimple(fmt.Sprintf("%s/test.db", t.TempDir()))
+	if err != nil {
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	mockSubmissionManager := &MockSubmissionManager{}
+	mockAIService := &MockAIService{}
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, []string{"U1234567"}, mockSubmissionManager, mockAIService, db).(*slackBot)
+	processed := make(chan int, 4)
+	bot.onProcessed = func(submissionID int) { processed <- submissionID }
+
+	command := SlashCommand{Command: "/pp", Text: "submit We moved into the new office!", UserID: "U987654321"}
+	if _, err := bot.HandleSlashCommand(context.Background(), command); err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+	waitForProcessed(t, processed, 1)
+
+	mockAIService.Error = fmt.Errorf("AI service unavailable")
+	if _, err := bot.HandleSlashCommand(context.Background(), command); err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+	waitForProcessed(t, processed, 2)
+
+	bot.jobs.Wait()
+	if len(processed) != 0 {
+		t.Errorf("Expected the hook to fire once per submission, got %d extra call(s)", len(processed))
+	}
+}
+
 // TDD: Test automatic processing with user information enrichment
 func TestSlackBot_AutoProcessWithUserInfo(t *testing.T) {
 	// This test should FAIL initially as user info enrichment doesn't exist in auto-processing
@@ -271,10 +315,44 @@ func (m *MockSubmissionManager) GetSubmissionsByUser(ctx context.Context, userID
 	return nil, nil // Not needed for these tests
 }
 
-func (m *MockSubmissionManager) GetAllSubmissions(ctx context.Context) ([]database.Submission, error) {
+func (m *MockSubmissionManager) IterateSubmissions(ctx context.Context, fn func(database.Submission) error) error {
+	return nil // Not needed for these tests
+}
+
+func (m *MockSubmissionManager) IterateSubmissionsByDateRange(ctx context.Context, start, end time.Time, fn func(database.Submission) error) error {
+	return nil // Not needed for these tests
+}
+
+func (m *MockSubmissionManager) UpdateSubmissionContent(ctx context.Context, id int, userID, content string) error {
+	if m.Error != nil {
+		return m.Error
+	}
+	for i, submission := range m.CreatedSubmissions {
+		if submission.ID == id && submission.UserID == userID {
+			m.CreatedSubmissions[i].Content = content
+			return nil
+		}
+	}
+	return fmt.Errorf("submission not found or not owned by user")
+}
+
+func (m *MockSubmissionManager) GetSubmissionsWithoutProcessedArticle(ctx context.Context) ([]database.Submission, error) {
 	return nil, nil // Not needed for these tests
 }
 
+func (m *MockSubmissionManager) GetUserSubmissionCounts(ctx context.Context, since time.Time) (map[string]int, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+	counts := make(map[string]int)
+	for _, submission := range m.CreatedSubmissions {
+		if submission.UserID != "" && !submission.CreatedAt.Before(since) {
+			counts[submission.UserID]++
+		}
+	}
+	return counts, nil
+}
+
 func (m *MockSubmissionManager) DeleteSubmission(ctx context.Context, id int) error {
 	if m.Error != nil {
 		return m.Error
@@ -294,6 +372,8 @@ type MockAIService struct {
 	ProcessedWithUserInfo []ProcessWithUserInfoCall
 	ProcessAndSaveCalls   []ProcessAndSaveCall
 	Error                 error
+	PromptTokens          int // Token usage reported on returned articles
+	CompletionTokens      int
 }
 
 type ProcessWithUserInfoCall struct {
@@ -333,6 +413,8 @@ func (m *MockAIService) ProcessSubmissionWithUserInfo(ctx context.Context, submi
 		ProcessedContent: `{"headline": "Test", "content": "Test content", "byline": "Test Writer"}`,
 		ProcessingStatus: database.ProcessingStatusSuccess,
 		WordCount:        10,
+		PromptTokens:     m.PromptTokens,
+		CompletionTokens: m.CompletionTokens,
 	}, nil
 }
 
@@ -407,8 +489,10 @@ type UpdateProcessedArticleCall struct {
 }
 
 func NewMockDatabase() *MockDatabase {
-	// Create a temporary DB for embedding (won't be used, just for type compatibility)
-	tempDB, _ := database.NewSimple(":memory:")
+	// Embed a migrated in-memory DB, so the real tables GetUnderlyingDB callers touch exist.
+	// One connection keeps every query on the same in-memory database.
+	tempDB, _ := database.New(database.Config{DataSourceName: ":memory:", MaxOpenConns: 1})
+	tempDB.Migrate()
 
 	return &MockDatabase{
 		WeeklyIssues:                make(map[string]*database.WeeklyNewsletterIssue),
@@ -832,3 +916,125 @@ func (m *MockAIServiceWithSave) ProcessAndSaveSubmission(
 	_, err := db.CreateProcessedArticle(processedArticle)
 	return err
 }
+
+// blockingAIService simulates a slow AI API that only returns once its context is done
+type blockingAIService struct {
+	MockAIService
+}
+
+func (m *blockingAIService) ProcessAndSaveSubmission(
+	ctx context.Context,
+	db *database.DB,
+	submission database.Submission,
+	authorName, authorDepartment, journalistType string,
+	newsletterIssueID *int,
+) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// TDD: processSubmissionAsync should give up on a slow AI call and record a failed article
+func TestProcessSubmissionAsync_TimeoutRecordsFailedArticle(t *testing.T) {
+	mockDB := NewMockDatabase()
+	blockingAI := &blockingAIService{}
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token", AITimeout: 50 * time.Millisecond},
+		nil,
+		[]string{"U1234567"},
+		&MockSubmissionManager{},
+		blockingAI,
+		mockDB,
+	).(*slackBot)
+
+	submission := database.Submission{
+		ID:      42,
+		UserID:  "U12345",
+		Content: "A story the AI is slow to write",
+	}
+
+	done := make(chan struct{})
+	go func() {
+		bot.processSubmissionAsync(context.Background(), submission, submission.UserID, "")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("processSubmissionAsync did not return after the AI timeout")
+	}
+
+	if len(mockDB.ProcessedArticles) != 1 {
+		t.Fatalf("Expected 1 failed article to be recorded, got %d", len(mockDB.ProcessedArticles))
+	}
+
+	article := mockDB.ProcessedArticles[0]
+	if article.SubmissionID != submission.ID {
+		t.Errorf("Expected submission ID %d, got %d", submission.ID, article.SubmissionID)
+	}
+	if article.ProcessingStatus != database.ProcessingStatusFailed {
+		t.Errorf("Expected status %s, got %s", database.ProcessingStatusFailed, article.ProcessingStatus)
+	}
+	if article.ErrorMessage == nil || *article.ErrorMessage != "AI processing timed out" {
+		t.Errorf("Expected timeout error message, got %v", article.ErrorMessage)
+	}
+	if article.NewsletterIssueID == nil {
+		t.Error("Expected failed article to keep its newsletter issue for later retry")
+	}
+}
+
+// TDD: The submission ETA reflects the rolling average of recent processing durations
+func TestProcessingETA(t *testing.T) {
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token"},
+		nil,
+		[]string{"U1234567"},
+		&MockSubmissionManager{},
+		&MockAIService{},
+		NewMockDatabase(),
+	).(*slackBot)
+
+	if eta := bot.processingETA(); eta != "" {
+		t.Errorf("Expected no ETA before anything was processed, got %q", eta)
+	}
+
+	bot.processingTimes.record(6 * time.Second)
+	bot.processingTimes.record(10 * time.Second)
+	if eta := bot.processingETA(); eta != " (usually ready in ~8 seconds)" {
+		t.Errorf("Expected ETA of ~8 seconds, got %q", eta)
+	}
+
+	// Only the most recent processingTimesWindow durations count
+	for i := 0; i < processingTimesWindow; i++ {
+		bot.processingTimes.record(3 * time.Minute)
+	}
+	if eta := bot.processingETA(); eta != " (usually ready in ~3 minutes)" {
+		t.Errorf("Expected ETA of ~3 minutes once old durations drop out, got %q", eta)
+	}
+}
+
+// TDD: A successful processSubmissionAsync run feeds the ETA
+func TestProcessSubmissionAsync_RecordsProcessingTime(t *testing.T) {
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token"},
+		nil,
+		[]string{"U1234567"},
+		&MockSubmissionManager{},
+		&MockAIService{},
+		NewMockDatabase(),
+	).(*slackBot)
+
+	bot.processSubmissionAsync(context.Background(), database.Submission{
+		ID:      7,
+		UserID:  "U12345",
+		Content: "We moved the office plants to the kitchen",
+	}, "U12345", "")
+
+	if _, ok := bot.processingTimes.average(); !ok {
+		t.Error("Expected the processing duration to be recorded")
+	}
+	if eta := bot.processingETA(); eta != " (usually ready in ~1 second)" {
+		t.Errorf("Expected a ~1 second ETA for an instant mock, got %q", eta)
+	}
+}
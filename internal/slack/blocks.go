@@ -0,0 +1,78 @@
+package slack
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// Slack rejects messages with more blocks, or section texts longer than this
+const (
+	maxBlocksPerMessage = 50
+	maxSectionTextRunes = 3000
+)
+
+// withBlocks renders the response text as Block Kit sections, keeping Text as the
+// notification and fallback text. Responses too large for Block Kit stay text-only.
+func withBlocks(response *SlashCommandResponse) *SlashCommandResponse {
+	response.Blocks = markdownBlocks(response.Text)
+	return response
+}
+
+// markdownBlocks turns markdown text into one mrkdwn section per paragraph.
+// Returns nil when the text would need more blocks than Slack allows.
+func markdownBlocks(text string) []slack.Block {
+	var blocks []slack.Block
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+
+		// Block Kit mrkdwn only knows single-asterisk bold
+		paragraph = strings.ReplaceAll(paragraph, "**", "*")
+
+		for _, chunk := range splitSectionText(paragraph) {
+			blocks = append(blocks, slack.NewSectionBlock(
+				slack.NewTextBlockObject(slack.MarkdownType, chunk, false, false), nil, nil))
+		}
+	}
+
+	if len(blocks) == 0 || len(blocks) > maxBlocksPerMessage {
+		return nil
+	}
+	return blocks
+}
+
+// splitSectionText splits a paragraph on line breaks into chunks that fit in one section
+func splitSectionText(paragraph string) []string {
+	if utf8.RuneCountInString(paragraph) <= maxSectionTextRunes {
+		return []string{paragraph}
+	}
+
+	var chunks []string
+	var current strings.Builder
+	for _, line := range strings.Split(paragraph, "\n") {
+		if current.Len() > 0 && utf8.RuneCountInString(current.String())+1+utf8.RuneCountInString(line) > maxSectionTextRunes {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+
+		// A single overlong line is cut at the limit
+		for utf8.RuneCountInString(line) > maxSectionTextRunes {
+			runes := []rune(line)
+			chunks = append(chunks, string(runes[:maxSectionTextRunes]))
+			line = string(runes[maxSectionTextRunes:])
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n")
+		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+
+	return chunks
+}
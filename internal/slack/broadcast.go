@@ -1,16 +1,31 @@
 package slack
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/slack-go/slack"
 )
 
+// slackAPI is the subset of the Slack client used by BroadcastManager
+type slackAPI interface {
+	GetUsersContext(ctx context.Context, options ...slack.GetUsersOption) ([]slack.User, error)
+	OpenConversationContext(ctx context.Context, params *slack.OpenConversationParameters) (*slack.Channel, bool, bool, error)
+	PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
+	UploadFileV2Context(ctx context.Context, params slack.UploadFileV2Parameters) (*slack.FileSummary, error)
+	GetUserGroupsContext(ctx context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error)
+	GetUserGroupMembersContext(ctx context.Context, userGroup string, options ...slack.GetUserGroupMembersOption) ([]string, error)
+}
+
 // BroadcastManager handles broadcasting messages to all workspace members
 type BroadcastManager struct {
-	client *slack.Client
+	client     slackAPI
+	retryDelay time.Duration // First backoff between Slack API retries, defaultSlackRetryDelay when unset
+	maxRetries atomic.Int32  // Retries of a Slack API call, DefaultSlackMaxRetries when unset; changes on reload
 }
 
 // NewBroadcastManager creates a new broadcast manager
@@ -20,8 +35,14 @@ func NewBroadcastManager(token string) *BroadcastManager {
 	}
 }
 
-// BroadcastBodyMindRequest sends a wellness question request to all workspace members
-func (bm *BroadcastManager) BroadcastBodyMindRequest(ctx context.Context) (*BroadcastResult, error) {
+// BroadcastPlan describes who a broadcast would reach and what they would receive
+type BroadcastPlan struct {
+	Recipients []slack.User
+	Message    string
+}
+
+// PlanBodyMindBroadcast resolves the recipients and message of a wellness broadcast without sending anything
+func (bm *BroadcastManager) PlanBodyMindBroadcast(ctx context.Context) (*BroadcastPlan, error) {
 	// Get list of all users in the workspace
 	users, err := bm.getAllWorkspaceUsers(ctx)
 	if err != nil {
@@ -29,10 +50,20 @@ func (bm *BroadcastManager) BroadcastBodyMindRequest(ctx context.Context) (*Broa
 	}
 
 	// Filter out bots and deleted users
-	activeUsers := bm.filterActiveUsers(users)
+	return &BroadcastPlan{
+		Recipients: bm.filterActiveUsers(users),
+		Message:    bm.createWellnessBroadcastMessage(),
+	}, nil
+}
 
-	// Create the wellness question broadcast message
-	message := bm.createWellnessBroadcastMessage()
+// BroadcastBodyMindRequest sends a wellness question request to all workspace members
+func (bm *BroadcastManager) BroadcastBodyMindRequest(ctx context.Context) (*BroadcastResult, error) {
+	plan, err := bm.PlanBodyMindBroadcast(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activeUsers := plan.Recipients
+	message := plan.Message
 
 	// Send direct message to each user
 	var successCount int
@@ -66,9 +97,33 @@ func (bm *BroadcastManager) BroadcastBodyMindRequest(ctx context.Context) (*Broa
 	return result, nil
 }
 
+// SetMaxRetries sets how often a Slack API call is retried; zero or less uses DefaultSlackMaxRetries
+func (bm *BroadcastManager) SetMaxRetries(maxRetries int) {
+	bm.maxRetries.Store(int32(maxRetries))
+}
+
+// retry runs a Slack API call, retrying rate limits and server errors as configured.
+// Broadcasts DM many users in a row, so they are the calls most likely to be rate limited.
+func (bm *BroadcastManager) retry(ctx context.Context, operation string, fn func() error) error {
+	delay := bm.retryDelay
+	if delay <= 0 {
+		delay = defaultSlackRetryDelay
+	}
+	maxRetries := int(bm.maxRetries.Load())
+	if maxRetries <= 0 {
+		maxRetries = DefaultSlackMaxRetries
+	}
+	return retrySlackCall(ctx, operation, maxRetries, delay, fn)
+}
+
 // getAllWorkspaceUsers retrieves all users from the workspace
 func (bm *BroadcastManager) getAllWorkspaceUsers(ctx context.Context) ([]slack.User, error) {
-	users, err := bm.client.GetUsersContext(ctx)
+	var users []slack.User
+	err := bm.retry(ctx, "users.list", func() error {
+		var err error
+		users, err = bm.client.GetUsersContext(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
@@ -99,20 +154,37 @@ func (bm *BroadcastManager) filterActiveUsers(users []slack.User) []slack.User {
 
 // sendDirectMessage sends a direct message to a specific user
 func (bm *BroadcastManager) sendDirectMessage(ctx context.Context, userID, message string) error {
+	return bm.sendDirectMessageBlocks(ctx, userID, message, nil)
+}
+
+// sendDirectMessageBlocks sends a Block Kit message to a user, with message as the notification and fallback text
+func (bm *BroadcastManager) sendDirectMessageBlocks(ctx context.Context, userID, message string, blocks []slack.Block) error {
 	// Open IM channel with the user first
 	params := &slack.OpenConversationParameters{
 		Users: []string{userID},
 	}
-	channel, _, _, err := bm.client.OpenConversationContext(ctx, params)
+	var channel *slack.Channel
+	err := bm.retry(ctx, "conversations.open", func() error {
+		var err error
+		channel, _, _, err = bm.client.OpenConversationContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to open IM channel with user %s: %w", userID, err)
 	}
 
 	// Send message to the IM channel
-	_, _, err = bm.client.PostMessageContext(ctx, channel.ID,
+	options := []slack.MsgOption{
 		slack.MsgOptionText(message, false),
 		slack.MsgOptionAsUser(true),
-	)
+	}
+	if len(blocks) > 0 {
+		options = append(options, slack.MsgOptionBlocks(blocks...))
+	}
+	err = bm.retry(ctx, "chat.postMessage", func() error {
+		_, _, err := bm.client.PostMessageContext(ctx, channel.ID, options...)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to send message to user %s: %w", userID, err)
 	}
@@ -120,6 +192,30 @@ func (bm *BroadcastManager) sendDirectMessage(ctx context.Context, userID, messa
 	return nil
 }
 
+// uploadFileToUser uploads a file into a direct message with a specific user
+func (bm *BroadcastManager) uploadFileToUser(ctx context.Context, userID, filename, title string, content []byte) error {
+	params := &slack.OpenConversationParameters{
+		Users: []string{userID},
+	}
+	channel, _, _, err := bm.client.OpenConversationContext(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to open IM channel with user %s: %w", userID, err)
+	}
+
+	_, err = bm.client.UploadFileV2Context(ctx, slack.UploadFileV2Parameters{
+		Reader:   bytes.NewReader(content),
+		FileSize: len(content),
+		Filename: filename,
+		Title:    title,
+		Channel:  channel.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload file to user %s: %w", userID, err)
+	}
+
+	return nil
+}
+
 // lookupUserByName searches for a user by username, real name, or display name
 func (bm *BroadcastManager) lookupUserByName(ctx context.Context, searchName string) (string, error) {
 	users, err := bm.getAllWorkspaceUsers(ctx)
@@ -141,6 +237,24 @@ func (bm *BroadcastManager) lookupUserByName(ctx context.Context, searchName str
 	return "", fmt.Errorf("user not found: %s", searchName)
 }
 
+// ListUsergroups returns every usergroup in the workspace
+func (bm *BroadcastManager) ListUsergroups(ctx context.Context) ([]slack.UserGroup, error) {
+	groups, err := bm.client.GetUserGroupsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usergroups: %w", err)
+	}
+	return groups, nil
+}
+
+// GetUsergroupMembers returns the member IDs of a usergroup
+func (bm *BroadcastManager) GetUsergroupMembers(ctx context.Context, groupID string) ([]string, error) {
+	members, err := bm.client.GetUserGroupMembersContext(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of usergroup %s: %w", groupID, err)
+	}
+	return members, nil
+}
+
 // createWellnessBroadcastMessage creates the message content for wellness question requests
 func (bm *BroadcastManager) createWellnessBroadcastMessage() string {
 	return "💡 *Help us expand our wellness content pool!*\n\n" +
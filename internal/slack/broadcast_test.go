@@ -14,7 +14,7 @@ import (
 type slackClientInterface interface {
 	PostMessageContext(ctx context.Context, channel string, options ...slack.MsgOption) (string, string, error)
 	OpenConversationContext(ctx context.Context, params *slack.OpenConversationParameters) (*slack.Channel, bool, bool, error)
-	GetUsersContext(ctx context.Context) ([]slack.User, error)
+	GetUsersContext(ctx context.Context, options ...slack.GetUsersOption) ([]slack.User, error)
 }
 
 // mockSlackClient implements slackClientInterface for testing
@@ -26,6 +26,7 @@ type mockSlackClient struct {
 	shouldFailGetUsers    bool
 	imChannelID           string
 	users                 []slack.User
+	getUsersCalls         int
 }
 
 type postMessageCall struct {
@@ -68,13 +69,26 @@ func (m *mockSlackClient) OpenConversationContext(ctx context.Context, params *s
 	}, false, false, nil
 }
 
-func (m *mockSlackClient) GetUsersContext(ctx context.Context) ([]slack.User, error) {
+func (m *mockSlackClient) GetUsersContext(ctx context.Context, options ...slack.GetUsersOption) ([]slack.User, error) {
+	m.getUsersCalls++
 	if m.shouldFailGetUsers {
 		return nil, errors.New("failed to get users")
 	}
 	return m.users, nil
 }
 
+func (m *mockSlackClient) UploadFileV2Context(ctx context.Context, params slack.UploadFileV2Parameters) (*slack.FileSummary, error) {
+	return &slack.FileSummary{}, nil
+}
+
+func (m *mockSlackClient) GetUserGroupsContext(ctx context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+	return nil, nil
+}
+
+func (m *mockSlackClient) GetUserGroupMembersContext(ctx context.Context, userGroup string, options ...slack.GetUserGroupMembersOption) ([]string, error) {
+	return nil, nil
+}
+
 // TestSendDirectMessageCurrentBehavior demonstrates the current failing behavior
 func TestSendDirectMessageCurrentBehavior(t *testing.T) {
 	ctx := context.Background()
@@ -364,3 +378,53 @@ func TestLookupUserByNameAPIFailure(t *testing.T) {
 		t.Errorf("Expected empty user ID when GetUsers fails, got '%s'", userID)
 	}
 }
+
+// TDD: broadcast-bodymind --dry-run previews recipients and message without sending
+func TestBroadcastBodyMindDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient := &mockSlackClient{
+		imChannelID: "D123",
+		users: []slack.User{
+			{ID: "U001", Name: "alice"},
+			{ID: "U002", Name: "bob"},
+			{ID: "U003", Name: "carol"},
+			{ID: "B001", Name: "helperbot", IsBot: true},
+			{ID: "U004", Name: "gone", Deleted: true},
+			{ID: "USLACKBOT", Name: "slackbot"},
+		},
+	}
+
+	handler := NewAdminHandler(&MockQuestionSelector{}, []string{"U123ADMIN"})
+	handler.broadcastManager = &BroadcastManager{client: mockClient}
+
+	response, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{
+		Action: "broadcast-bodymind",
+		Args:   []string{"--dry-run"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to handle broadcast-bodymind --dry-run: %v", err)
+	}
+
+	if len(mockClient.postMessageCalls) != 0 || len(mockClient.openConversationCalls) != 0 {
+		t.Errorf("Expected no Slack sends in dry-run, got %d posts and %d IM opens",
+			len(mockClient.postMessageCalls), len(mockClient.openConversationCalls))
+	}
+
+	if !strings.Contains(response.Text, "Would send to 3 users") {
+		t.Errorf("Expected recipient count of 3, got: %s", response.Text)
+	}
+
+	expectedMessage := (&BroadcastManager{}).createWellnessBroadcastMessage()
+	if !strings.Contains(response.Text, expectedMessage) {
+		t.Errorf("Expected dry-run to include the exact broadcast message, got: %s", response.Text)
+	}
+
+	// Without the flag the broadcast really goes out
+	if _, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{Action: "broadcast-bodymind"}); err != nil {
+		t.Fatalf("Failed to handle broadcast-bodymind: %v", err)
+	}
+	if len(mockClient.postMessageCalls) != 3 {
+		t.Errorf("Expected 3 messages sent without --dry-run, got %d", len(mockClient.postMessageCalls))
+	}
+}
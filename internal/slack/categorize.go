@@ -0,0 +1,196 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+// categorySignals lists, per category, words and phrases typical of its submissions
+var categorySignals = []struct {
+	category string
+	signals  []string
+}{
+	{"feature", []string{
+		"launch", "launched", "shipped", "released", "rolled out", "went live", "go live",
+		"built", "migrated", "redesign", "redesigned", "new feature", "milestone",
+		"project", "prototype", "our team", "the team", "we've been working", "months of work",
+	}},
+	{"general", []string{
+		"reminder", "don't forget", "announcement", "heads up", "fika", "lunch", "kitchen",
+		"coffee machine", "sign up", "party", "afterwork", "birthday", "closed", "dress code",
+	}},
+}
+
+// minCategorySignals is how many distinct signals a submission needs before a category is suggested
+const minCategorySignals = 2
+
+// suggestCategory guesses a better category for content from keyword signals. It reports
+// false when no category has enough signals, or two categories are equally likely.
+func suggestCategory(content string) (string, bool) {
+	text := " " + normalizeForSignals(content) + " "
+	best, bestHits, tied := "", 0, false
+	for _, candidate := range categorySignals {
+		hits := 0
+		for _, signal := range candidate.signals {
+			if strings.Contains(text, " "+signal+" ") {
+				hits++
+			}
+		}
+		switch {
+		case hits > bestHits:
+			best, bestHits, tied = candidate.category, hits, false
+		case hits == bestHits:
+			tied = true
+		}
+	}
+	if bestHits < minCategorySignals || tied {
+		return "", false
+	}
+	return best, true
+}
+
+// assignmentMismatch reports the category content reads like when it clearly doesn't match
+// the assignment it answers. Only categories with signals of their own are compared, so an
+// interview answer is never flagged for sounding like a feature.
+func assignmentMismatch(contentType database.ContentType, content string) (string, bool) {
+	assigned := contentTypeToSubmissionCategory(contentType)
+	known := false
+	for _, candidate := range categorySignals {
+		if candidate.category == assigned {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return "", false
+	}
+
+	suggested, confident := suggestCategory(content)
+	if !confident || suggested == assigned {
+		return "", false
+	}
+	return suggested, true
+}
+
+// normalizeForSignals lowercases text and turns punctuation into single spaces
+func normalizeForSignals(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	}), " ")
+}
+
+// categorySuggestion returns a heads-up line for a submission that fell back to category but
+// looks like something else. It is only offered when the user has an open assignment of the
+// suggested type, since that is what recategorizing links the submission to.
+func (b *slackBot) categorySuggestion(userID string, submissionID int, category, content string) string {
+	suggested, confident := suggestCategory(content)
+	if !confident || suggested == category || !containsString(b.submissionCategories(), suggested) || b.db == nil {
+		return ""
+	}
+
+	assignment, err := b.db.GetActiveAssignmentByUser(userID, database.ContentType(categoryToContentType(suggested)))
+	if err != nil || assignment == nil || assignment.SubmissionID != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("💡 Heads up: this looks like a %s — reply `/pp recategorize %d %s` to change it\n", suggested, submissionID, suggested)
+}
+
+// handleRecategorize moves one of the user's unlinked submissions to their open assignment of
+// another category, so it is written up by that category's journalist
+func (b *slackBot) handleRecategorize(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	usage := "Usage: `recategorize submission_id category`\nExample: `recategorize 42 feature`"
+
+	parts := strings.Fields(strings.TrimPrefix(cmd.Text, "recategorize "))
+	if len(parts) != 2 {
+		return &SlashCommandResponse{Text: usage, ResponseType: "ephemeral"}, nil
+	}
+
+	submissionID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.\n\n%s", parts[0], usage),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Body/mind submissions are anonymous, so nothing can be moved there
+	category := parts[1]
+	if category == "body_mind" || !containsString(b.submissionCategories(), category) {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Cannot recategorize to '%s'. Enabled categories: %s", category, strings.Join(b.submissionCategories(), ", ")),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var dbPtr *database.DB
+	if b.db != nil {
+		dbPtr = b.db.GetUnderlyingDB()
+	}
+	if dbPtr == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Recategorizing not available (database not configured)",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	submission, err := dbPtr.GetSubmission(submissionID)
+	if err != nil || submission.UserID != cmd.UserID {
+		loggerFor(ctx).Warn("Rejected recategorize", "submission_id", submissionID, "user", cmd.UserID, "error", err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Submission #%d could not be recategorized. You can only recategorize your own submissions.", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if linked, err := b.db.GetAssignmentBySubmissionID(submissionID); err == nil && linked != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Submission #%d already answers your %s assignment.", submissionID, contentTypeToSubmissionCategory(linked.ContentType)),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	assignment, err := b.db.GetActiveAssignmentByUser(cmd.UserID, database.ContentType(categoryToContentType(category)))
+	if err != nil || assignment == nil || assignment.SubmissionID != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("📭 You have no open %s assignment this week to move submission #%d to.", category, submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if err := b.db.LinkSubmissionToAssignment(assignment.ID, submissionID); err != nil {
+		loggerFor(ctx).Error("Failed to link recategorized submission", "submission_id", submissionID, "assignment_id", assignment.ID, "error", err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to recategorize submission #%d. Please try again later.", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	responseText := fmt.Sprintf("🏷️ *Submission #%d is now your %s!*\n\n", submissionID, category)
+
+	// Articles written as the old category are replaced by the new journalist's take
+	if superseded, err := b.db.SupersedeProcessedArticles(submissionID); err != nil {
+		loggerFor(ctx).Error("Failed to supersede articles for recategorized submission", "submission_id", submissionID, "error", err)
+	} else if superseded > 0 {
+		loggerFor(ctx).Info("Superseded articles for recategorized submission", "submission_id", submissionID, "count", superseded)
+	}
+
+	if b.aiProcessor != nil {
+		responseText += "🤖 Re-processing with AI in the background...\n"
+		b.goBackground(func() {
+			b.processSubmissionAsync(context.WithoutCancel(ctx), *submission, cmd.UserID, cmd.ResponseURL)
+		})
+	}
+
+	responseText += "✅ Thanks for helping us file it right!"
+
+	return &SlashCommandResponse{
+		Text:         responseText,
+		ResponseType: "ephemeral",
+	}, nil
+}
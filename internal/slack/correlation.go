@@ -0,0 +1,42 @@
+package slack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+// correlationIDKey is the context key for a submission's correlation ID
+type correlationIDKey struct{}
+
+// newCorrelationID returns a short random ID for following one submission through the logs
+func newCorrelationID() string {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// withCorrelationID returns a context carrying a correlation ID, keeping one already present
+func withCorrelationID(ctx context.Context) context.Context {
+	if correlationID(ctx) != "" {
+		return ctx
+	}
+	return context.WithValue(ctx, correlationIDKey{}, newCorrelationID())
+}
+
+// correlationID returns the context's correlation ID, or "" when it has none
+func correlationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// loggerFor returns the default logger, tagged with the context's correlation ID when it has one
+func loggerFor(ctx context.Context) *slog.Logger {
+	if id := correlationID(ctx); id != "" {
+		return slog.Default().With("correlation_id", id)
+	}
+	return slog.Default()
+}
@@ -0,0 +1,220 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
+)
+
+// syncBuffer is a bytes.Buffer safe to share between a test and background goroutines
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// TDD: Every log line of a submission, from receipt through async processing, carries one
+// correlation ID, and the follow-up message reports it in its metadata
+func TestSubmissionLogsShareCorrelationID(t *testing.T) {
+	logs := &syncBuffer{}
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	followups := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users.info":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"ok": true, "user": {"id": "U12345", "name": "anna", "real_name": "Anna Svensson"}}`))
+		case "/followup":
+			body, _ := io.ReadAll(r.Body)
+			followups <- body
+		}
+	}))
+	defer server.Close()
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token"},
+		nil,
+		[]string{"U1234567"},
+		&MockSubmissionManager{},
+		&MockAIService{},
+		NewMockDatabase(),
+	).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	_, err := bot.HandleSlashCommand(context.Background(), SlashCommand{
+		Command:     "/pp",
+		Text:        "submit general We repainted the office kitchen in bright yellow this week",
+		UserID:      "U12345",
+		ResponseURL: server.URL + "/followup",
+	})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+
+	var followup []byte
+	select {
+	case followup = <-followups:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the follow-up message")
+	}
+
+	var payload struct {
+		Metadata struct {
+			EventPayload struct {
+				CorrelationID string `json:"correlation_id"`
+			} `json:"event_payload"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(followup, &payload); err != nil {
+		t.Fatalf("Failed to decode follow-up payload: %v", err)
+	}
+	id := payload.Metadata.EventPayload.CorrelationID
+	if id == "" {
+		t.Fatalf("Expected a correlation ID in the follow-up metadata, got: %s", followup)
+	}
+
+	// The follow-up is logged after it is sent, so wait for that last line
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(logs.String(), "Successfully sent follow-up message") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Goroutines left over from other tests may log too, so only this submission's ID is followed
+	var lifecycle []string
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Failed to decode log line %q: %v", line, err)
+		}
+		if got, _ := entry["correlation_id"].(string); got == id {
+			msg, _ := entry["msg"].(string)
+			lifecycle = append(lifecycle, msg)
+		}
+	}
+
+	for _, expected := range []string{
+		"Starting async AI processing",
+		"Successfully enriched submission with user info",
+		"ProcessAndSaveSubmission completed successfully",
+		"Successfully sent follow-up message to Slack",
+	} {
+		if !containsString(lifecycle, expected) {
+			t.Errorf("Expected a %q log line with correlation ID %q, got: %v", expected, id, lifecycle)
+		}
+	}
+}
+
+// TDD: A context keeps the correlation ID it already has
+func TestWithCorrelationIDKeepsExisting(t *testing.T) {
+	ctx := withCorrelationID(context.Background())
+	id := correlationID(ctx)
+	if len(id) != 8 {
+		t.Errorf("Expected an 8 character correlation ID, got %q", id)
+	}
+	if again := correlationID(withCorrelationID(ctx)); again != id {
+		t.Errorf("Expected correlation ID %q to be kept, got %q", id, again)
+	}
+	if other := correlationID(withCorrelationID(context.Background())); other == id {
+		t.Errorf("Expected a new context to get a fresh correlation ID, got %q twice", id)
+	}
+}
+
+// contextCapturingAIService hands the context of every ProcessAndSaveSubmission call to the test
+// and holds the call open until the test releases it
+type contextCapturingAIService struct {
+	*MockAIService
+	contexts chan context.Context
+	release  chan struct{}
+}
+
+func (m *contextCapturingAIService) ProcessAndSaveSubmission(ctx context.Context, db *database.DB, submission database.Submission, authorName, authorDepartment, journalistType string, newsletterIssueID *int) error {
+	m.contexts <- ctx
+	<-m.release
+	return nil
+}
+
+// TDD: Async processing keeps the request's values, such as its correlation ID, but outlives the
+// request's cancellation and bounds the AI call with its own deadline
+func TestAsyncProcessingKeepsRequestValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true, "user": {"id": "U12345", "name": "anna", "real_name": "Anna Svensson"}}`))
+	}))
+	defer server.Close()
+
+	db := createTestDB(t)
+	defer db.Close()
+
+	submissionManager := database.NewSubmissionManager(db.DB)
+	aiService := &contextCapturingAIService{MockAIService: &MockAIService{}, contexts: make(chan context.Context), release: make(chan struct{})}
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, nil, submissionManager, aiService, db).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	// run sends a command on a request context that is cancelled as soon as the command returns,
+	// and returns the context the background AI call ran with
+	run := func(text string) (requestID string, jobCtx context.Context) {
+		t.Helper()
+		requestCtx, cancel := context.WithCancel(withCorrelationID(context.Background()))
+		if _, err := bot.HandleSlashCommand(requestCtx, SlashCommand{Command: "/pp", Text: text, UserID: "U12345"}); err != nil {
+			t.Fatalf("HandleSlashCommand(%q) failed: %v", text, err)
+		}
+		cancel()
+
+		select {
+		case jobCtx = <-aiService.contexts:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for async processing of %q", text)
+		}
+		return correlationID(requestCtx), jobCtx
+	}
+
+	for _, name := range []string{"submit", "edit"} {
+		text := "submit general We repainted the office kitchen in bright yellow this week"
+		if name == "edit" {
+			submissions, err := submissionManager.GetSubmissionsByUser(context.Background(), "U12345")
+			if err != nil || len(submissions) == 0 {
+				t.Fatalf("Failed to get the submission to edit: %v", err)
+			}
+			text = fmt.Sprintf(`edit %d "We repainted the office kitchen in calm green this week"`, submissions[0].ID)
+		}
+
+		requestID, jobCtx := run(text)
+		if got := correlationID(jobCtx); got != requestID {
+			t.Errorf("%s: expected the job to carry correlation ID %q, got %q", name, requestID, got)
+		}
+		if err := jobCtx.Err(); err != nil {
+			t.Errorf("%s: expected the job to outlive the cancelled request, got %v", name, err)
+		}
+		if _, ok := jobCtx.Deadline(); !ok {
+			t.Errorf("%s: expected the AI call to have its own deadline", name)
+		}
+		aiService.release <- struct{}{}
+	}
+	bot.jobs.Wait()
+}
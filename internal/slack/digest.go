@@ -0,0 +1,154 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+// WeeklyDigestResult reports who a weekly digest was sent to
+type WeeklyDigestResult struct {
+	Sent   []string // Admins who got the digest
+	Errors []string // One entry per admin the digest couldn't be sent to
+}
+
+// weeklyDigest summarizes the plan for the week of now for admins: who is assigned to what and
+// which slots are still open, the body/mind pool, and last week's assignments that were never submitted
+func (ah *AdminHandler) weeklyDigest(now time.Time) (string, error) {
+	year, week := now.ISOWeek()
+	assignments, err := ah.db.GetAssignmentsByWeek(week, year)
+	if err != nil {
+		return "", fmt.Errorf("failed to get assignments: %w", err)
+	}
+
+	var digest strings.Builder
+	digest.WriteString(fmt.Sprintf("🗞️ *Weekly digest - week %d, %d*\n\n", week, year))
+
+	// Assignments per content type, in plan-week order followed by any others
+	assigned := make(map[database.ContentType][]string)
+	for _, assignment := range assignments {
+		assigned[assignment.ContentType] = append(assigned[assignment.ContentType], assignment.PersonID)
+	}
+	slots := ah.db.AssignmentSlots()
+	contentTypes := append([]database.ContentType(nil), planWeekContentTypes...)
+	var others []database.ContentType
+	for contentType := range assigned {
+		if !containsContentType(contentTypes, contentType) {
+			others = append(others, contentType)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	contentTypes = append(contentTypes, others...)
+
+	digest.WriteString("📝 *This week's plan:*\n")
+	listed := 0
+	for _, contentType := range contentTypes {
+		people := assigned[contentType]
+		open := slots[contentType] - len(people)
+		if len(people) == 0 && open <= 0 {
+			continue
+		}
+		listed++
+
+		mentions := make([]string, len(people))
+		for i, personID := range people {
+			mentions[i] = fmt.Sprintf("<@%s>", personID)
+		}
+		line := strings.Join(mentions, ", ")
+		if len(people) == 0 {
+			line = "nobody assigned"
+		}
+		if open > 0 {
+			line += fmt.Sprintf(" ⚠️ %d open slot(s)", open)
+		}
+		digest.WriteString(fmt.Sprintf("• %s: %s\n", contentType, line))
+	}
+	if listed == 0 {
+		digest.WriteString("• Nobody is assigned yet\n")
+	}
+
+	if ah.poolManager != nil {
+		status, err := ah.poolManager.GetPoolStatus()
+		if err != nil {
+			slog.Warn("Failed to get pool status for weekly digest", "error", err)
+		} else {
+			digest.WriteString(fmt.Sprintf("\n🧘 *Body/mind pool:* %d questions\n%s\n", status.TotalActive, status.RecommendedAction))
+		}
+	}
+
+	carryover, err := ah.unsubmittedInWeekOf(now.AddDate(0, 0, -7))
+	if err != nil {
+		slog.Warn("Failed to get last week's unsubmitted assignments for weekly digest", "error", err)
+	} else if len(carryover) > 0 {
+		digest.WriteString(fmt.Sprintf("\n⏳ *Still not submitted from last week* (%d):\n", len(carryover)))
+		for _, assignment := range carryover {
+			digest.WriteString(fmt.Sprintf("• <@%s> - %s\n", assignment.PersonID, assignment.ContentType))
+		}
+	}
+
+	digest.WriteString("\nSee `admin week-status` for the details.")
+	return digest.String(), nil
+}
+
+// unsubmittedInWeekOf returns the assignments of the week containing t that have no submission
+func (ah *AdminHandler) unsubmittedInWeekOf(t time.Time) ([]database.PersonAssignment, error) {
+	year, week := t.ISOWeek()
+	issue, err := ah.db.GetWeeklyIssueByWeek(week, year)
+	if err != nil {
+		return nil, nil // No issue that week, so nothing to carry over
+	}
+	return ah.db.GetUnsubmittedAssignments(issue.ID)
+}
+
+// SendWeeklyDigest DMs every super admin the current week's digest
+func (ah *AdminHandler) SendWeeklyDigest(ctx context.Context) (*WeeklyDigestResult, error) {
+	if ah.db == nil {
+		return nil, fmt.Errorf("weekly automation is not available")
+	}
+	if ah.broadcastManager == nil {
+		return nil, fmt.Errorf("direct messages are not available")
+	}
+
+	digest, err := ah.weeklyDigest(time.Now().In(ah.db.Location()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build weekly digest: %w", err)
+	}
+
+	result := &WeeklyDigestResult{}
+	for _, adminID := range ah.adminUsers() {
+		if err := ah.broadcastManager.sendDirectMessage(ctx, adminID, digest); err != nil {
+			slog.Warn("Failed to send weekly digest", "admin", adminID, "error", err)
+			result.Errors = append(result.Errors, fmt.Sprintf("Admin %s: %v", adminID, err))
+			continue
+		}
+		result.Sent = append(result.Sent, adminID)
+	}
+
+	slog.Info("Sent weekly digest", "sent", len(result.Sent), "failed", len(result.Errors))
+	return result, nil
+}
+
+// handleWeeklyDigest sends the current week's digest to every super admin on demand
+func (ah *AdminHandler) handleWeeklyDigest(ctx context.Context, args []string) (*SlashCommandResponse, error) {
+	result, err := ah.SendWeeklyDigest(ctx)
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to send the weekly digest: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	text := fmt.Sprintf("✅ Sent the weekly digest to %d admin(s).", len(result.Sent))
+	if len(result.Errors) > 0 {
+		text += fmt.Sprintf("\n❌ Failed for %d:\n%s", len(result.Errors), strings.Join(result.Errors, "\n"))
+	}
+	return &SlashCommandResponse{
+		Text:         text,
+		ResponseType: "ephemeral",
+	}, nil
+}
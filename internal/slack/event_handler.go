@@ -52,6 +52,7 @@ func (h *EventCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	// Parse the event payload from raw body
 	var payload struct {
 		Type      string     `json:"type"`
+		EventID   string     `json:"event_id"`
 		Event     SlackEvent `json:"event"`
 		Challenge string     `json:"challenge"` // For URL verification
 	}
@@ -72,6 +73,16 @@ func (h *EventCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// Handle regular events
 	if payload.Type == "event_callback" {
+		if payload.Event.EventID == "" {
+			payload.Event.EventID = payload.EventID
+		}
+		if retryNum := r.Header.Get("X-Slack-Retry-Num"); retryNum != "" {
+			slog.Info("Received Slack event retry",
+				"event_id", payload.EventID,
+				"retry_num", retryNum,
+				"reason", r.Header.Get("X-Slack-Retry-Reason"))
+		}
+
 		if err := h.bot.HandleEventCallback(r.Context(), payload.Event); err != nil {
 			slog.Error("Failed to handle event", "error", err)
 			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
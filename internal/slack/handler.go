@@ -86,6 +86,16 @@ func (h *SlashCommandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		UserID:      r.FormValue("user_id"),
 		ChannelID:   r.FormValue("channel_id"),
 		ResponseURL: r.FormValue("response_url"),
+		TriggerID:   r.FormValue("trigger_id"),
+	}
+
+	// Clients may name a submit themselves; Slack's own retries repeat the trigger_id
+	command.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	if command.IdempotencyKey == "" {
+		command.IdempotencyKey = r.FormValue("idempotency_key")
+	}
+	if command.IdempotencyKey == "" {
+		command.IdempotencyKey = command.TriggerID
 	}
 
 	// Log the incoming command for debugging
@@ -1,11 +1,15 @@
 package slack
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
 )
 
 func testSlashCommandHandler(t *testing.T) {
@@ -32,3 +36,132 @@ func testSlashCommandHandler(t *testing.T) {
 		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
 	}
 }
+
+// TDD: Test the envelope event_id reaches the bot so retries can be deduplicated
+func TestEventCallbackHandler_PassesEventID(t *testing.T) {
+	mockBot := NewMockBot()
+	handler := NewEventCallbackHandler(mockBot, "")
+
+	body := `{"type":"event_callback","event_id":"Ev0123ABCD","event":{"type":"message","user":"U123","text":"hi","channel":"D123","client_msg_id":"5f1c7a9e-msg"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/slack/events", strings.NewReader(body))
+	req.Header.Set("X-Slack-Retry-Num", "1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", w.Code)
+	}
+	if len(mockBot.HandleEventCallbackCalls) != 1 {
+		t.Fatalf("Expected 1 event callback, got %d", len(mockBot.HandleEventCallbackCalls))
+	}
+	event := mockBot.HandleEventCallbackCalls[0].Event
+	if event.EventID != "Ev0123ABCD" {
+		t.Errorf("Expected event ID Ev0123ABCD, got %q", event.EventID)
+	}
+	if event.ClientMsgID != "5f1c7a9e-msg" {
+		t.Errorf("Expected client message ID 5f1c7a9e-msg, got %q", event.ClientMsgID)
+	}
+}
+
+// TDD: The slash command endpoint sends blocks alongside the text fallback when present
+func TestSlashCommandHandler_EmitsBlocks(t *testing.T) {
+	mockBot := NewMockBot()
+	mockBot.responses["/pp"] = withBlocks(&SlashCommandResponse{
+		Text:         "*Title*\n\nBody",
+		ResponseType: "ephemeral",
+	})
+	handler := NewSlashCommandHandler(mockBot)
+
+	formData := url.Values{}
+	formData.Set("command", "/pp")
+	formData.Set("text", "help")
+	formData.Set("user_id", "U1234567")
+	req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", w.Code)
+	}
+
+	var body struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type string `json:"type"`
+			Text struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"text"`
+		} `json:"blocks"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if body.Text != "*Title*\n\nBody" {
+		t.Errorf("Expected text fallback, got %q", body.Text)
+	}
+	if len(body.Blocks) != 2 || body.Blocks[0].Type != "section" || body.Blocks[0].Text.Type != "mrkdwn" || body.Blocks[1].Text.Text != "Body" {
+		t.Errorf("Expected two mrkdwn sections, got %+v", body.Blocks)
+	}
+}
+
+// TDD: Replaying a submit with the same trigger_id or client-supplied key creates one submission
+func TestSlashCommandHandler_IdempotentSubmit(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	// Content dedup is off, so only the idempotency key can collapse the replays
+	submissionManager := database.NewSubmissionManager(testDB.DB)
+	submissionManager.SetDuplicateWindow(0)
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, &MockQuestionSelector{}, []string{"U999999999"}, submissionManager, nil, testDB)
+	handler := NewSlashCommandHandler(bot)
+
+	post := func(form url.Values, header string) string {
+		t.Helper()
+		form.Set("command", "/pp")
+		form.Set("text", "submit general The coffee machine on floor 2 is fixed")
+		form.Set("user_id", "U111111111")
+		req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if header != "" {
+			req.Header.Set("Idempotency-Key", header)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d", w.Code)
+		}
+		return w.Body.String()
+	}
+	countSubmissions := func() int {
+		t.Helper()
+		submissions, err := submissionManager.GetAllSubmissions(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to get submissions: %v", err)
+		}
+		return len(submissions)
+	}
+
+	post(url.Values{"trigger_id": {"13345224609.738474920.8088930838d88f008e0"}}, "")
+	if body := post(url.Values{"trigger_id": {"13345224609.738474920.8088930838d88f008e0"}}, ""); !strings.Contains(body, "already received this submission") {
+		t.Errorf("Expected the replayed submit to be acknowledged as a duplicate, got: %s", body)
+	}
+	if count := countSubmissions(); count != 1 {
+		t.Fatalf("Expected 1 submission after replaying the trigger_id, got %d", count)
+	}
+
+	// A client-supplied key wins over the trigger_id, which differs on every attempt
+	post(url.Values{"trigger_id": {"first-attempt"}}, "client-key-1")
+	post(url.Values{"trigger_id": {"second-attempt"}}, "client-key-1")
+	if count := countSubmissions(); count != 2 {
+		t.Fatalf("Expected 1 more submission after replaying the client key, got %d in total", count)
+	}
+
+	// Without a key every submit counts
+	post(url.Values{}, "")
+	post(url.Values{}, "")
+	if count := countSubmissions(); count != 4 {
+		t.Errorf("Expected submits without a key to each be stored, got %d in total", count)
+	}
+}
@@ -0,0 +1,107 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/slack-go/slack"
+)
+
+// InteractionHandler handles Slack interactivity payloads such as submitted modals and button presses
+type InteractionHandler struct {
+	bot           Bot
+	signingSecret string
+}
+
+func NewInteractionHandler(bot Bot, signingSecret string) *InteractionHandler {
+	return &InteractionHandler{
+		bot:           bot,
+		signingSecret: signingSecret,
+	}
+}
+
+func (h *InteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Only accept POST requests
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read the raw body first (needed for signature verification)
+	rawBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("Failed to read request body", "error", err)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	// Verify request signature
+	if h.signingSecret != "" {
+		signature := r.Header.Get("X-Slack-Signature")
+		timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+
+		if !VerifySignature(h.signingSecret, timestamp, string(rawBody), signature) {
+			slog.Warn("Invalid signature - rejecting request",
+				"signature", signature,
+				"timestamp", timestamp)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	// Interactivity payloads arrive as JSON in the payload form field
+	r.Body = io.NopCloser(bytes.NewReader(rawBody))
+	if err := r.ParseForm(); err != nil {
+		slog.Error("Failed to parse form data", "error", err)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	var callback slack.InteractionCallback
+	if err := json.Unmarshal([]byte(r.FormValue("payload")), &callback); err != nil {
+		slog.Error("Failed to parse interaction payload", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	// Button presses need no response body
+	if actionHandler, ok := h.bot.(BlockActionHandler); ok && callback.Type == slack.InteractionTypeBlockActions {
+		if err := actionHandler.HandleBlockActions(r.Context(), callback); err != nil {
+			slog.Error("Failed to handle block actions", "error", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	handler, ok := h.bot.(ViewSubmissionHandler)
+	if callback.Type != slack.InteractionTypeViewSubmission || !ok {
+		// Unhandled interactions still get a 200 so Slack doesn't show an error
+		slog.Warn("Unhandled Slack interaction type", "type", callback.Type)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	response, err := handler.HandleViewSubmission(r.Context(), callback)
+	if err != nil {
+		slog.Error("Failed to handle view submission", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	// An empty 200 closes the modal
+	if response == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		slog.Error("Failed to encode view submission response", "error", err)
+	}
+}
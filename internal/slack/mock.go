@@ -77,6 +77,14 @@ func (m *MockQuestionSelector) DeleteQuestion(ctx context.Context, questionID in
 	return nil
 }
 
+func (m *MockQuestionSelector) GetQuestionUsageStats(ctx context.Context, category string) ([]database.QuestionUsageStat, error) {
+	return nil, nil
+}
+
+func (m *MockQuestionSelector) ResetCategoryRotation(ctx context.Context, category string) error {
+	return nil
+}
+
 func NewMockBot() *MockBot {
 	return &MockBot{
 		responses:            make(map[string]*SlashCommandResponse),
@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// Identifiers of the guided submission modal and its inputs, as they come back in view_submission
+const (
+	submissionModalCallbackID = "pp_submission"
+	submissionCategoryBlockID = "category"
+	submissionCategoryAction  = "category_select"
+	submissionContentBlockID  = "content"
+	submissionContentAction   = "content_input"
+)
+
+// submissionCategoryLabels are the modal's names for the submission categories
+var submissionCategoryLabels = map[string]string{
+	"feature":   "Feature",
+	"general":   "General news",
+	"interview": "Interview",
+	"body_mind": "Body & mind (anonymous)",
+}
+
+// submissionModal builds the guided submission form with a category dropdown, preselecting
+// defaultCategory, and a multiline field for the content
+func submissionModal(categories []string, defaultCategory string) slack.ModalViewRequest {
+	var options []*slack.OptionBlockObject
+	var initial *slack.OptionBlockObject
+	for _, category := range categories {
+		label, ok := submissionCategoryLabels[category]
+		if !ok {
+			label = category
+		}
+		option := slack.NewOptionBlockObject(category, slack.NewTextBlockObject(slack.PlainTextType, label, false, false), nil)
+		if category == defaultCategory {
+			initial = option
+		}
+		options = append(options, option)
+	}
+
+	categorySelect := slack.NewOptionsSelectBlockElement(slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, "Choose a category", false, false),
+		submissionCategoryAction, options...)
+	categorySelect.InitialOption = initial
+
+	contentInput := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, "What happened? Our journalists take it from here.", false, false),
+		submissionContentAction)
+	contentInput.Multiline = true
+
+	return slack.ModalViewRequest{
+		Type:       slack.VTModal,
+		CallbackID: submissionModalCallbackID,
+		Title:      slack.NewTextBlockObject(slack.PlainTextType, "Submit to the newsletter", false, false),
+		Submit:     slack.NewTextBlockObject(slack.PlainTextType, "Submit", false, false),
+		Close:      slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks: slack.Blocks{BlockSet: []slack.Block{
+			slack.NewInputBlock(submissionCategoryBlockID,
+				slack.NewTextBlockObject(slack.PlainTextType, "Category", false, false), nil, categorySelect),
+			slack.NewInputBlock(submissionContentBlockID,
+				slack.NewTextBlockObject(slack.PlainTextType, "Your story", false, false), nil, contentInput),
+		}},
+	}
+}
+
+// openSubmissionModal opens the guided submission form for the user who triggered it
+func (b *slackBot) openSubmissionModal(ctx context.Context, triggerID string) error {
+	modal := submissionModal(b.submissionCategories(), b.defaultCategory())
+	if _, err := b.slackClient().OpenViewContext(ctx, triggerID, modal); err != nil {
+		return fmt.Errorf("failed to open submission modal: %w", err)
+	}
+	return nil
+}
+
+// HandleViewSubmission creates a submission from the guided submission modal. Invalid input
+// is reported next to the field so the modal stays open; the usual submission reply is sent
+// as a DM since view submissions have no response URL.
+func (b *slackBot) HandleViewSubmission(ctx context.Context, callback slack.InteractionCallback) (*slack.ViewSubmissionResponse, error) {
+	if callback.View.CallbackID != submissionModalCallbackID {
+		loggerFor(ctx).Warn("Ignoring view submission for unknown view", "callback_id", callback.View.CallbackID)
+		return nil, nil
+	}
+
+	values := callback.View.State.Values
+	category := values[submissionCategoryBlockID][submissionCategoryAction].SelectedOption.Value
+	content := strings.TrimSpace(values[submissionContentBlockID][submissionContentAction].Value)
+
+	if !containsString(b.submissionCategories(), category) {
+		return slack.NewErrorsViewSubmissionResponse(map[string]string{
+			submissionCategoryBlockID: "Please choose one of the listed categories.",
+		}), nil
+	}
+	if length, ok := b.submissionLength(content); !ok {
+		return slack.NewErrorsViewSubmissionResponse(map[string]string{
+			submissionContentBlockID: fmt.Sprintf("Please keep it between %d and %d characters (currently %d).",
+				b.minSubmissionLength(), b.maxSubmissionLength(), length),
+		}), nil
+	}
+
+	userID := callback.User.ID
+	response, err := b.handleCategorizedSubmission(ctx, SlashCommand{
+		Command: "/pp",
+		Text:    fmt.Sprintf("submit %s %s", category, content),
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to handle modal submission: %w", err)
+	}
+
+	// Posting to a user ID lands in the DM with the bot
+	if err := b.SendMessage(ctx, userID, response.Text); err != nil {
+		loggerFor(ctx).Warn("Failed to DM modal submission reply", "user", userID, "error", err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
+)
+
+// viewSubmissionPayload builds the interactivity payload Slack posts when the submission modal is submitted
+func viewSubmissionPayload(userID, category, content string) string {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type": "view_submission",
+		"user": map[string]string{"id": userID},
+		"view": map[string]interface{}{
+			"callback_id": submissionModalCallbackID,
+			"state": map[string]interface{}{
+				"values": map[string]interface{}{
+					submissionCategoryBlockID: map[string]interface{}{
+						submissionCategoryAction: map[string]interface{}{
+							"type":            "static_select",
+							"selected_option": map[string]string{"value": category},
+						},
+					},
+					submissionContentBlockID: map[string]interface{}{
+						submissionContentAction: map[string]interface{}{
+							"type":  "plain_text_input",
+							"value": content,
+						},
+					},
+				},
+			},
+		},
+	})
+	return url.Values{"payload": {string(payload)}}.Encode()
+}
+
+// TDD: A bare /pp with a trigger_id opens the submission modal listing the enabled categories
+func TestBareCommandOpensSubmissionModal(t *testing.T) {
+	// views.open takes a JSON body
+	type openRequest struct {
+		TriggerID string          `json:"trigger_id"`
+		View      json.RawMessage `json:"view"`
+	}
+	opened := make(chan openRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/views.open" {
+			var request openRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			opened <- request
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	bot := NewBot(SlackConfig{Token: "test-token", SubmissionCategories: []string{"feature", "general"}}, nil, nil).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	response, err := bot.HandleSlashCommand(context.Background(), SlashCommand{Command: "/pp", UserID: "U111111111", TriggerID: "trigger-123"})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "Opening the submission form") {
+		t.Errorf("Expected modal acknowledgement, got: %s", response.Text)
+	}
+
+	var request openRequest
+	select {
+	case request = <-opened:
+	default:
+		t.Fatal("Expected views.open to be called")
+	}
+	if request.TriggerID != "trigger-123" {
+		t.Errorf("Expected the command's trigger_id, got %q", request.TriggerID)
+	}
+	view := string(request.View)
+	if !strings.Contains(view, submissionModalCallbackID) || !strings.Contains(view, `"value":"feature"`) || strings.Contains(view, `"value":"body_mind"`) {
+		t.Errorf("Expected a modal with only the enabled categories, got: %s", view)
+	}
+
+	// Without a trigger_id there is no modal to open, so help is shown as before
+	response, err = bot.HandleSlashCommand(context.Background(), SlashCommand{Command: "/pp", UserID: "U111111111"})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+	if strings.Contains(response.Text, "Opening the submission form") {
+		t.Errorf("Expected help without a trigger_id, got: %s", response.Text)
+	}
+}
+
+// TDD: Submitting the modal creates a submission in the chosen category and DMs the usual reply
+func TestViewSubmissionCreatesSubmission(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	var mu sync.Mutex
+	var dms []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/chat.postMessage" {
+			r.ParseForm()
+			mu.Lock()
+			dms = append(dms, r.Form.Get("channel")+": "+r.Form.Get("text"))
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	submissionManager := database.NewSubmissionManager(testDB.DB)
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, &MockQuestionSelector{}, nil, submissionManager, nil, testDB).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentID, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U111111111",
+		ContentType: database.ContentTypeFeature,
+		AssignedAt:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	handler := NewInteractionHandler(bot, "")
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/api/slack/interactive", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("TooShortKeepsModalOpen", func(t *testing.T) {
+		rr := post(viewSubmissionPayload("U111111111", "feature", "Hi"))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d", rr.Code)
+		}
+		var response slack.ViewSubmissionResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to decode response %q: %v", rr.Body.String(), err)
+		}
+		if response.ResponseAction != slack.RAErrors || response.Errors[submissionContentBlockID] == "" {
+			t.Errorf("Expected a field error on the content, got %+v", response)
+		}
+	})
+
+	t.Run("CreatesFeatureSubmission", func(t *testing.T) {
+		content := "Our team launched the new booking system\nand everyone can use it from Monday"
+		rr := post(viewSubmissionPayload("U111111111", "feature", content))
+		if rr.Code != http.StatusOK || rr.Body.Len() != 0 {
+			t.Fatalf("Expected an empty 200 closing the modal, got %d %q", rr.Code, rr.Body.String())
+		}
+
+		submissions, err := submissionManager.GetSubmissionsByUser(context.Background(), "U111111111")
+		if err != nil {
+			t.Fatalf("GetSubmissionsByUser failed: %v", err)
+		}
+		if len(submissions) != 1 || submissions[0].Content != content {
+			t.Fatalf("Expected the modal content to be stored, got %+v", submissions)
+		}
+
+		// The chosen category decides the assignment the submission answers
+		assignment, err := testDB.GetAssignmentBySubmissionID(submissions[0].ID)
+		if err != nil || assignment.ID != assignmentID {
+			t.Errorf("Expected the submission to answer the feature assignment %d, got %+v (err %v)", assignmentID, assignment, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(dms) != 1 || !strings.HasPrefix(dms[0], "U111111111: ") || !strings.Contains(dms[0], "Feature submission received") {
+			t.Errorf("Expected the submission reply as a DM, got %v", dms)
+		}
+	})
+
+	t.Run("RejectsUnknownCategory", func(t *testing.T) {
+		rr := post(viewSubmissionPayload("U111111111", "gossip", "A perfectly long enough story"))
+		if !strings.Contains(rr.Body.String(), submissionCategoryBlockID) {
+			t.Errorf("Expected a field error on the category, got %q", rr.Body.String())
+		}
+	})
+
+	t.Run("IgnoresOtherInteractions", func(t *testing.T) {
+		rr := post(url.Values{"payload": {fmt.Sprintf(`{"type": %q}`, slack.InteractionTypeBlockActions)}}.Encode())
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 for unhandled interactions, got %d", rr.Code)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/metrics"
+)
+
+// findBannedWord returns the first banned word or phrase found in content. Matching is
+// case-insensitive and on whole words, so "class" does not match a banned "ass".
+func findBannedWord(content string, bannedWords []string) (string, bool) {
+	normalized := " " + normalizeForModeration(content) + " "
+
+	for _, banned := range bannedWords {
+		phrase := normalizeForModeration(banned)
+		if phrase == "" {
+			continue
+		}
+		if strings.Contains(normalized, " "+phrase+" ") {
+			return banned, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeForModeration lowercases text and collapses everything but letters and digits into single spaces
+func normalizeForModeration(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// handleFlaggedSubmission stores a submission that hit the banned-word list, flags it for review
+// and notifies the admins instead of sending it to the AI
+func (b *slackBot) handleFlaggedSubmission(ctx context.Context, userID, category, content, bannedWord string) (*SlashCommandResponse, error) {
+	var submission *database.Submission
+	var err error
+
+	switch {
+	case category == "body_mind" && b.db != nil:
+		submission, err = b.db.CreateAnonymousSubmission(content, category)
+	case b.submissionManager != nil:
+		submission, err = b.submissionManager.CreateNewsSubmission(ctx, userID, content)
+	default:
+		return &SlashCommandResponse{
+			Text:         "❌ Submission storage not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Failed to store submission: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	metrics.SubmissionsReceived.WithLabelValues(category).Inc()
+
+	reason := fmt.Sprintf("contains banned word %q", bannedWord)
+	if b.db != nil {
+		if err := b.db.FlagSubmission(submission.ID, reason); err != nil {
+			loggerFor(ctx).Error("Failed to flag submission for review", "submission_id", submission.ID, "error", err)
+		}
+	}
+
+	loggerFor(ctx).Warn("Submission held for review",
+		"submission_id", submission.ID,
+		"category", category,
+		"reason", reason)
+
+	author := fmt.Sprintf("<@%s>", userID)
+	if category == "body_mind" {
+		author = "anonymous"
+	}
+	b.notifyAdminsOfFlaggedSubmission(ctx, submission.ID, category, author, content, reason)
+
+	return &SlashCommandResponse{
+		Text:         "📝 *Submission received*\n\nYour submission needs a quick review by an editor before it can be included in the newsletter. Thanks for your patience!",
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// notifyAdminsOfFlaggedSubmission sends every super admin a DM about a submission held for review
+func (b *slackBot) notifyAdminsOfFlaggedSubmission(ctx context.Context, submissionID int, category, author, content, reason string) {
+	if b.adminHandler == nil || b.adminHandler.broadcastManager == nil {
+		loggerFor(ctx).Warn("Cannot notify admins about flagged submission: messaging not available", "submission_id", submissionID)
+		return
+	}
+
+	message := fmt.Sprintf("🚩 *Submission #%d flagged for review*\n\n"+
+		"*Reason:* %s\n*Category:* %s\n*From:* %s\n\n> %s\n\n"+
+		"It was stored but not sent for AI processing. Use `admin rerun-submission %d` to process it, "+
+		"or `admin remove-submission` to discard it.",
+		submissionID, reason, category, author, content, submissionID)
+
+	for _, adminID := range b.adminHandler.adminUsers() {
+		if err := b.adminHandler.sendDirectMessage(ctx, adminID, message); err != nil {
+			loggerFor(ctx).Error("Failed to notify admin about flagged submission",
+				"admin_id", adminID, "submission_id", submissionID, "error", err)
+		}
+	}
+}
@@ -223,4 +223,12 @@ func (m *MockQuestionManager) DeleteQuestion(ctx context.Context, questionID int
 	return nil // Not needed for these tests
 }
 
+func (m *MockQuestionManager) GetQuestionUsageStats(ctx context.Context, category string) ([]database.QuestionUsageStat, error) {
+	return nil, nil // Not needed for these tests
+}
+
+func (m *MockQuestionManager) ResetCategoryRotation(ctx context.Context, category string) error {
+	return nil // Not needed for these tests
+}
+
 // MockSubmissionManager is defined in auto_processing_test.go
@@ -0,0 +1,67 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// DefaultSlackMaxRetries is how often a Slack API call is retried after a rate limit or server
+// error when SlackConfig.SlackMaxRetries is unset
+const DefaultSlackMaxRetries = 3
+
+// defaultSlackRetryDelay is the first backoff between Slack API retries, doubled on each further attempt
+const defaultSlackRetryDelay = 500 * time.Millisecond
+
+// retrySlackCall runs fn, retrying up to maxRetries times while it fails with a rate limit or a
+// 5xx from Slack. Rate-limited calls wait the Retry-After Slack asked for; others back off
+// exponentially from delay. It stops waiting as soon as ctx is done and returns the last error.
+func retrySlackCall(ctx context.Context, operation string, maxRetries int, delay time.Duration, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		wait, retryable := slackRetryWait(err, delay<<attempt)
+		if !retryable || attempt >= maxRetries {
+			return err
+		}
+
+		loggerFor(ctx).Warn("Retrying Slack API call",
+			"operation", operation,
+			"attempt", attempt+1,
+			"max_retries", maxRetries,
+			"wait", wait,
+			"error", err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// slackRetryWait reports whether err is worth retrying and how long to wait first: the
+// Retry-After of a rate limit, or backoff for server errors
+func slackRetryWait(err error, backoff time.Duration) (time.Duration, bool) {
+	var rateLimited *slack.RateLimitedError
+	if errors.As(err, &rateLimited) {
+		if rateLimited.RetryAfter > 0 {
+			return rateLimited.RetryAfter, true
+		}
+		return backoff, true
+	}
+
+	var statusErr slack.StatusCodeError
+	if errors.As(err, &statusErr) && statusErr.Retryable() {
+		return backoff, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,144 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// flakySlackServer answers the first failures requests with status (and Retry-After when set),
+// then succeeds with body. It counts every request it receives.
+func flakySlackServer(t *testing.T, failures int32, status int, retryAfter, body string) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// newRetryTestBot returns a bot talking to server with a short backoff
+func newRetryTestBot(server *httptest.Server, maxRetries int) *slackBot {
+	bot := NewBot(SlackConfig{Token: "test-token", SlackMaxRetries: maxRetries}, nil, nil).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+	bot.slackRetryDelay = 10 * time.Millisecond
+	return bot
+}
+
+// TDD: A rate-limited message is sent again once the Retry-After Slack asked for has passed
+func TestSendMessageRetriesAfterRateLimit(t *testing.T) {
+	server, calls := flakySlackServer(t, 1, http.StatusTooManyRequests, "1", `{"ok": true, "channel": "D123", "ts": "1.0"}`)
+	bot := newRetryTestBot(server, 0)
+
+	started := time.Now()
+	if err := bot.SendMessage(context.Background(), "D123", "Thanks for your story!"); err != nil {
+		t.Fatalf("Expected SendMessage to succeed after the rate limit, got: %v", err)
+	}
+
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+	if elapsed := time.Since(started); elapsed < time.Second {
+		t.Errorf("Expected the retry to wait for Retry-After (1s), waited %v", elapsed)
+	}
+}
+
+// TDD: Server errors are retried with backoff, and a profile lookup succeeds once Slack recovers
+func TestGetUserInfoRetriesServerErrors(t *testing.T) {
+	server, calls := flakySlackServer(t, 2, http.StatusServiceUnavailable, "",
+		`{"ok": true, "user": {"id": "U123", "name": "anna", "real_name": "Anna Andersson", "profile": {"title": "Design"}}}`)
+	bot := newRetryTestBot(server, 0)
+
+	info, err := bot.GetUserInfo(context.Background(), "U123")
+	if err != nil {
+		t.Fatalf("Expected GetUserInfo to succeed after server errors, got: %v", err)
+	}
+	if info.RealName != "Anna Andersson" {
+		t.Errorf("Expected the fetched profile, got %+v", info)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
+
+// TDD: Retries stop at the configured maximum, and errors Slack won't recover from aren't retried
+func TestSlackRetryLimits(t *testing.T) {
+	server, calls := flakySlackServer(t, 100, http.StatusInternalServerError, "", "")
+	bot := newRetryTestBot(server, 2)
+
+	if err := bot.SendMessage(context.Background(), "D123", "Hello"); err == nil {
+		t.Fatal("Expected SendMessage to fail when Slack keeps erroring")
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("Expected 1 request and 2 retries, got %d requests", got)
+	}
+
+	server, calls = flakySlackServer(t, 0, http.StatusOK, "", `{"ok": false, "error": "channel_not_found"}`)
+	bot = newRetryTestBot(server, 2)
+
+	if err := bot.SendMessage(context.Background(), "D123", "Hello"); err == nil {
+		t.Fatal("Expected SendMessage to fail for an unknown channel")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("Expected no retries for a Slack API error, got %d requests", got)
+	}
+}
+
+// TDD: Waiting for a long Retry-After ends as soon as the context is cancelled
+func TestSlackRetryStopsWhenContextIsDone(t *testing.T) {
+	server, _ := flakySlackServer(t, 100, http.StatusTooManyRequests, "30", "")
+	bot := newRetryTestBot(server, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	if err := bot.SendMessage(ctx, "D123", "Hello"); err == nil {
+		t.Fatal("Expected SendMessage to fail when the context ends")
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Errorf("Expected cancellation to cut the 30s wait short, took %v", elapsed)
+	}
+}
+
+// TDD: Broadcasts and other admin Slack calls retry as often as SLACK_MAX_RETRIES says, also after a reload
+func TestBroadcastManagerUsesConfiguredRetries(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	server, calls := flakySlackServer(t, 100, http.StatusInternalServerError, "", "")
+	bot := NewBotWithWeeklyAutomation(SlackConfig{Token: "test-token", SlackMaxRetries: 1}, nil, []string{"U999999999"}, nil, nil, db).(*slackBot)
+	bm := bot.adminHandler.broadcastManager
+	bm.client = slack.New("test-token", slack.OptionAPIURL(server.URL+"/"))
+	bm.retryDelay = 10 * time.Millisecond
+
+	if _, err := bm.getAllWorkspaceUsers(context.Background()); err == nil {
+		t.Fatal("Expected the user list to fail when Slack keeps erroring")
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("Expected 1 request and 1 retry, got %d requests", got)
+	}
+
+	bot.ReloadSettings(SlackConfig{Token: "test-token", SlackMaxRetries: 3}, []string{"U999999999"})
+	atomic.StoreInt32(calls, 0)
+	if _, err := bm.getAllWorkspaceUsers(context.Background()); err == nil {
+		t.Fatal("Expected the user list to fail when Slack keeps erroring")
+	}
+	if got := atomic.LoadInt32(calls); got != 4 {
+		t.Errorf("Expected 1 request and 3 retries after the reload, got %d requests", got)
+	}
+}
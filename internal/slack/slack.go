@@ -11,23 +11,44 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/ai"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/metrics"
 	"github.com/slack-go/slack"
 )
 
 type slackBot struct {
-	client            *slack.Client
+	client            *slack.Client // Created on first use by slackClient
+	clientOnce        sync.Once
+	clientOptions     []slack.Option // Extra options for the lazily created client, e.g. a test API URL
 	config            SlackConfig
 	adminHandler      *AdminHandler
 	submissionManager SubmissionManager
 	aiProcessor       AIProcessor
 	questionSelector  QuestionSelector
 	db                DatabaseInterface // Add database interface for testing
+	eventHandlers     map[EventType]EventHandlerFunc
+	seenEvents        eventDeduplicator // Drops Slack's retried deliveries of events already handled
+	seenSubmits       eventDeduplicator // Drops repeated submit commands carrying the same idempotency key
+	processingTimes   processingTimes   // Recent AI processing durations, for the ETA in submission replies
+	userInfos         userInfoCache     // Recently fetched Slack profiles, so repeat submitters don't hit Slack each time
+	configMu          sync.RWMutex      // Guards the config fields ReloadSettings swaps
+	clock             func() time.Time  // Current time for the submission window, time.Now when nil
+	slackRetryDelay   time.Duration     // First backoff between Slack API retries, defaultSlackRetryDelay when unset
+	jobs              sync.WaitGroup    // Background AI jobs, which Drain waits for on shutdown
+	onProcessed       func(int)         // Called with the submission ID each time async processing finishes, so tests can wait for it
 }
 
+// DefaultAITimeout bounds a single AI processing request when SlackConfig.AITimeout is unset
+const DefaultAITimeout = 30 * time.Second
+
+// aiTimedOutMessage is stored on articles whose AI processing hit the timeout
+const aiTimedOutMessage = "AI processing timed out"
+
 type QuestionSelector interface {
 	SelectNextQuestion(ctx context.Context, category string) (*database.Question, error)
 	MarkQuestionUsed(ctx context.Context, questionID int) error
@@ -35,13 +56,19 @@ type QuestionSelector interface {
 	AddQuestion(ctx context.Context, text, category string) (*database.Question, error)
 	GetQuestionByID(ctx context.Context, questionID int) (*database.Question, error)
 	DeleteQuestion(ctx context.Context, questionID int) error
+	GetQuestionUsageStats(ctx context.Context, category string) ([]database.QuestionUsageStat, error)
+	ResetCategoryRotation(ctx context.Context, category string) error
 }
 
 type SubmissionManager interface {
 	CreateNewsSubmission(ctx context.Context, userID, content string) (*database.Submission, error)
 	GetSubmissionsByUser(ctx context.Context, userID string) ([]database.Submission, error)
-	GetAllSubmissions(ctx context.Context) ([]database.Submission, error)
+	IterateSubmissions(ctx context.Context, fn func(database.Submission) error) error
+	IterateSubmissionsByDateRange(ctx context.Context, start, end time.Time, fn func(database.Submission) error) error
 	DeleteSubmission(ctx context.Context, id int) error
+	UpdateSubmissionContent(ctx context.Context, id int, userID, content string) error
+	GetUserSubmissionCounts(ctx context.Context, since time.Time) (map[string]int, error)
+	GetSubmissionsWithoutProcessedArticle(ctx context.Context) ([]database.Submission, error)
 }
 
 func NewBot(cfg SlackConfig, questionSelector QuestionSelector, adminUsers []string) Bot {
@@ -82,10 +109,20 @@ func NewBotWithAIProcessing(cfg SlackConfig, questionSelector QuestionSelector,
 
 // NewBotWithWeeklyAutomation creates a bot with full weekly automation capabilities
 func NewBotWithWeeklyAutomation(cfg SlackConfig, questionSelector QuestionSelector, adminUsers []string, submissionManager SubmissionManager, aiProcessor AIProcessor, db *database.DB) Bot {
+	adminHandler := NewAdminHandlerWithAI(questionSelector, adminUsers, submissionManager, db, cfg.Token, aiProcessor)
+	adminHandler.SetEditorUsers(cfg.EditorUsers)
+	adminHandler.SetAITimeout(cfg.AITimeout)
+	adminHandler.SetDebugErrors(cfg.DebugErrors)
+	adminHandler.SetSlackMaxRetries(cfg.SlackMaxRetries)
+	adminHandler.SetDefaultJournalist(cfg.DefaultJournalist)
+	if cfg.LowPoolThreshold > 0 {
+		adminHandler.EnableLowPoolBroadcast(cfg.LowPoolThreshold)
+	}
+
 	return &slackBot{
 		client:            nil,
 		config:            cfg,
-		adminHandler:      NewAdminHandlerWithAI(questionSelector, adminUsers, submissionManager, db, cfg.Token, aiProcessor),
+		adminHandler:      adminHandler,
 		submissionManager: submissionManager,
 		aiProcessor:       aiProcessor,
 		questionSelector:  questionSelector,
@@ -106,14 +143,93 @@ func NewBotWithDatabase(cfg SlackConfig, questionSelector QuestionSelector, admi
 	}
 }
 
-func (b *slackBot) SendMessage(ctx context.Context, channelID, text string) error {
-	// Initialize client only when actually needed
-	if b.client == nil {
-		b.client = slack.New(b.config.Token)
+// settings returns a snapshot of the bot configuration that is safe to read during a reload
+func (b *slackBot) settings() SlackConfig {
+	b.configMu.RLock()
+	defer b.configMu.RUnlock()
+	return b.config
+}
+
+// goBackground runs fn in a goroutine that Drain waits for
+func (b *slackBot) goBackground(fn func()) {
+	b.jobs.Add(1)
+	go func() {
+		defer b.jobs.Done()
+		fn()
+	}()
+}
+
+// Drain waits for background AI jobs, including admin reruns, to finish. It gives up
+// with an error when ctx is done first.
+func (b *slackBot) Drain(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		b.jobs.Wait()
+		if b.adminHandler != nil {
+			b.adminHandler.jobs.Wait()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("background jobs still running: %w", ctx.Err())
 	}
+}
 
-	_, _, err := b.client.PostMessageContext(ctx, channelID,
-		slack.MsgOptionText(text, false))
+// ReloadSettings swaps in the settings that can change without a restart: admin and editor
+// users, banned words, submission categories, length limits and window, the DM grace period,
+// Slack API retries and the AI timeout. The token, signing secret and low-pool threshold only take effect at startup.
+func (b *slackBot) ReloadSettings(cfg SlackConfig, adminUsers []string) {
+	b.configMu.Lock()
+	if cfg.Token != b.config.Token || cfg.SigningSecret != b.config.SigningSecret || cfg.LowPoolThreshold != b.config.LowPoolThreshold {
+		slog.Warn("Ignoring changes to Slack token, signing secret or low-pool threshold until restart")
+	}
+	b.config.EditorUsers = cfg.EditorUsers
+	b.config.AITimeout = cfg.AITimeout
+	b.config.BannedWords = cfg.BannedWords
+	b.config.SubmissionCategories = cfg.SubmissionCategories
+	b.config.DefaultCategory = cfg.DefaultCategory
+	b.config.DefaultJournalist = cfg.DefaultJournalist
+	b.config.MinSubmissionLength = cfg.MinSubmissionLength
+	b.config.MaxSubmissionLength = cfg.MaxSubmissionLength
+	b.config.DMReplyGracePeriod = cfg.DMReplyGracePeriod
+	b.config.SubmissionWindow = cfg.SubmissionWindow
+	b.config.SlackMaxRetries = cfg.SlackMaxRetries
+	b.config.DebugErrors = cfg.DebugErrors
+	b.configMu.Unlock()
+
+	if b.adminHandler != nil {
+		b.adminHandler.SetAdminUsers(adminUsers)
+		b.adminHandler.SetEditorUsers(cfg.EditorUsers)
+		b.adminHandler.SetAITimeout(cfg.AITimeout)
+		b.adminHandler.SetDebugErrors(cfg.DebugErrors)
+		b.adminHandler.SetSlackMaxRetries(cfg.SlackMaxRetries)
+		b.adminHandler.SetDefaultJournalist(cfg.DefaultJournalist)
+	}
+
+	slog.Info("Reloaded bot settings", "admins", len(adminUsers), "editors", len(cfg.EditorUsers))
+}
+
+// slackClient returns the Slack API client, creating it exactly once on first use
+// so concurrent event handlers never race on initialization
+func (b *slackBot) slackClient() *slack.Client {
+	b.clientOnce.Do(func() {
+		if b.client == nil {
+			b.client = slack.New(b.config.Token, b.clientOptions...)
+		}
+	})
+	return b.client
+}
+
+func (b *slackBot) SendMessage(ctx context.Context, channelID, text string) error {
+	err := b.retrySlack(ctx, "chat.postMessage", func() error {
+		_, _, err := b.slackClient().PostMessageContext(ctx, channelID,
+			slack.MsgOptionText(text, false))
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
@@ -121,6 +237,18 @@ func (b *slackBot) SendMessage(ctx context.Context, channelID, text string) erro
 }
 
 func (b *slackBot) HandleSlashCommand(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	// A bare /pp opens the guided submission form, falling back to help when it can't
+	if cmd.Text == "" && cmd.TriggerID != "" {
+		if err := b.openSubmissionModal(ctx, cmd.TriggerID); err != nil {
+			slog.Warn("Failed to open submission modal, showing help instead", "user", cmd.UserID, "error", err)
+			return b.handleRegularHelp(), nil
+		}
+		return &SlashCommandResponse{
+			Text:         "📝 Opening the submission form...",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
 	// Handle empty commands or help requests
 	if cmd.Text == "" || cmd.Text == "help" {
 		return b.handleRegularHelp(), nil
@@ -131,7 +259,7 @@ func (b *slackBot) HandleSlashCommand(ctx context.Context, cmd SlashCommand) (*S
 		adminCmd, err := parseAdminCommand(cmd.Text)
 		if err != nil {
 			return &SlashCommandResponse{
-				Text:         "Invalid admin command format. Type 'admin help' for admin usage or just 'help' for regular commands.",
+				Text:         fmt.Sprintf("❌ Invalid admin command: %v. Type 'admin help' for admin usage or just 'help' for regular commands.", err),
 				ResponseType: "ephemeral",
 			}, nil
 		}
@@ -139,9 +267,26 @@ func (b *slackBot) HandleSlashCommand(ctx context.Context, cmd SlashCommand) (*S
 		return b.adminHandler.HandleAdminCommand(ctx, cmd.UserID, adminCmd)
 	}
 
+	// Anonymous wellness questions go straight to the body/mind pool
+	if cmd.Text == "submit-wellness" || strings.HasPrefix(cmd.Text, "submit-wellness ") {
+		return b.handleIdempotentSubmit(ctx, cmd, b.handleWellnessSubmission)
+	}
+
 	// Handle news story submissions for regular users (unified submission system)
 	if strings.HasPrefix(cmd.Text, "submit ") {
-		return b.handleCategorizedSubmission(ctx, cmd)
+		return b.handleIdempotentSubmit(ctx, cmd, b.handleCategorizedSubmission)
+	}
+
+	if strings.HasPrefix(cmd.Text, "edit ") {
+		return b.handleEditSubmission(ctx, cmd)
+	}
+
+	if cmd.Text == "reroll" {
+		return b.handleReroll(ctx, cmd)
+	}
+
+	if strings.HasPrefix(cmd.Text, "recategorize ") {
+		return b.handleRecategorize(ctx, cmd)
 	}
 
 	// Handle regular newsletter functionality
@@ -151,20 +296,206 @@ func (b *slackBot) HandleSlashCommand(ctx context.Context, cmd SlashCommand) (*S
 	}, nil
 }
 
+// defaultEventHandlers are the event handlers every bot starts with
+var defaultEventHandlers = map[EventType]func(b *slackBot, ctx context.Context, event SlackEvent) error{
+	EventTypeMessage: (*slackBot).handleMessageEvent,
+}
+
+// RegisterEventHandler routes events of the given type to handler, replacing any existing handler
+func (b *slackBot) RegisterEventHandler(eventType EventType, handler EventHandlerFunc) {
+	if b.eventHandlers == nil {
+		b.eventHandlers = make(map[EventType]EventHandlerFunc)
+	}
+	b.eventHandlers[eventType] = handler
+}
+
 func (b *slackBot) HandleEventCallback(ctx context.Context, event SlackEvent) error {
 	// skip messages from bots to avoid infinite loops
 	if event.BotID != "" {
 		return nil
 	}
 
-	// Handle direct messages as potential assignment replies
-	if event.Type == "message" && event.Text != "" {
-		// Check if this is a direct message (channel starts with "D")
-		if strings.HasPrefix(event.Channel, "D") && event.User != "" {
-			return b.handleDirectMessageReply(ctx, event)
+	if b.seenEvents.seen(eventDedupKey(event), time.Now()) {
+		slog.Info("Ignoring duplicate Slack event delivery", "event_id", event.EventID, "client_msg_id", event.ClientMsgID)
+		return nil
+	}
+
+	var err error
+	eventType := EventType(event.Type)
+	if handler, ok := b.eventHandlers[eventType]; ok {
+		err = handler(ctx, event)
+	} else if handler, ok := defaultEventHandlers[eventType]; ok {
+		err = handler(b, ctx, event)
+	} else {
+		slog.Debug("Ignoring unhandled Slack event", "type", event.Type)
+	}
+
+	// The key is recorded up front so concurrent retries are dropped, but a failed event has
+	// to be forgotten again so Slack's next retry gets handled
+	if err != nil {
+		b.seenEvents.forget(eventDedupKey(event))
+	}
+	return err
+}
+
+// duplicateSubmissionText answers a submission that was already received
+const duplicateSubmissionText = "👍 We already received this submission a moment ago - no need to send it twice!"
+
+// handleIdempotentSubmit runs a submit command unless one with the same idempotency key was
+// already handled for the user, e.g. when Slack retries a slash command it thinks timed out
+func (b *slackBot) handleIdempotentSubmit(ctx context.Context, cmd SlashCommand, submit func(context.Context, SlashCommand) (*SlashCommandResponse, error)) (*SlashCommandResponse, error) {
+	key := submitDedupKey(cmd)
+	if b.seenSubmits.seen(key, time.Now()) {
+		loggerFor(ctx).Info("Ignoring repeated submit", "user", cmd.UserID, "idempotency_key", cmd.IdempotencyKey)
+		return &SlashCommandResponse{
+			Text:         duplicateSubmissionText,
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// As with events, a failed submit is forgotten so a retry gets another chance
+	response, err := submit(ctx, cmd)
+	if err != nil {
+		b.seenSubmits.forget(key)
+	}
+	return response, err
+}
+
+// submitDedupKey scopes a submit's idempotency key to its user, so keys from different clients can't collide
+func submitDedupKey(cmd SlashCommand) string {
+	if cmd.IdempotencyKey == "" {
+		return ""
+	}
+	return "submit:" + cmd.UserID + ":" + cmd.IdempotencyKey
+}
+
+// eventDedupTTL is how long a handled event ID is remembered; Slack retries within minutes
+const eventDedupTTL = 10 * time.Minute
+
+// eventDeduplicator remembers recently handled event IDs. The zero value is ready to use.
+type eventDeduplicator struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	handled map[string]time.Time
+}
+
+// seen records key and reports whether it was already recorded within the TTL.
+// Empty keys are never treated as duplicates.
+func (d *eventDeduplicator) seen(key string, now time.Time) bool {
+	if key == "" {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ttl := d.ttl
+	if ttl == 0 {
+		ttl = eventDedupTTL
+	}
+	if d.handled == nil {
+		d.handled = make(map[string]time.Time)
+	}
+
+	// Drop expired entries so the cache stays small
+	for k, at := range d.handled {
+		if now.Sub(at) > ttl {
+			delete(d.handled, k)
 		}
 	}
 
+	if _, ok := d.handled[key]; ok {
+		return true
+	}
+	d.handled[key] = now
+	return false
+}
+
+// forget removes key, so a later delivery of the same event is handled again
+func (d *eventDeduplicator) forget(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.handled, key)
+}
+
+// processingTimesWindow is how many recent AI processing durations the ETA averages over
+const processingTimesWindow = 10
+
+// processingTimes keeps a rolling window of recent AI processing durations. The zero value is ready to use.
+type processingTimes struct {
+	mu      sync.Mutex
+	samples []time.Duration
+}
+
+// record adds a duration, dropping the oldest once the window is full
+func (p *processingTimes) record(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.samples = append(p.samples, d)
+	if len(p.samples) > processingTimesWindow {
+		p.samples = p.samples[len(p.samples)-processingTimesWindow:]
+	}
+}
+
+// average returns the mean of the recorded durations, or false when nothing has been recorded yet
+func (p *processingTimes) average() (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.samples) == 0 {
+		return 0, false
+	}
+	var total time.Duration
+	for _, d := range p.samples {
+		total += d
+	}
+	return total / time.Duration(len(p.samples)), true
+}
+
+// processingETA describes how long AI processing usually takes, e.g. " (usually ready in ~8 seconds)".
+// It is empty until a submission has been processed.
+func (b *slackBot) processingETA() string {
+	avg, ok := b.processingTimes.average()
+	if !ok {
+		return ""
+	}
+
+	seconds := int(avg.Round(time.Second) / time.Second)
+	switch {
+	case seconds < 1:
+		seconds = 1
+	case seconds >= 120:
+		return fmt.Sprintf(" (usually ready in ~%d minutes)", (seconds+30)/60)
+	}
+	if seconds == 1 {
+		return " (usually ready in ~1 second)"
+	}
+	return fmt.Sprintf(" (usually ready in ~%d seconds)", seconds)
+}
+
+// eventDedupKey identifies an event across retries, preferring the envelope event ID
+func eventDedupKey(event SlackEvent) string {
+	if event.EventID != "" {
+		return "event:" + event.EventID
+	}
+	if event.ClientMsgID != "" {
+		return "msg:" + event.ClientMsgID
+	}
+	return ""
+}
+
+// handleMessageEvent treats direct messages as potential assignment replies
+func (b *slackBot) handleMessageEvent(ctx context.Context, event SlackEvent) error {
+	if event.Text == "" {
+		return nil
+	}
+
+	// Check if this is a direct message (channel starts with "D")
+	if strings.HasPrefix(event.Channel, "D") && event.User != "" {
+		return b.handleDirectMessageReply(ctx, event)
+	}
+
 	return nil
 }
 
@@ -177,6 +508,12 @@ func (b *slackBot) handleDirectMessageReply(ctx context.Context, event SlackEven
 		return b.SendMessage(ctx, event.Channel, "Please provide some content for your submission.")
 	}
 
+	// A bare "reroll" asks for a different question rather than submitting the word
+	if strings.EqualFold(content, "reroll") {
+		message, _ := b.rerollQuestion(ctx, userID)
+		return b.SendMessage(ctx, event.Channel, message)
+	}
+
 	// Look up user's active assignments
 	if b.db == nil {
 		return b.SendMessage(ctx, event.Channel, "❌ Assignment lookup not available (database not configured)")
@@ -198,8 +535,29 @@ func (b *slackBot) handleDirectMessageReply(ctx context.Context, event SlackEven
 		return b.SendMessage(ctx, event.Channel, "❌ Failed to look up your assignments. Please try using the `/pp submit` command instead.")
 	}
 
+	// Early in the week a reply may still be answering last week's assignment
+	if !hasOpenAssignment(assignments) {
+		if previous := b.previousWeekOpenAssignment(userID, now); previous != nil {
+			slog.Info("Linking DM reply to previous week's open assignment",
+				"user", userID, "assignment_id", previous.ID)
+			simulatedCmd := SlashCommand{
+				Command:   "/pp",
+				Text:      fmt.Sprintf("submit %s %s", contentTypeToSubmissionCategory(previous.ContentType), content),
+				UserID:    userID,
+				ChannelID: event.Channel,
+				Files:     event.Files,
+			}
+			response, err := b.handleCategorizedSubmissionFor(ctx, simulatedCmd, previous)
+			if err != nil {
+				slog.Error("Failed to process DM submission", "user", userID, "error", err)
+				return b.SendMessage(ctx, event.Channel, "❌ Failed to process your submission. Please try again or use the `/pp submit` command.")
+			}
+			return b.confirmDMSubmission(ctx, event.Channel, response.Text)
+		}
+	}
+
 	if len(assignments) == 0 {
-		return b.SendMessage(ctx, event.Channel, "You don't have any active newsletter assignments this week. Use `/pp submit general \"your content\"` to submit general news.")
+		return b.SendMessage(ctx, event.Channel, b.noAssignmentHelp())
 	}
 
 	if len(assignments) > 1 {
@@ -218,6 +576,7 @@ func (b *slackBot) handleDirectMessageReply(ctx context.Context, event SlackEven
 		Text:      fmt.Sprintf("submit %s %s", category, content),
 		UserID:    userID,
 		ChannelID: event.Channel,
+		Files:     event.Files,
 	}
 
 	// Process through existing categorized submission handler
@@ -228,7 +587,48 @@ func (b *slackBot) handleDirectMessageReply(ctx context.Context, event SlackEven
 	}
 
 	// Send the response as a regular message instead of slash command response
-	return b.SendMessage(ctx, event.Channel, response.Text)
+	return b.confirmDMSubmission(ctx, event.Channel, response.Text)
+}
+
+// confirmDMSubmission replies to a DM submission. The submission is stored by then, so a failed
+// reply is only logged: failing the event would let Slack's retry store it a second time.
+func (b *slackBot) confirmDMSubmission(ctx context.Context, channel, text string) error {
+	if err := b.SendMessage(ctx, channel, text); err != nil {
+		loggerFor(ctx).Warn("Failed to confirm DM submission", "channel", channel, "error", err)
+	}
+	return nil
+}
+
+// hasOpenAssignment reports whether any of the assignments still awaits a submission
+func hasOpenAssignment(assignments []database.PersonAssignment) bool {
+	for _, assignment := range assignments {
+		if assignment.SubmissionID == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// previousWeekOpenAssignment returns the user's unsubmitted assignment from the previous ISO
+// week when now falls within the configured grace period after that week ended, nil otherwise
+func (b *slackBot) previousWeekOpenAssignment(userID string, now time.Time) *database.PersonAssignment {
+	gracePeriod := b.settings().DMReplyGracePeriod
+	if gracePeriod <= 0 {
+		return nil
+	}
+
+	daysSinceMonday := (int(now.Weekday()) + 6) % 7
+	weekStart := time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, now.Location())
+	if now.Sub(weekStart) > gracePeriod {
+		return nil
+	}
+
+	year, week := weekStart.AddDate(0, 0, -7).ISOWeek()
+	assignment, err := b.db.GetAssignmentForUserInWeek(userID, week, year)
+	if err != nil {
+		return nil
+	}
+	return assignment
 }
 
 // contentTypeToSubmissionCategory maps database ContentType to submission category
@@ -238,6 +638,8 @@ func contentTypeToSubmissionCategory(contentType database.ContentType) string {
 		return "feature"
 	case database.ContentTypeGeneral:
 		return "general"
+	case database.ContentTypeInterview:
+		return "interview"
 	case database.ContentTypeBodyMind:
 		return "body_mind"
 	default:
@@ -252,6 +654,8 @@ func contentTypeToJournalistType(contentType database.ContentType) string {
 		return "feature"
 	case database.ContentTypeGeneral:
 		return "general"
+	case database.ContentTypeInterview:
+		return "interview"
 	case database.ContentTypeBodyMind:
 		return "body_mind"
 	default:
@@ -259,6 +663,33 @@ func contentTypeToJournalistType(contentType database.ContentType) string {
 	}
 }
 
+// submissionCategoryHelp describes each submission category in help messages
+var submissionCategoryHelp = map[string]string{
+	"feature":   "Major features, launches, or product announcements",
+	"general":   "Regular news, updates, interesting links, or team updates",
+	"interview": "Q&A format content, interviews, or conversation pieces",
+	"body_mind": "Wellness content (submitted anonymously for privacy)",
+}
+
+// noAssignmentHelp is the DM reply for users without an assignment this week, explaining
+// how to contribute anyway with the categories that are enabled
+func (b *slackBot) noAssignmentHelp() string {
+	var help strings.Builder
+	help.WriteString("You don't have an active newsletter assignment this week, so I couldn't file this message - but you can still contribute!\n\n")
+	help.WriteString("*📝 Submit with the slash command:*\n")
+	help.WriteString("`/pp submit [category] \"your content\"`\n\n")
+	help.WriteString("*Categories:*\n")
+	for _, category := range b.submissionCategories() {
+		help.WriteString(fmt.Sprintf("• `%s` - %s\n", category, submissionCategoryHelp[category]))
+	}
+	help.WriteString(fmt.Sprintf("\nLeaving out the category submits it as `%s`, e.g. `/pp submit \"Found this great Go performance article\"`\n", b.defaultCategory()))
+	if containsString(b.submissionCategories(), "body_mind") {
+		help.WriteString("🧘 Wellness questions sent with `/pp submit body_mind \"...\"` are stored anonymously - your name is never attached.\n")
+	}
+	help.WriteString("\nType `/pp help` for everything the bot can do.")
+	return help.String()
+}
+
 func (b *slackBot) handleRegularHelp() *SlashCommandResponse {
 	help := "*Newsletter Bot Help*\n\n" +
 		"This bot helps manage weekly newsletter content collection and AI-powered article generation.\n\n" +
@@ -289,28 +720,37 @@ func (b *slackBot) handleRegularHelp() *SlashCommandResponse {
 		"• **Real-time Feedback**: Instant confirmation when processing completes\n\n" +
 		"*⌨️ Available Commands:*\n" +
 		"• `/pp help` - Show this comprehensive help message\n" +
+		"• `/pp` - Open a form that guides you through a submission\n" +
+		"• `/pp submit-wellness \"your question\" [wellness|mental_health|work_life_balance]` - Anonymously add a question to the body/mind pool\n" +
+		"• `/pp edit submission_id \"new content\"` - Fix one of your submissions; it is re-processed automatically\n" +
+		"• `/pp recategorize submission_id category` - Move one of your submissions to your open assignment of another category\n" +
+		"• `/pp reroll` - Swap this week's assignment question for another one (or reply `reroll` to the assignment DM)\n" +
 		"• `/pp admin help` - Show admin commands (authorized users only)\n\n" +
 		"*👥 For Admins:*\n" +
 		"Admin users can manage questions, view submissions, assign weekly content, check pool status, and broadcast requests."
 
-	return &SlashCommandResponse{
+	return withBlocks(&SlashCommandResponse{
 		Text:         help,
 		ResponseType: "ephemeral",
-	}
+	})
 }
 
 func (b *slackBot) GetUserInfo(ctx context.Context, userID string) (*UserInfo, error) {
-	// Initialize client only when actually needed
-	if b.client == nil {
-		b.client = slack.New(b.config.Token)
+	if info, ok := b.userInfos.get(userID, time.Now()); ok {
+		return info, nil
 	}
 
-	user, err := b.client.GetUserInfoContext(ctx, userID)
+	var user *slack.User
+	err := b.retrySlack(ctx, "users.info", func() error {
+		var err error
+		user, err = b.slackClient().GetUserInfoContext(ctx, userID)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user info: %w", err)
 	}
 
-	return &UserInfo{
+	info := &UserInfo{
 		ID:       user.ID,
 		Name:     user.Name,
 		RealName: user.RealName,
@@ -321,7 +761,58 @@ func (b *slackBot) GetUserInfo(ctx context.Context, userID string) (*UserInfo, e
 			FirstName: user.Profile.FirstName,
 			LastName:  user.Profile.LastName,
 		},
-	}, nil
+	}
+	b.userInfos.put(userID, info, time.Now())
+
+	return info, nil
+}
+
+// userInfoCacheTTL is how long a fetched Slack profile is reused; short so profile edits show up soon
+const userInfoCacheTTL = 5 * time.Minute
+
+// userInfoCache keeps recently fetched Slack profiles by user ID. The zero value is ready to use.
+type userInfoCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cachedUserInfo
+}
+
+type cachedUserInfo struct {
+	info      UserInfo
+	fetchedAt time.Time
+}
+
+// get returns a copy of the cached profile, or false when it is missing or older than the TTL
+func (c *userInfoCache) get(userID string, now time.Time) (*UserInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ttl := c.ttl
+	if ttl == 0 {
+		ttl = userInfoCacheTTL
+	}
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, false
+	}
+	if now.Sub(entry.fetchedAt) > ttl {
+		delete(c.entries, userID)
+		return nil, false
+	}
+	info := entry.info
+	return &info, true
+}
+
+// put stores a copy of info, so callers modifying their UserInfo don't change the cache
+func (c *userInfoCache) put(userID string, info *UserInfo, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]cachedUserInfo)
+	}
+	c.entries[userID] = cachedUserInfo{info: *info, fetchedAt: now}
 }
 
 func (b *slackBot) EnrichSubmissionWithUserInfo(ctx context.Context, userID, content string) (*EnrichedSubmission, error) {
@@ -349,6 +840,8 @@ func (b *slackBot) EnrichSubmissionWithUserInfo(ctx context.Context, userID, con
 }
 
 func (b *slackBot) handleNewsSubmission(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	ctx = withCorrelationID(ctx)
+
 	// Extract the news content (everything after "submit ")
 	newsContent := strings.TrimSpace(strings.TrimPrefix(cmd.Text, "submit "))
 
@@ -372,6 +865,13 @@ func (b *slackBot) handleNewsSubmission(ctx context.Context, cmd SlashCommand) (
 				ResponseType: "ephemeral",
 			}, nil
 		}
+		if submission.Duplicate {
+			return &SlashCommandResponse{
+				Text:         duplicateSubmissionText,
+				ResponseType: "ephemeral",
+			}, nil
+		}
+		metrics.SubmissionsReceived.WithLabelValues("general").Inc()
 		responseText = fmt.Sprintf("📰 *News submission received!*\n\n> %s\n\n", newsContent)
 	} else {
 		responseText = fmt.Sprintf("📰 *News submission received!*\n\n> %s\n\n", newsContent)
@@ -379,10 +879,12 @@ func (b *slackBot) handleNewsSubmission(ctx context.Context, cmd SlashCommand) (
 
 	// Launch async AI processing if AIProcessor is available
 	if b.aiProcessor != nil && submission != nil {
-		responseText += "🤖 Processing with AI in the background...\n"
+		responseText += "🤖 Processing with AI in the background" + b.processingETA() + "...\n"
 
 		// Launch goroutine for async processing
-		go b.processSubmissionAsync(context.Background(), *submission, cmd.UserID, cmd.ResponseURL)
+		b.goBackground(func() {
+			b.processSubmissionAsync(context.WithoutCancel(ctx), *submission, cmd.UserID, cmd.ResponseURL)
+		})
 	}
 
 	responseText += "✅ Thanks for contributing!"
@@ -393,7 +895,8 @@ func (b *slackBot) handleNewsSubmission(ctx context.Context, cmd SlashCommand) (
 	}, nil
 }
 
-// determineJournalistTypeFromSubmission determines journalist type based on question category
+// determineJournalistTypeFromSubmission determines journalist type from the question category, then the
+// linked assignment, falling back to the configured default journalist
 func (b *slackBot) determineJournalistTypeFromSubmission(ctx context.Context, submission *database.Submission) string {
 	// First priority: If submission has a question ID, use question category
 	if submission.QuestionID != nil {
@@ -416,16 +919,32 @@ func (b *slackBot) determineJournalistTypeFromSubmission(ctx context.Context, su
 		}
 	}
 
-	// Fallback: Default to general for unlinked submissions
-	return "general"
+	// Anonymous submissions are body/mind pieces even without an assignment
+	if submission.UserID == "" {
+		return "body_mind"
+	}
+
+	// Fallback: the configured journalist for unlinked submissions
+	return b.defaultJournalist()
 }
 
 // determineJournalistType is deprecated - use determineJournalistTypeFromSubmission instead
 
 // processSubmissionAsync handles AI processing in the background
 func (b *slackBot) processSubmissionAsync(ctx context.Context, submission database.Submission, userID string, responseURL string) {
+	if b.onProcessed != nil {
+		defer b.onProcessed(submission.ID)
+	}
+
+	// Submissions received without a correlation ID (edits, reruns) get their own here
+	ctx = withCorrelationID(ctx)
+	logger := loggerFor(ctx)
+
+	metrics.AIJobsInFlight.Inc()
+	defer metrics.AIJobsInFlight.Dec()
+
 	// Log start of processing
-	slog.Info("Starting async AI processing",
+	logger.Info("Starting async AI processing",
 		"submission_id", submission.ID,
 		"user_id", userID)
 
@@ -437,13 +956,13 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 	authorDepartment := "Unknown"
 
 	if err != nil {
-		slog.Warn("Using fallback user info for async processing",
+		logger.Warn("Using fallback user info for async processing",
 			"error", err,
 			"submission_id", submission.ID)
 	} else {
 		authorName = enrichedSubmission.AuthorName
 		authorDepartment = enrichedSubmission.AuthorDepartment
-		slog.Info("Successfully enriched submission with user info",
+		logger.Info("Successfully enriched submission with user info",
 			"author_name", authorName,
 			"author_department", authorDepartment,
 			"submission_id", submission.ID)
@@ -452,15 +971,24 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 	// Determine journalist type from question category
 	journalistType := b.determineJournalistTypeFromSubmission(ctx, &submission)
 
-	// Get current newsletter issue for auto-assignment
+	// A submission linked to an assignment belongs to that assignment's issue, which is last week's
+	// for a late reply; everything else goes into the current newsletter issue
 	var newsletterIssueID *int
 	if b.db != nil {
+		if assignment, err := b.db.GetAssignmentBySubmissionID(submission.ID); err == nil && assignment != nil {
+			newsletterIssueID = &assignment.IssueID
+			logger.Info("Using linked assignment's newsletter issue",
+				"newsletter_issue_id", assignment.IssueID,
+				"assignment_id", assignment.ID)
+		}
+	}
+	if b.db != nil && newsletterIssueID == nil {
 		now := time.Now()
 		year, week := now.ISOWeek()
 
 		issue, err := b.db.GetOrCreateWeeklyIssue(week, year)
 		if err != nil {
-			slog.Error("Failed to get/create weekly newsletter issue for auto-assignment",
+			logger.Error("Failed to get/create weekly newsletter issue for auto-assignment",
 				"error", err,
 				"week", week,
 				"year", year,
@@ -468,7 +996,7 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 			// Continue without newsletter assignment
 		} else {
 			newsletterIssueID = &issue.ID
-			slog.Info("Retrieved newsletter issue for auto-assignment",
+			logger.Info("Retrieved newsletter issue for auto-assignment",
 				"newsletter_issue_id", issue.ID,
 				"week", week,
 				"year", year)
@@ -479,13 +1007,18 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 	// Get underlying *database.DB from the interface
 	dbPtr := b.db.GetUnderlyingDB()
 	if dbPtr == nil {
-		slog.Error("Database interface does not provide underlying DB", "submission_id", submission.ID)
-		b.sendFollowupMessage(responseURL, "❌ Internal error: database not available")
+		logger.Error("Database interface does not provide underlying DB", "submission_id", submission.ID)
+		b.sendFollowupMessage(ctx, responseURL, "❌ Internal error: database not available")
 		return
 	}
 
+	// Bound the AI call so a slow API can't leave this goroutine hanging
+	aiCtx, cancel := context.WithTimeout(ctx, b.aiTimeout())
+	defer cancel()
+
+	started := time.Now()
 	err = b.aiProcessor.ProcessAndSaveSubmission(
-		ctx,
+		aiCtx,
 		dbPtr,             // Database connection
 		submission,        // Submission to process
 		authorName,        // Author name
@@ -493,21 +1026,46 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 		journalistType,    // Journalist type
 		newsletterIssueID, // Newsletter issue ID for auto-assignment
 	)
+	metrics.RecordAIResult(err)
+
+	if err != nil && aiCtx.Err() == context.DeadlineExceeded {
+		logger.Warn("AI processing timed out",
+			"submission_id", submission.ID,
+			"journalist_type", journalistType,
+			"timeout", b.aiTimeout())
+
+		b.recordTimedOutArticle(ctx, submission, journalistType, newsletterIssueID)
+		// Nothing retries a timed out submission automatically; the dead-letter list is where editors rerun it
+		recordDeadLetter(ctx, dbPtr, submission.ID, aiTimedOutMessage, ai.AttemptsOf(err))
+		b.sendFollowupMessage(ctx, responseURL, "⏳ AI processing took longer than expected. Your submission is saved and an editor will re-run it - no need to resend it!")
+		return
+	}
 
 	if err != nil {
 		// Log error - processing failed
-		slog.Error("ProcessAndSaveSubmission failed",
+		logger.Error("ProcessAndSaveSubmission failed",
 			"error", err,
 			"submission_id", submission.ID,
 			"journalist_type", journalistType)
 
+		// The AI service has already retried, so keep the submission for manual reprocessing
+		recordDeadLetter(ctx, dbPtr, submission.ID, err.Error(), ai.AttemptsOf(err))
+
 		// Send failure notification to user via response_url
-		b.sendFollowupMessage(responseURL, fmt.Sprintf("❌ AI processing failed: %v", err))
+		b.sendFollowupMessage(ctx, responseURL, fmt.Sprintf("❌ AI processing failed: %v", err))
 		return
 	}
 
+	// Only successful runs feed the ETA; timeouts and failures would skew it
+	b.processingTimes.record(time.Since(started))
+
+	// A submission processed after an earlier failure is no longer dead
+	if err := dbPtr.DeleteDeadLetter(submission.ID); err != nil {
+		logger.Warn("Failed to clear dead letter", "submission_id", submission.ID, "error", err)
+	}
+
 	// Success! Article has been processed AND saved to database with newsletter assignment
-	slog.Info("ProcessAndSaveSubmission completed successfully",
+	logger.Info("ProcessAndSaveSubmission completed successfully",
 		"submission_id", submission.ID,
 		"journalist_type", journalistType,
 		"newsletter_issue_id", newsletterIssueID)
@@ -516,13 +1074,143 @@ func (b *slackBot) processSubmissionAsync(ctx context.Context, submission databa
 	message := fmt.Sprintf("🤖 ✅ Your submission has been processed by our %s journalist and added to the newsletter!\n\n_Processing completed in the background_",
 		journalistType)
 
-	b.sendFollowupMessage(responseURL, message)
+	b.sendFollowupMessage(ctx, responseURL, message)
+}
+
+// submissionCategories returns the categories `submit` accepts, falling back to all of them
+func (b *slackBot) submissionCategories() []string {
+	cfg := b.settings()
+	if len(cfg.SubmissionCategories) > 0 {
+		return cfg.SubmissionCategories
+	}
+	return submissionCategories
+}
+
+// defaultCategory returns the category for submissions that name none, falling back to DefaultSubmissionCategory
+func (b *slackBot) defaultCategory() string {
+	cfg := b.settings()
+	if cfg.DefaultCategory != "" {
+		return cfg.DefaultCategory
+	}
+	return DefaultSubmissionCategory
 }
 
-// sendFollowupMessage sends a follow-up message to Slack using the response_url
-func (b *slackBot) sendFollowupMessage(responseURL string, message string) {
+// defaultJournalist returns the journalist for submissions with neither a question nor an
+// assignment, falling back to DefaultJournalistType
+func (b *slackBot) defaultJournalist() string {
+	cfg := b.settings()
+	if cfg.DefaultJournalist != "" {
+		return cfg.DefaultJournalist
+	}
+	return DefaultJournalistType
+}
+
+// minSubmissionLength returns the configured minimum submission length, falling back to DefaultMinSubmissionLength
+func (b *slackBot) minSubmissionLength() int {
+	cfg := b.settings()
+	if cfg.MinSubmissionLength > 0 {
+		return cfg.MinSubmissionLength
+	}
+	return DefaultMinSubmissionLength
+}
+
+// maxSubmissionLength returns the configured maximum submission length, falling back to DefaultMaxSubmissionLength
+func (b *slackBot) maxSubmissionLength() int {
+	cfg := b.settings()
+	if cfg.MaxSubmissionLength > 0 {
+		return cfg.MaxSubmissionLength
+	}
+	return DefaultMaxSubmissionLength
+}
+
+// submissionLength returns the length of content in characters and whether it is within the
+// configured submission length limits
+func (b *slackBot) submissionLength(content string) (int, bool) {
+	length := utf8.RuneCountInString(content)
+	return length, length >= b.minSubmissionLength() && length <= b.maxSubmissionLength()
+}
+
+// now returns the current time in the publication time zone
+func (b *slackBot) now() time.Time {
+	now := time.Now()
+	if b.clock != nil {
+		now = b.clock()
+	}
+	if b.db != nil {
+		if db := b.db.GetUnderlyingDB(); db != nil {
+			return now.In(db.Location())
+		}
+	}
+	return now.UTC()
+}
+
+// retrySlack runs a Slack API call, retrying rate limits and server errors as configured
+func (b *slackBot) retrySlack(ctx context.Context, operation string, fn func() error) error {
+	maxRetries := b.settings().SlackMaxRetries
+	if maxRetries <= 0 {
+		maxRetries = DefaultSlackMaxRetries
+	}
+	delay := b.slackRetryDelay
+	if delay <= 0 {
+		delay = defaultSlackRetryDelay
+	}
+	return retrySlackCall(ctx, operation, maxRetries, delay, fn)
+}
+
+// aiTimeout returns the configured AI request timeout, falling back to DefaultAITimeout
+func (b *slackBot) aiTimeout() time.Duration {
+	cfg := b.settings()
+	if cfg.AITimeout > 0 {
+		return cfg.AITimeout
+	}
+	return DefaultAITimeout
+}
+
+// recordTimedOutArticle stores a failed article for a timed-out submission so it can be retried later
+func (b *slackBot) recordTimedOutArticle(ctx context.Context, submission database.Submission, journalistType string, newsletterIssueID *int) {
+	templateFormat := "column"
+	if profile, err := ai.GetJournalistProfile(journalistType); err == nil {
+		templateFormat = profile.TemplateFormat
+	}
+
+	errorMessage := aiTimedOutMessage
+	articleID, err := b.db.CreateProcessedArticle(database.ProcessedArticle{
+		SubmissionID:      submission.ID,
+		NewsletterIssueID: newsletterIssueID,
+		JournalistType:    journalistType,
+		TemplateFormat:    templateFormat,
+		ProcessingStatus:  database.ProcessingStatusFailed,
+		ErrorMessage:      &errorMessage,
+	})
+	if err != nil {
+		loggerFor(ctx).Error("Failed to record timed out article",
+			"error", err,
+			"submission_id", submission.ID)
+		return
+	}
+
+	loggerFor(ctx).Info("Recorded timed out article for retry",
+		"processed_article_id", articleID,
+		"submission_id", submission.ID)
+}
+
+// recordDeadLetter keeps a submission whose processing failed for good in the dead-letter table,
+// with the number of AI requests that were made for it
+func recordDeadLetter(ctx context.Context, db *database.DB, submissionID int, lastError string, attempts int) {
+	if err := db.RecordDeadLetter(submissionID, lastError, attempts); err != nil {
+		loggerFor(ctx).Error("Failed to record dead letter", "submission_id", submissionID, "error", err)
+		return
+	}
+	loggerFor(ctx).Warn("Submission dead-lettered after failed processing", "submission_id", submissionID, "attempts", attempts)
+}
+
+// sendFollowupMessage sends a follow-up message to Slack using the response_url,
+// attaching the context's correlation ID as message metadata
+func (b *slackBot) sendFollowupMessage(ctx context.Context, responseURL string, message string) {
+	logger := loggerFor(ctx)
+
 	if responseURL == "" {
-		slog.Warn("No response URL provided for follow-up message")
+		logger.Warn("No response URL provided for follow-up message")
 		return
 	}
 
@@ -531,29 +1219,35 @@ func (b *slackBot) sendFollowupMessage(responseURL string, message string) {
 		"text":          message,
 		"response_type": "ephemeral",
 	}
+	if id := correlationID(ctx); id != "" {
+		payload["metadata"] = map[string]interface{}{
+			"event_type":    "submission_processed",
+			"event_payload": map[string]string{"correlation_id": id},
+		}
+	}
 
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		slog.Error("Failed to marshal follow-up message payload", "error", err)
+		logger.Error("Failed to marshal follow-up message payload", "error", err)
 		return
 	}
 
 	// Send HTTP POST to the response URL
 	resp, err := http.Post(responseURL, "application/json", bytes.NewBuffer(jsonPayload))
 	if err != nil {
-		slog.Error("Failed to send follow-up message to Slack", "error", err)
+		logger.Error("Failed to send follow-up message to Slack", "error", err)
 		return
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		slog.Warn("Unexpected response status from Slack follow-up",
+		logger.Warn("Unexpected response status from Slack follow-up",
 			"status_code", resp.StatusCode,
 			"status", resp.Status)
 		return
 	}
 
-	slog.Info("Successfully sent follow-up message to Slack",
+	logger.Info("Successfully sent follow-up message to Slack",
 		"response_url_provided", true,
 		"message_length", len(message))
 }
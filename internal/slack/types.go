@@ -2,14 +2,50 @@ package slack
 
 import (
 	"context"
+	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/ai"
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
 )
 
 type SlackConfig struct {
-	Token         string
-	SigningSecret string
+	Token                string
+	SigningSecret        string
+	EditorUsers          []string          // Slack user IDs with read/review-only admin access
+	AITimeout            time.Duration     // Per-request limit for AI processing, defaults to DefaultAITimeout
+	BannedWords          []string          // Words or phrases that hold a submission for admin review instead of AI processing
+	SubmissionCategories []string          // Categories `submit` accepts, defaults to all of them
+	DefaultCategory      string            // Category for submissions that name none, defaults to DefaultSubmissionCategory
+	DefaultJournalist    string            // Journalist for submissions with neither a question nor an assignment, defaults to DefaultJournalistType
+	MinSubmissionLength  int               // Fewest characters a submission may have, defaults to DefaultMinSubmissionLength
+	MaxSubmissionLength  int               // Most characters a submission may have, defaults to DefaultMaxSubmissionLength
+	DMReplyGracePeriod   time.Duration     // How long into a new week a DM reply may still answer last week's open assignment; 0 disables
+	LowPoolThreshold     int               // Broadcast a body/mind question request when a selection leaves fewer active questions; 0 disables
+	SubmissionWindow     *SubmissionWindow // Days of the week `submit` accepts submissions; nil accepts them any time
+	SlackMaxRetries      int               // Retries of a Slack API call after a rate limit or 5xx, defaults to DefaultSlackMaxRetries
+	DebugErrors          bool              // Include details such as the caller's Slack ID in ephemeral error replies
+}
+
+// SubmissionWindow is the part of each week in which submissions are accepted: from the start
+// of Opens to the end of Closes, in the publication time zone. Opens after Closes wraps the weekend.
+type SubmissionWindow struct {
+	Opens  time.Weekday
+	Closes time.Weekday
+}
+
+// Contains reports whether t falls within the window
+func (w SubmissionWindow) Contains(t time.Time) bool {
+	day, opens, closes := mondayIndex(t.Weekday()), mondayIndex(w.Opens), mondayIndex(w.Closes)
+	if opens <= closes {
+		return day >= opens && day <= closes
+	}
+	return day >= opens || day <= closes
+}
+
+// mondayIndex numbers the days of the week from Monday (0) to Sunday (6), like ISO weeks
+func mondayIndex(day time.Weekday) int {
+	return (int(day) + 6) % 7
 }
 
 type SlashCommand struct {
@@ -19,11 +55,17 @@ type SlashCommand struct {
 	UserID      string
 	ChannelID   string
 	ResponseURL string
+	TriggerID   string      // Lets the command open a modal within the next few seconds
+	Files       []SlackFile // Files shared with a DM reply; slash commands can't carry files
+	// IdempotencyKey identifies a single submit, so a retried delivery of it doesn't create a second
+	// submission. The handler fills it from a client-supplied key, falling back to TriggerID.
+	IdempotencyKey string
 }
 
 type SlashCommandResponse struct {
-	Text         string `json:"text"`
-	ResponseType string `json:"response_type,omitempty"`
+	Text         string        `json:"text"` // Always set; the fallback when Blocks are present
+	ResponseType string        `json:"response_type,omitempty"`
+	Blocks       []slack.Block `json:"blocks,omitempty"` // Optional Block Kit layout of Text
 }
 
 type Bot interface {
@@ -34,12 +76,61 @@ type Bot interface {
 	EnrichSubmissionWithUserInfo(ctx context.Context, userID, content string) (*EnrichedSubmission, error)
 }
 
+// SettingsReloader is implemented by bots whose runtime settings can be swapped without a restart
+type SettingsReloader interface {
+	ReloadSettings(cfg SlackConfig, adminUsers []string)
+}
+
+// Drainer is implemented by bots that run background jobs which should finish before shutdown
+type Drainer interface {
+	Drain(ctx context.Context) error
+}
+
+// WeeklyRunner is implemented by bots that can run the weekly assignment job on demand
+type WeeklyRunner interface {
+	RunWeekly(ctx context.Context, candidates []string) (*WeeklyRunSummary, error)
+}
+
+// ViewSubmissionHandler is implemented by bots that handle submitted Slack modals. A nil
+// response closes the modal; a response with errors keeps it open.
+type ViewSubmissionHandler interface {
+	HandleViewSubmission(ctx context.Context, callback slack.InteractionCallback) (*slack.ViewSubmissionResponse, error)
+}
+
+// BlockActionHandler is implemented by bots that handle button presses in their messages
+type BlockActionHandler interface {
+	HandleBlockActions(ctx context.Context, callback slack.InteractionCallback) error
+}
+
+// EventType identifies the kind of a Slack Events API event
+type EventType string
+
+const (
+	EventTypeMessage       EventType = "message"
+	EventTypeAppMention    EventType = "app_mention"
+	EventTypeReactionAdded EventType = "reaction_added"
+)
+
+// EventHandlerFunc handles one kind of Slack event
+type EventHandlerFunc func(ctx context.Context, event SlackEvent) error
+
 type SlackEvent struct {
-	Type    string `json:"type"`
-	User    string `json:"user"`
-	Text    string `json:"text"`
-	Channel string `json:"channel"`
-	BotID   string `json:"bot_id,omitempty"`
+	Type        string      `json:"type"`
+	User        string      `json:"user"`
+	Text        string      `json:"text"`
+	Channel     string      `json:"channel"`
+	BotID       string      `json:"bot_id,omitempty"`
+	ClientMsgID string      `json:"client_msg_id,omitempty"`
+	EventID     string      `json:"event_id,omitempty"` // Copied from the event_callback envelope, stable across retries
+	Files       []SlackFile `json:"files,omitempty"`
+}
+
+// SlackFile is a file shared in a message event
+type SlackFile struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Mimetype   string `json:"mimetype"`
+	URLPrivate string `json:"url_private"`
 }
 
 // UserInfo represents Slack user information
@@ -68,6 +159,14 @@ type EnrichedSubmission struct {
 	AuthorDepartment string `json:"author_department"`
 }
 
+// UsergroupResolver expands a Slack usergroup into the IDs of its members
+type UsergroupResolver interface {
+	// ListUsergroups returns every usergroup in the workspace
+	ListUsergroups(ctx context.Context) ([]slack.UserGroup, error)
+	// GetUsergroupMembers returns the member IDs of a usergroup
+	GetUsergroupMembers(ctx context.Context, groupID string) ([]string, error)
+}
+
 // AIProcessor defines interface for AI content processing
 // This is an alias to the EnhancedAIService interface in the ai package
 type AIProcessor = ai.EnhancedAIService
@@ -76,14 +175,19 @@ type AIProcessor = ai.EnhancedAIService
 type DatabaseInterface interface {
 	GetOrCreateWeeklyIssue(weekNumber, year int) (*database.WeeklyNewsletterIssue, error)
 	CreateProcessedArticle(article database.ProcessedArticle) (int, error)
+	SupersedeProcessedArticles(submissionID int) (int, error)
 	// Assignment-related methods for unified submission system
 	GetActiveAssignmentByUser(userID string, contentType database.ContentType) (*database.PersonAssignment, error)
 	GetAssignmentsByUserAndIssue(userID string, issueID int) ([]database.PersonAssignment, error)
+	GetAssignmentForUserInWeek(userID string, week, year int) (*database.PersonAssignment, error)
 	LinkSubmissionToAssignment(assignmentID, submissionID int) error
+	UpdateAssignmentQuestion(assignmentID, questionID int) error
 	GetPersonAssignmentByID(assignmentID int) (*database.PersonAssignment, error)
 	GetAssignmentBySubmissionID(submissionID int) (*database.PersonAssignment, error)
 	// Anonymous submission methods
 	CreateAnonymousSubmission(content, category string) (*database.Submission, error)
+	// Moderation
+	FlagSubmission(id int, reason string) error
 	GetAnonymousSubmissionsByCategory(category string) ([]database.Submission, error)
 	// GetUnderlyingDB returns the underlying *database.DB if available, nil otherwise
 	GetUnderlyingDB() *database.DB
@@ -2,86 +2,195 @@ package slack
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/olle-forsslof/kumpan-newspaper/internal/metrics"
 )
 
+// submissionCategories lists every category the unified submission system can route.
+// SlackConfig.SubmissionCategories may narrow this down.
+var submissionCategories = []string{"feature", "general", "interview", "body_mind"}
+
+// DefaultSubmissionCategory is used when a submission names no category and
+// SlackConfig.DefaultCategory is unset
+const DefaultSubmissionCategory = "general"
+
+// DefaultJournalistType writes up submissions with neither a question nor an assignment when
+// SlackConfig.DefaultJournalist is unset
+const DefaultJournalistType = "general"
+
+// Default submission length limits in characters (runes), used when SlackConfig leaves them unset
+const (
+	DefaultMinSubmissionLength = 10
+	DefaultMaxSubmissionLength = 4000
+)
+
+// errEmptySubmission is returned when a submission has no content after the category
+var errEmptySubmission = errors.New("submission has no content")
+
 // parseCategorizedSubmission parses a submission command with optional category
+// against the full category set, defaulting to general.
 // Returns: category, content, valid
 func parseCategorizedSubmission(input string) (string, string, bool) {
+	category, content, err := parseSubmission(input, submissionCategories, DefaultSubmissionCategory)
+	if err != nil {
+		return "", "", false
+	}
+	return category, content, true
+}
+
+// parseSubmission parses a submission command with optional category. Only the enabled
+// categories are accepted; a known but disabled or unknown category is rejected with an
+// error listing the enabled ones. Without a category, defaultCategory is used.
+func parseSubmission(input string, enabled []string, defaultCategory string) (string, string, error) {
 	// Remove "submit " prefix
 	content := strings.TrimSpace(strings.TrimPrefix(input, "submit "))
 	if content == "" {
-		return "", "", false
+		return "", "", errEmptySubmission
 	}
 
-	// Valid categories for unified submission system
-	validCategories := []string{"feature", "general", "interview", "body_mind"}
-
-	// Check if first word is a valid category
+	// Check if first word is a category
 	parts := strings.SplitN(content, " ", 2)
+	category := parts[0]
 
-	if len(parts) >= 1 {
-		category := parts[0]
-
-		// Check if it's a valid category
-		isValidCategory := false
-		for _, validCat := range validCategories {
-			if category == validCat {
-				isValidCategory = true
-				break
-			}
+	if containsString(submissionCategories, category) {
+		if !containsString(enabled, category) {
+			return "", "", fmt.Errorf("category '%s' is disabled. Enabled categories: %s", category, strings.Join(enabled, ", "))
+		}
+		if len(parts) < 2 {
+			return "", "", errEmptySubmission // Category specified but no content
 		}
+		actualContent := strings.TrimSpace(parts[1])
+		if actualContent == "" {
+			return "", "", errEmptySubmission // Category specified but no content
+		}
+		return category, actualContent, nil
+	}
 
-		if isValidCategory {
-			if len(parts) < 2 {
-				return "", "", false // Category specified but no content
-			}
-			actualContent := strings.TrimSpace(parts[1])
-			if actualContent == "" {
-				return "", "", false // Category specified but no content
+	// If first word looks like a category attempt but isn't valid, reject it
+	// We detect this by checking if it ends with common category suffixes or contains underscores
+	if strings.Contains(category, "_") || strings.HasSuffix(category, "category") {
+		return "", "", fmt.Errorf("unknown category '%s'. Enabled categories: %s", category, strings.Join(enabled, ", "))
+	}
+
+	// No category specified or first word doesn't look like a category
+	// Fall back to the default for backward compatibility
+	return defaultCategory, content, nil
+}
+
+// DefaultWellnessCategory is the pool category for submit-wellness questions that name none
+const DefaultWellnessCategory = "wellness"
+
+// parseWellnessSubmission parses `submit-wellness "Question" [category]`. The quotes are optional;
+// without them a trailing pool category is still recognised.
+func parseWellnessSubmission(input string) (string, string, error) {
+	text := strings.TrimSpace(strings.TrimPrefix(input, "submit-wellness"))
+	if text == "" {
+		return "", "", errEmptySubmission
+	}
+
+	question, category := text, DefaultWellnessCategory
+	if strings.HasPrefix(text, "\"") {
+		if end := strings.LastIndex(text, "\""); end > 0 {
+			question = strings.TrimSpace(text[1:end])
+			if rest := strings.TrimSpace(text[end+1:]); rest != "" {
+				category = rest
 			}
-			return category, actualContent, true
 		}
+	} else if space := strings.LastIndex(text, " "); space > 0 && containsString(database.BodyMindCategories(), text[space+1:]) {
+		question, category = strings.TrimSpace(text[:space]), text[space+1:]
+	}
 
-		// If first word looks like a category attempt but isn't valid, reject it
-		// We detect this by checking if it ends with common category suffixes or contains underscores
-		if strings.Contains(category, "_") || strings.HasSuffix(category, "category") {
-			// Looks like a category attempt but not valid
-			return "", "", false
-		}
+	if question == "" {
+		return "", "", errEmptySubmission
+	}
+	if !containsString(database.BodyMindCategories(), category) {
+		return "", "", fmt.Errorf("unknown wellness category '%s'. Categories: %s", category, strings.Join(database.BodyMindCategories(), ", "))
 	}
 
-	// No category specified or first word doesn't look like a category
-	// Default to general for backward compatibility
-	return "general", content, true
+	return question, category, nil
+}
+
+// containsString reports whether values contains s
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
 
 // handleCategorizedSubmission processes unified submissions with category routing
 func (b *slackBot) handleCategorizedSubmission(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
-	category, content, valid := parseCategorizedSubmission(cmd.Text)
-	if !valid {
+	// Outside the weekly window nothing is stored, so late stories don't trickle in after publication
+	if window := b.settings().SubmissionWindow; window != nil && !window.Contains(b.now()) {
+		return &SlashCommandResponse{
+			Text: fmt.Sprintf("🔒 Submissions are closed for this week - they're open from %s until the end of %s. Hold on to your story until then!",
+				window.Opens, window.Closes),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return b.handleCategorizedSubmissionFor(ctx, cmd, nil)
+}
+
+// handleCategorizedSubmissionFor processes a unified submission, linking it to the given
+// assignment instead of looking up the user's assignment for the current week when set
+func (b *slackBot) handleCategorizedSubmissionFor(ctx context.Context, cmd SlashCommand, assignment *database.PersonAssignment) (*SlashCommandResponse, error) {
+	// Tag everything logged for this submission, including its background processing
+	ctx = withCorrelationID(ctx)
+
+	category, content, err := parseSubmission(cmd.Text, b.submissionCategories(), b.defaultCategory())
+	if errors.Is(err, errEmptySubmission) {
 		return &SlashCommandResponse{
 			Text:         "Please provide content for your submission.\n\nExamples:\n• `submit feature My team built a new dashboard`\n• `submit general Found this great Go performance article`\n• `submit body_mind How do you manage stress during deployments?`",
 			ResponseType: "ephemeral",
 		}, nil
 	}
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
 
-	// Route based on category
-	switch category {
-	case "body_mind":
-		return b.handleAnonymousBodyMindSubmission(ctx, content)
+	// Very short or very long content makes for poor articles, so reject it before storing
+	if length, ok := b.submissionLength(content); !ok {
+		return &SlashCommandResponse{
+			Text: fmt.Sprintf("✏️ Your submission is %d characters long - please keep it between %d and %d characters so our journalists have something to work with.",
+				length, b.minSubmissionLength(), b.maxSubmissionLength()),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Hold back content that hits the banned-word list before it reaches the AI
+	if bannedWord, found := findBannedWord(content, b.settings().BannedWords); found {
+		return b.handleFlaggedSubmission(ctx, cmd.UserID, category, content, bannedWord)
+	}
+
+	// Submissions that only fell back to the default category may get a better one suggested
+	suggest := assignment == nil && !strings.HasPrefix(strings.TrimSpace(strings.TrimPrefix(cmd.Text, "submit ")), category+" ")
+
+	// Route based on category. Body/mind answers are always stored anonymously, even when they
+	// complete a known assignment.
+	switch {
+	case category == "body_mind":
+		return b.handleAnonymousBodyMindSubmission(ctx, content, assignment)
 	default:
-		return b.handleAssignmentLinkedSubmission(ctx, cmd.UserID, category, content, cmd.ResponseURL)
+		return b.handleAssignmentLinkedSubmission(ctx, cmd.UserID, category, content, cmd.ResponseURL, assignment, suggest, cmd.Files)
 	}
 }
 
-// handleAnonymousBodyMindSubmission creates anonymous submissions for wellness content
-func (b *slackBot) handleAnonymousBodyMindSubmission(ctx context.Context, content string) (*SlashCommandResponse, error) {
+// handleAnonymousBodyMindSubmission creates anonymous submissions for wellness content. A given
+// assignment is marked done by linking it, but the submission itself never records its author.
+func (b *slackBot) handleAnonymousBodyMindSubmission(ctx context.Context, content string, assignment *database.PersonAssignment) (*SlashCommandResponse, error) {
 	if b.db == nil {
 		return &SlashCommandResponse{
 			Text:         "❌ Anonymous submissions not available (database not configured)",
@@ -97,15 +206,24 @@ func (b *slackBot) handleAnonymousBodyMindSubmission(ctx context.Context, conten
 			ResponseType: "ephemeral",
 		}, nil
 	}
+	metrics.SubmissionsReceived.WithLabelValues("body_mind").Inc()
 
 	responseText := fmt.Sprintf("🧘 *Anonymous wellness submission received!*\n\n> %s\n\n✅ Your submission has been added to the body/mind pool anonymously.", content)
 
+	if assignment != nil {
+		if err := b.db.LinkSubmissionToAssignment(assignment.ID, submission.ID); err != nil {
+			loggerFor(ctx).Error("Failed to link anonymous submission to assignment", "assignment_id", assignment.ID, "submission_id", submission.ID, "error", err)
+		} else {
+			responseText += "\n🎯 Your still-open body/mind assignment from last week is done!"
+		}
+	}
+
 	// Process with AI if available
 	if b.aiProcessor != nil {
 		responseText += "\n🤖 Processing with our wellness journalist in the background..."
 
 		// Launch async processing for anonymous submission
-		go b.processAnonymousSubmissionAsync(context.Background(), *submission)
+		b.goBackground(func() { b.processAnonymousSubmissionAsync(context.WithoutCancel(ctx), *submission) })
 	}
 
 	return &SlashCommandResponse{
@@ -114,8 +232,72 @@ func (b *slackBot) handleAnonymousBodyMindSubmission(ctx context.Context, conten
 	}, nil
 }
 
-// handleAssignmentLinkedSubmission processes submissions that should link to user assignments
-func (b *slackBot) handleAssignmentLinkedSubmission(ctx context.Context, userID, category, content, responseURL string) (*SlashCommandResponse, error) {
+// handleWellnessSubmission adds a `submit-wellness` question straight to the body/mind pool.
+// Nothing identifying the sender is stored.
+func (b *slackBot) handleWellnessSubmission(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	question, category, err := parseWellnessSubmission(cmd.Text)
+	if errors.Is(err, errEmptySubmission) {
+		return &SlashCommandResponse{
+			Text:         "Please include your question.\n\nExample: `/pp submit-wellness \"How do you disconnect from work after hours?\" work_life_balance`",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid wellness question: %v", err),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if length, ok := b.submissionLength(question); !ok {
+		return &SlashCommandResponse{
+			Text: fmt.Sprintf("✏️ Your question is %d characters long - please keep it between %d and %d characters.",
+				length, b.minSubmissionLength(), b.maxSubmissionLength()),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Flagged submissions are held with the sender's ID, so wellness questions are turned away instead
+	if _, found := findBannedWord(question, b.settings().BannedWords); found {
+		return &SlashCommandResponse{
+			Text:         "🚫 Your question contains language we can't publish. Please rephrase it and try again.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	var dbPtr *database.DB
+	if b.db != nil {
+		dbPtr = b.db.GetUnderlyingDB()
+	}
+	if dbPtr == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Wellness questions not available (database not configured)",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if _, err := database.NewBodyMindPoolManager(dbPtr).AddQuestionToPool(question, category); err != nil {
+		slog.Error("Failed to add wellness question to pool", "category", category, "error", err)
+		return &SlashCommandResponse{
+			Text:         "❌ Failed to store your wellness question. Please try again later.",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	metrics.SubmissionsReceived.WithLabelValues("body_mind").Inc()
+
+	return &SlashCommandResponse{
+		Text: fmt.Sprintf("🧘 *Wellness question received - thank you!*\n\n> %s\n\n"+
+			"🔒 It was added to the %s pool completely anonymously: your name is not stored anywhere, "+
+			"not even for admins.", question, strings.ReplaceAll(category, "_", " ")),
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleAssignmentLinkedSubmission processes submissions that should link to user assignments.
+// A nil assignment links to the user's current-week assignment for the category, if any.
+// With suggest set, an unlinked submission may come with a better category suggestion.
+// Files shared along with the submission are stored with it.
+func (b *slackBot) handleAssignmentLinkedSubmission(ctx context.Context, userID, category, content, responseURL string, assignment *database.PersonAssignment, suggest bool, files []SlackFile) (*SlashCommandResponse, error) {
 	if b.submissionManager == nil {
 		return &SlashCommandResponse{
 			Text:         "❌ Submission storage not available",
@@ -132,10 +314,30 @@ func (b *slackBot) handleAssignmentLinkedSubmission(ctx context.Context, userID,
 		}, nil
 	}
 
+	if submission.Duplicate {
+		return &SlashCommandResponse{
+			Text:         duplicateSubmissionText,
+			ResponseType: "ephemeral",
+		}, nil
+	}
+	metrics.SubmissionsReceived.WithLabelValues(category).Inc()
+
 	responseText := fmt.Sprintf("📰 *%s submission received!*\n\n> %s\n\n", strings.Title(category), content)
 
+	if attached := b.storeSubmissionFiles(ctx, submission.ID, files); attached > 0 {
+		responseText += fmt.Sprintf("📎 %d file(s) attached\n", attached)
+	}
+
 	// Try to link to active assignment if available
-	if b.db != nil {
+	linked := false
+	if b.db != nil && assignment != nil {
+		if linkErr := b.db.LinkSubmissionToAssignment(assignment.ID, submission.ID); linkErr == nil {
+			linked = true
+			responseText += fmt.Sprintf("🎯 Linked to your still-open %s assignment from last week!\n", category)
+		} else {
+			loggerFor(ctx).Error("Failed to link submission to assignment", "assignment_id", assignment.ID, "submission_id", submission.ID, "error", linkErr)
+		}
+	} else if b.db != nil {
 		// Convert category to ContentType
 		contentType := categoryToContentType(category)
 		if contentType != "" {
@@ -144,16 +346,28 @@ func (b *slackBot) handleAssignmentLinkedSubmission(ctx context.Context, userID,
 				// Link submission to assignment
 				linkErr := b.db.LinkSubmissionToAssignment(assignment.ID, submission.ID)
 				if linkErr == nil {
+					linked = true
 					responseText += fmt.Sprintf("🎯 Linked to your %s assignment for this week!\n", category)
 				}
 			}
 		}
 	}
 
+	if suggest && !linked {
+		responseText += b.categorySuggestion(userID, submission.ID, category, content)
+	}
+
+	// Admins see likely mis-categorized answers in week-status; the submission is kept as is
+	if linked {
+		if suggested, mismatch := assignmentMismatch(database.ContentType(categoryToContentType(category)), content); mismatch {
+			loggerFor(ctx).Info("Possible mis-categorization", "submission_id", submission.ID, "assigned", category, "reads_like", suggested)
+		}
+	}
+
 	// Launch async AI processing if available
 	if b.aiProcessor != nil && submission != nil {
-		responseText += "🤖 Processing with AI in the background...\n"
-		go b.processSubmissionAsync(context.Background(), *submission, userID, responseURL)
+		responseText += "🤖 Processing with AI in the background" + b.processingETA() + "...\n"
+		b.goBackground(func() { b.processSubmissionAsync(context.WithoutCancel(ctx), *submission, userID, responseURL) })
 	}
 
 	responseText += "✅ Thanks for contributing!"
@@ -164,6 +378,224 @@ func (b *slackBot) handleAssignmentLinkedSubmission(ctx context.Context, userID,
 	}, nil
 }
 
+// storeSubmissionFiles records the files shared with a submission and returns how many were stored.
+// A file that can't be stored is logged and skipped, since the submission itself is already saved.
+func (b *slackBot) storeSubmissionFiles(ctx context.Context, submissionID int, files []SlackFile) int {
+	if len(files) == 0 || b.db == nil {
+		return 0
+	}
+	dbPtr := b.db.GetUnderlyingDB()
+	if dbPtr == nil {
+		return 0
+	}
+
+	stored := 0
+	for _, file := range files {
+		if file.ID == "" || file.URLPrivate == "" {
+			continue
+		}
+		if err := dbPtr.AddSubmissionFile(submissionID, file.ID, file.URLPrivate, file.Mimetype); err != nil {
+			loggerFor(ctx).Error("Failed to store submission file", "submission_id", submissionID, "file_id", file.ID, "error", err)
+			continue
+		}
+		stored++
+	}
+	return stored
+}
+
+// handleEditSubmission lets a user replace the content of their own submission and re-processes it
+func (b *slackBot) handleEditSubmission(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	usage := "Usage: `edit submission_id \"new content\"`\nExample: `edit 42 \"Our team launched the new dashboard today\"`"
+
+	parts := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(cmd.Text, "edit ")), " ", 2)
+	if len(parts) < 2 {
+		return &SlashCommandResponse{Text: usage, ResponseType: "ephemeral"}, nil
+	}
+
+	submissionID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Invalid submission ID '%s'. Must be a number.\n\n%s", parts[0], usage),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	content := strings.TrimSpace(strings.Trim(strings.TrimSpace(parts[1]), "\"'"))
+	if content == "" {
+		return &SlashCommandResponse{Text: usage, ResponseType: "ephemeral"}, nil
+	}
+
+	// Edits are held to the same limits as new submissions
+	if length, ok := b.submissionLength(content); !ok {
+		return &SlashCommandResponse{
+			Text: fmt.Sprintf("✏️ Your edit is %d characters long - please keep it between %d and %d characters so our journalists have something to work with.",
+				length, b.minSubmissionLength(), b.maxSubmissionLength()),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	if b.submissionManager == nil {
+		return &SlashCommandResponse{
+			Text:         "❌ Submission storage not available",
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Ownership is checked by the update itself
+	if err := b.submissionManager.UpdateSubmissionContent(ctx, submissionID, cmd.UserID, content); err != nil {
+		slog.Warn("Rejected submission edit", "submission_id", submissionID, "user", cmd.UserID, "error", err)
+		return &SlashCommandResponse{
+			Text:         fmt.Sprintf("❌ Submission #%d could not be edited. You can only edit your own submissions.", submissionID),
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	responseText := fmt.Sprintf("✏️ *Submission #%d updated!*\n\n> %s\n\n", submissionID, content)
+
+	if b.db != nil {
+		superseded, err := b.db.SupersedeProcessedArticles(submissionID)
+		if err != nil {
+			slog.Error("Failed to supersede articles for edited submission", "submission_id", submissionID, "error", err)
+		} else if superseded > 0 {
+			slog.Info("Superseded articles for edited submission", "submission_id", submissionID, "count", superseded)
+		}
+
+		// Edits go through the same moderation as new submissions, and held submissions stay held
+		// until an admin reruns them
+		heldForReview := false
+		if bannedWord, found := findBannedWord(content, b.settings().BannedWords); found {
+			reason := fmt.Sprintf("contains banned word %q", bannedWord)
+			if err := b.db.FlagSubmission(submissionID, reason); err != nil {
+				loggerFor(ctx).Error("Failed to flag edited submission for review", "submission_id", submissionID, "error", err)
+			}
+			loggerFor(ctx).Warn("Edited submission held for review", "submission_id", submissionID, "reason", reason)
+			b.notifyAdminsOfFlaggedSubmission(ctx, submissionID, "edited", fmt.Sprintf("<@%s>", cmd.UserID), content, reason)
+			heldForReview = true
+		} else if underlyingDB := b.db.GetUnderlyingDB(); underlyingDB != nil {
+			status, _, err := underlyingDB.GetSubmissionReview(submissionID)
+			if err != nil {
+				slog.Error("Failed to get review status of edited submission", "submission_id", submissionID, "error", err)
+			}
+			heldForReview = status == database.SubmissionReviewNeedsReview
+		}
+
+		if heldForReview {
+			responseText += "📝 Your submission needs a quick review by an editor before it can be included in the newsletter.\n"
+		} else if b.aiProcessor != nil {
+			// Re-run AI processing on the new content
+			if underlyingDB := b.db.GetUnderlyingDB(); underlyingDB != nil {
+				submission, err := underlyingDB.GetSubmission(submissionID)
+				if err != nil {
+					slog.Error("Failed to reload edited submission", "submission_id", submissionID, "error", err)
+				} else {
+					responseText += "🤖 Re-processing with AI in the background...\n"
+					b.goBackground(func() { b.processSubmissionAsync(context.WithoutCancel(ctx), *submission, cmd.UserID, cmd.ResponseURL) })
+				}
+			}
+		}
+	}
+
+	responseText += "✅ Thanks for keeping the newsletter accurate!"
+
+	return &SlashCommandResponse{
+		Text:         responseText,
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// handleReroll swaps the user's assignment question and sends the new one as a DM
+func (b *slackBot) handleReroll(ctx context.Context, cmd SlashCommand) (*SlashCommandResponse, error) {
+	message, rerolled := b.rerollQuestion(ctx, cmd.UserID)
+	if !rerolled {
+		return &SlashCommandResponse{
+			Text:         message,
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	// Posting to a user ID lands in the DM with the bot, where replies count as submissions
+	if err := b.SendMessage(ctx, cmd.UserID, message); err != nil {
+		slog.Warn("Failed to DM rerolled question", "user", cmd.UserID, "error", err)
+		return &SlashCommandResponse{
+			Text:         message,
+			ResponseType: "ephemeral",
+		}, nil
+	}
+
+	return &SlashCommandResponse{
+		Text:         "🎲 Your new question is waiting in your DMs!",
+		ResponseType: "ephemeral",
+	}, nil
+}
+
+// rerollQuestion replaces the question of the user's open assignment this week with the next
+// question of the same category. It returns the new assignment message when the swap succeeded,
+// or an explanation for the user and false when it didn't.
+func (b *slackBot) rerollQuestion(ctx context.Context, userID string) (string, bool) {
+	if b.db == nil || b.questionSelector == nil {
+		return "❌ Rerolling is not available right now.", false
+	}
+
+	now := time.Now()
+	year, week := now.ISOWeek()
+	issue, err := b.db.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		slog.Error("Failed to get current week issue for reroll", "user", userID, "error", err)
+		return "❌ Failed to look up your assignment. Please try again later.", false
+	}
+
+	assignments, err := b.db.GetAssignmentsByUserAndIssue(userID, issue.ID)
+	if err != nil {
+		slog.Error("Failed to get assignments for reroll", "user", userID, "error", err)
+		return "❌ Failed to look up your assignment. Please try again later.", false
+	}
+	if len(assignments) == 0 {
+		return "📭 You don't have an assignment this week, so there's no question to reroll.", false
+	}
+
+	var assignment *database.PersonAssignment
+	for i := range assignments {
+		if assignments[i].SubmissionID == nil {
+			assignment = &assignments[i]
+			break
+		}
+	}
+	if assignment == nil {
+		return "✅ You've already submitted for this week's assignment, so its question can't be changed.", false
+	}
+	if assignment.ContentType == database.ContentTypeBodyMind {
+		return "🧘 Body/mind questions come from the anonymous pool and can't be rerolled.", false
+	}
+
+	category := string(assignment.ContentType)
+	question, err := b.questionSelector.SelectNextQuestion(ctx, category)
+	if err != nil {
+		slog.Warn("Failed to select question for reroll", "user", userID, "category", category, "error", err)
+		return fmt.Sprintf("📭 There are no other %s questions available right now.", category), false
+	}
+	if assignment.QuestionID != nil && question.ID == *assignment.QuestionID {
+		return fmt.Sprintf("📭 There are no other %s questions available right now.", category), false
+	}
+
+	// The update only applies while nothing is linked, so a submission racing the reroll wins
+	if err := b.db.UpdateAssignmentQuestion(assignment.ID, question.ID); err != nil {
+		slog.Warn("Failed to reroll assignment question", "user", userID, "assignment_id", assignment.ID, "error", err)
+		return "✅ You've already submitted for this week's assignment, so its question can't be changed.", false
+	}
+	if err := b.questionSelector.MarkQuestionUsed(ctx, question.ID); err != nil {
+		slog.Warn("Failed to mark rerolled question used", "question_id", question.ID, "error", err)
+	}
+
+	slog.Info("Rerolled assignment question",
+		"user", userID, "assignment_id", assignment.ID, "question_id", question.ID)
+
+	return fmt.Sprintf("🎲 *New question - Week %d, %d*\n\n"+
+		"*Your question:*\n> %s\n\n"+
+		"Please submit your response using: `/pp submit %s \"your content here\"`\n\n"+
+		"You can also simply reply to this message with your content.",
+		week, year, question.Text, contentTypeToSubmissionCategory(assignment.ContentType)), true
+}
+
 // categoryToContentType converts submission category to database ContentType
 func categoryToContentType(category string) string {
 	switch category {
@@ -172,7 +604,7 @@ func categoryToContentType(category string) string {
 	case "general":
 		return "general"
 	case "interview":
-		return "general" // Interview content uses general journalist for now
+		return "interview"
 	case "body_mind":
 		return "body_mind"
 	default:
@@ -182,20 +614,24 @@ func categoryToContentType(category string) string {
 
 // processAnonymousSubmissionAsync handles AI processing for anonymous body/mind submissions
 func (b *slackBot) processAnonymousSubmissionAsync(ctx context.Context, submission database.Submission) {
+	metrics.AIJobsInFlight.Inc()
+	defer metrics.AIJobsInFlight.Dec()
+
 	// Similar to regular async processing but for anonymous submissions
 	// Get current newsletter issue
 	if b.db == nil {
 		return
 	}
 
-	// Get newsletter issue for auto-assignment
+	// A late answer to last week's assignment belongs in that week's issue, anything else in the current one
 	var newsletterIssueID *int
-	now := time.Now()
-	year, week := now.ISOWeek()
-
-	issue, err := b.db.GetOrCreateWeeklyIssue(week, year)
-	if err == nil {
-		newsletterIssueID = &issue.ID
+	if assignment, err := b.db.GetAssignmentBySubmissionID(submission.ID); err == nil && assignment != nil {
+		newsletterIssueID = &assignment.IssueID
+	} else {
+		year, week := time.Now().ISOWeek()
+		if issue, err := b.db.GetOrCreateWeeklyIssue(week, year); err == nil {
+			newsletterIssueID = &issue.ID
+		}
 	}
 
 	// Process anonymously (no author info)
@@ -204,7 +640,7 @@ func (b *slackBot) processAnonymousSubmissionAsync(ctx context.Context, submissi
 		return
 	}
 
-	err = b.aiProcessor.ProcessAndSaveSubmission(
+	err := b.aiProcessor.ProcessAndSaveSubmission(
 		ctx,
 		dbPtr,
 		submission,
@@ -213,11 +649,12 @@ func (b *slackBot) processAnonymousSubmissionAsync(ctx context.Context, submissi
 		"body_mind",        // Journalist type
 		newsletterIssueID,
 	)
+	metrics.RecordAIResult(err)
 
 	// Log results but don't send user notifications (anonymous)
 	if err != nil {
-		slog.Error("Anonymous submission processing failed", "error", err, "submission_id", submission.ID)
+		loggerFor(ctx).Error("Anonymous submission processing failed", "error", err, "submission_id", submission.ID)
 	} else {
-		slog.Info("Anonymous submission processed successfully", "submission_id", submission.ID)
+		loggerFor(ctx).Info("Anonymous submission processed successfully", "submission_id", submission.ID)
 	}
 }
@@ -2,13 +2,20 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
 )
 
 // Helper function to create test database
@@ -54,7 +61,7 @@ func TestCategorizedSubmissionParsing(t *testing.T) {
 			expectContent:  "Found great article on Go performance",
 		},
 		{
-			name:           "interview submission routed as general",
+			name:           "interview submission",
 			input:          "submit interview Q: What's your favorite debugging technique? A: I use...",
 			expectValid:    true,
 			expectCategory: "interview",
@@ -109,6 +116,212 @@ func TestCategorizedSubmissionParsing(t *testing.T) {
 	}
 }
 
+// TDD: A configured category set can disable categories and change the default
+func TestParseSubmissionWithEnabledCategories(t *testing.T) {
+	enabled := []string{"feature", "general"}
+
+	tests := []struct {
+		name           string
+		input          string
+		defaultCat     string
+		expectCategory string
+		expectContent  string
+		expectErr      string
+	}{
+		{
+			name:           "enabled category accepted",
+			input:          "submit feature New dashboard",
+			defaultCat:     "general",
+			expectCategory: "feature",
+			expectContent:  "New dashboard",
+		},
+		{
+			name:       "disabled category rejected",
+			input:      "submit body_mind How do you unwind?",
+			defaultCat: "general",
+			expectErr:  "category 'body_mind' is disabled. Enabled categories: feature, general",
+		},
+		{
+			name:       "unknown category lists enabled ones",
+			input:      "submit random_stuff Something",
+			defaultCat: "general",
+			expectErr:  "unknown category 'random_stuff'. Enabled categories: feature, general",
+		},
+		{
+			name:           "custom default category",
+			input:          "submit We moved offices",
+			defaultCat:     "feature",
+			expectCategory: "feature",
+			expectContent:  "We moved offices",
+		},
+		{
+			name:       "empty content",
+			input:      "submit general",
+			defaultCat: "general",
+			expectErr:  errEmptySubmission.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, content, err := parseSubmission(tt.input, enabled, tt.defaultCat)
+			if tt.expectErr != "" {
+				if err == nil || err.Error() != tt.expectErr {
+					t.Fatalf("Expected error %q, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if category != tt.expectCategory || content != tt.expectContent {
+				t.Errorf("Expected (%s, %s), got (%s, %s)", tt.expectCategory, tt.expectContent, category, content)
+			}
+		})
+	}
+}
+
+// TDD: Submitting to a disabled category explains which categories are enabled
+func TestCategorizedSubmissionDisabledCategory(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token", SubmissionCategories: []string{"feature", "general", "interview"}},
+		&MockQuestionSelector{},
+		[]string{},
+		&MockSubmissionManager{},
+		nil,
+		testDB,
+	)
+
+	response, err := bot.HandleSlashCommand(context.Background(), SlashCommand{
+		Text:   "submit body_mind How do you manage stress during deployments?",
+		UserID: "U123456",
+	})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand() failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "body_mind' is disabled") ||
+		!strings.Contains(response.Text, "feature, general, interview") {
+		t.Errorf("Expected disabled-category error listing enabled categories, got: %s", response.Text)
+	}
+
+	var stored int
+	if err := testDB.QueryRow("SELECT COUNT(*) FROM submissions").Scan(&stored); err != nil {
+		t.Fatalf("Failed to count submissions: %v", err)
+	}
+	if stored != 0 {
+		t.Errorf("Expected no anonymous submission to be stored, got %d", stored)
+	}
+}
+
+// TDD: Submission length limits count characters, not bytes, and nothing out of range is stored
+func TestCategorizedSubmissionLengthLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectStore bool
+	}{
+		{"one below minimum", strings.Repeat("a", 9), false},
+		{"at minimum", strings.Repeat("a", 10), true},
+		{"multi-byte at minimum", strings.Repeat("å", 10), true},
+		{"multi-byte at maximum", "Smörgåsbord " + strings.Repeat("ö", 28), true},
+		{"multi-byte one above maximum", "Smörgåsbord " + strings.Repeat("ö", 29), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubmissionManager := &MockSubmissionManager{}
+			bot := NewBotWithDatabase(
+				SlackConfig{Token: "test-token", MaxSubmissionLength: 40},
+				&MockQuestionSelector{},
+				[]string{},
+				mockSubmissionManager,
+				nil,
+				nil,
+			)
+
+			response, err := bot.HandleSlashCommand(context.Background(), SlashCommand{
+				Text:   "submit general " + tt.content,
+				UserID: "U123456",
+			})
+			if err != nil {
+				t.Fatalf("HandleSlashCommand() failed: %v", err)
+			}
+
+			stored := len(mockSubmissionManager.CreatedSubmissions) == 1
+			if stored != tt.expectStore {
+				t.Errorf("Expected stored=%v for %d characters, got %v (response: %s)", tt.expectStore, len([]rune(tt.content)), stored, response.Text)
+			}
+			if !tt.expectStore && !strings.Contains(response.Text, "between 10 and 40 characters") {
+				t.Errorf("Expected friendly length error, got: %s", response.Text)
+			}
+			if response.ResponseType != "ephemeral" {
+				t.Errorf("Expected ephemeral response, got: %s", response.ResponseType)
+			}
+		})
+	}
+}
+
+// TDD: Submissions are only stored within the weekly window, judged in the publication time zone
+func TestCategorizedSubmissionWindow(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	db.SetLocation(stockholm)
+
+	tests := []struct {
+		name        string
+		window      *SubmissionWindow
+		now         time.Time
+		expectStore bool
+	}{
+		{"no window", nil, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC), true},
+		{"monday morning", &SubmissionWindow{Opens: time.Monday, Closes: time.Wednesday}, time.Date(2026, 10, 12, 8, 0, 0, 0, stockholm), true},
+		{"wednesday evening", &SubmissionWindow{Opens: time.Monday, Closes: time.Wednesday}, time.Date(2026, 10, 14, 23, 30, 0, 0, stockholm), true},
+		{"thursday morning", &SubmissionWindow{Opens: time.Monday, Closes: time.Wednesday}, time.Date(2026, 10, 15, 0, 30, 0, 0, stockholm), false},
+		// 23:30 UTC on Wednesday is already Thursday in Stockholm
+		{"wednesday night in UTC", &SubmissionWindow{Opens: time.Monday, Closes: time.Wednesday}, time.Date(2026, 10, 14, 23, 30, 0, 0, time.UTC), false},
+		{"sunday", &SubmissionWindow{Opens: time.Monday, Closes: time.Wednesday}, time.Date(2026, 10, 18, 12, 0, 0, 0, stockholm), false},
+		{"window across the weekend", &SubmissionWindow{Opens: time.Friday, Closes: time.Monday}, time.Date(2026, 10, 18, 12, 0, 0, 0, stockholm), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubmissionManager := &MockSubmissionManager{}
+			bot := NewBotWithDatabase(
+				SlackConfig{Token: "test-token", SubmissionWindow: tt.window},
+				&MockQuestionSelector{},
+				[]string{},
+				mockSubmissionManager,
+				nil,
+				db,
+			).(*slackBot)
+			bot.clock = func() time.Time { return tt.now }
+
+			response, err := bot.HandleSlashCommand(context.Background(), SlashCommand{
+				Text:   "submit general The office got a new espresso machine",
+				UserID: "U123456",
+			})
+			if err != nil {
+				t.Fatalf("HandleSlashCommand() failed: %v", err)
+			}
+
+			stored := len(mockSubmissionManager.CreatedSubmissions) == 1
+			if stored != tt.expectStore {
+				t.Errorf("Expected stored=%v, got %v (response: %s)", tt.expectStore, stored, response.Text)
+			}
+			if !tt.expectStore && !strings.Contains(response.Text, "Submissions are closed") {
+				t.Errorf("Expected submissions closed message, got: %s", response.Text)
+			}
+		})
+	}
+}
+
 // Test TDD Cycle 2: Database methods for assignment lookup and linking
 func TestGetActiveAssignmentByUser(t *testing.T) {
 	// Setup test database
@@ -481,6 +694,84 @@ func TestReplyToBotSubmission(t *testing.T) {
 	}
 }
 
+// TDD: Test files shared with a DM reply are stored with the submission
+func TestReplyToBotWithFiles(t *testing.T) {
+	testDB, err := database.NewSimple(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+	defer testDB.Close()
+
+	if err := testDB.Migrate(); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	userID := "U123456"
+	if _, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    userID,
+		ContentType: database.ContentTypeFeature,
+		AssignedAt:  time.Now(),
+	}); err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ok": true}`)
+	}))
+	defer server.Close()
+
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, []string{}, database.NewSubmissionManager(testDB.DB), nil, testDB).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	// A file_share message as Slack delivers it; the file without a URL can't be stored
+	var event SlackEvent
+	payload := `{
+		"type": "message",
+		"user": "U123456",
+		"text": "Here is the new dashboard we shipped this week",
+		"channel": "D123456",
+		"files": [
+			{"id": "F0001", "name": "dashboard.png", "mimetype": "image/png", "url_private": "https://files.slack.com/files-pri/T1-F0001/dashboard.png"},
+			{"id": "F0002", "name": "notes.pdf", "mimetype": "application/pdf", "url_private": "https://files.slack.com/files-pri/T1-F0002/notes.pdf"},
+			{"id": "F0003", "name": "gone.txt", "mimetype": "text/plain"}
+		]
+	}`
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		t.Fatalf("Failed to parse event: %v", err)
+	}
+
+	if err := bot.HandleEventCallback(context.Background(), event); err != nil {
+		t.Fatalf("HandleEventCallback failed: %v", err)
+	}
+
+	assignments, err := testDB.GetAssignmentsByUserAndIssue(userID, issue.ID)
+	if err != nil || len(assignments) != 1 || assignments[0].SubmissionID == nil {
+		t.Fatalf("Expected the reply to be linked to the assignment, got %+v (%v)", assignments, err)
+	}
+
+	files, err := testDB.GetFilesForSubmission(*assignments[0].SubmissionID)
+	if err != nil {
+		t.Fatalf("GetFilesForSubmission failed: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Expected 2 stored files, got %d: %+v", len(files), files)
+	}
+	if files[0].FileID != "F0001" || files[0].MimeType != "image/png" || files[0].URL != "https://files.slack.com/files-pri/T1-F0001/dashboard.png" {
+		t.Errorf("Unexpected first file: %+v", files[0])
+	}
+	if files[1].FileID != "F0002" || files[1].MimeType != "application/pdf" {
+		t.Errorf("Unexpected second file: %+v", files[1])
+	}
+}
+
 func TestReplyToBotNoAssignment(t *testing.T) {
 	// Create test database
 	tempFile := "/tmp/test_reply_no_assignment.db"
@@ -531,3 +822,898 @@ func TestReplyToBotNoAssignment(t *testing.T) {
 		t.Errorf("Expected 0 submissions to be created, got %d", len(mockSubmissionManager.CreatedSubmissions))
 	}
 }
+
+// TDD: A DM reply early in the week attaches to last week's still-open assignment
+func TestReplyToBotPreviousWeekAssignment(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	userID := "U123456"
+	lastYear, lastWeek := time.Now().AddDate(0, 0, -7).ISOWeek()
+	lastIssue, err := testDB.GetOrCreateWeeklyIssue(lastWeek, lastYear)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentID, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     lastIssue.ID,
+		PersonID:    userID,
+		ContentType: database.ContentTypeFeature,
+		AssignedAt:  time.Now().AddDate(0, 0, -7),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	event := SlackEvent{
+		Type:    "message",
+		User:    userID,
+		Text:    "Sorry for the delay - here's the dashboard story",
+		Channel: "D123456",
+	}
+
+	t.Run("OutsideGracePeriod", func(t *testing.T) {
+		mockSubmissionManager := &MockSubmissionManager{}
+		bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, []string{}, mockSubmissionManager, nil, testDB)
+
+		// Error is expected due to mock Slack API, focus on core functionality
+		_ = bot.HandleEventCallback(context.Background(), event)
+
+		if len(mockSubmissionManager.CreatedSubmissions) != 0 {
+			t.Errorf("Expected no submission without a grace period, got %d", len(mockSubmissionManager.CreatedSubmissions))
+		}
+	})
+
+	t.Run("WithinGracePeriod", func(t *testing.T) {
+		mockSubmissionManager := &MockSubmissionManager{}
+		// Longer than a week, so any day of the current week is inside the window
+		bot := NewBotWithDatabase(SlackConfig{
+			Token:              "test-token",
+			DMReplyGracePeriod: 8 * 24 * time.Hour,
+		}, nil, []string{}, mockSubmissionManager, nil, testDB)
+
+		_ = bot.HandleEventCallback(context.Background(), event)
+
+		if len(mockSubmissionManager.CreatedSubmissions) != 1 {
+			t.Fatalf("Expected 1 submission to be created, got %d", len(mockSubmissionManager.CreatedSubmissions))
+		}
+
+		assignment, err := testDB.GetPersonAssignmentByID(assignmentID)
+		if err != nil {
+			t.Fatalf("Failed to get assignment: %v", err)
+		}
+		if assignment.SubmissionID == nil || *assignment.SubmissionID != mockSubmissionManager.CreatedSubmissions[0].ID {
+			t.Errorf("Expected last week's assignment to be linked to the reply, got %v", assignment.SubmissionID)
+		}
+	})
+}
+
+// TDD: A late reply to last week's body/mind assignment is linked to it and processed into last week's issue,
+// but stored anonymously like every other body/mind answer
+func TestReplyToBotPreviousWeekBodyMindAssignment(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	userID := "U123456"
+	lastYear, lastWeek := time.Now().AddDate(0, 0, -7).ISOWeek()
+	lastIssue, err := testDB.GetOrCreateWeeklyIssue(lastWeek, lastYear)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentID, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     lastIssue.ID,
+		PersonID:    userID,
+		ContentType: database.ContentTypeBodyMind,
+		AssignedAt:  time.Now().AddDate(0, 0, -7),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	mockAIProcessor := &MockAIService{}
+	bot := NewBotWithDatabase(SlackConfig{
+		Token:              "test-token",
+		DMReplyGracePeriod: 8 * 24 * time.Hour,
+	}, nil, []string{}, database.NewSubmissionManager(testDB.DB), mockAIProcessor, testDB).(*slackBot)
+
+	_ = bot.HandleEventCallback(context.Background(), SlackEvent{
+		Type:    "message",
+		User:    userID,
+		Text:    "I unplug by going for a long walk without my phone",
+		Channel: "D123456",
+	})
+	if err := bot.Drain(context.Background()); err != nil {
+		t.Fatalf("Drain failed: %v", err)
+	}
+
+	assignment, err := testDB.GetPersonAssignmentByID(assignmentID)
+	if err != nil {
+		t.Fatalf("Failed to get assignment: %v", err)
+	}
+	if assignment.SubmissionID == nil {
+		t.Fatal("Expected last week's body/mind assignment to be linked to the reply")
+	}
+
+	if len(mockAIProcessor.ProcessAndSaveCalls) != 1 {
+		t.Fatalf("Expected 1 AI processing call, got %d", len(mockAIProcessor.ProcessAndSaveCalls))
+	}
+	call := mockAIProcessor.ProcessAndSaveCalls[0]
+	if call.Submission.ID != *assignment.SubmissionID {
+		t.Errorf("Expected the linked submission to be processed, got %d", call.Submission.ID)
+	}
+	if call.NewsletterIssueID == nil || *call.NewsletterIssueID != lastIssue.ID {
+		t.Errorf("Expected processing into last week's issue %d, got %v", lastIssue.ID, call.NewsletterIssueID)
+	}
+	if call.JournalistType != "body_mind" {
+		t.Errorf("Expected the body_mind journalist, got %q", call.JournalistType)
+	}
+
+	submission, err := testDB.GetSubmission(*assignment.SubmissionID)
+	if err != nil {
+		t.Fatalf("Failed to get submission: %v", err)
+	}
+	if submission.UserID != "" {
+		t.Errorf("Expected the body/mind reply to be stored without user_id, got %q", submission.UserID)
+	}
+}
+
+// TDD: A DM from someone without an assignment gets help on how to submit instead of a dead end
+func TestReplyToBotNoAssignmentSendsHelp(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	var sent []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.FormValue("text"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true, "channel": "D123456", "ts": "1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	mockSubmissionManager := &MockSubmissionManager{}
+	bot := NewBotWithDatabase(SlackConfig{
+		Token:                "test-token",
+		SubmissionCategories: []string{"general", "body_mind"},
+	}, nil, []string{}, mockSubmissionManager, nil, testDB).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	err := bot.HandleEventCallback(context.Background(), SlackEvent{
+		Type:    "message",
+		User:    "U123456",
+		Text:    "Is this where I send news?",
+		Channel: "D123456",
+	})
+	if err != nil {
+		t.Fatalf("HandleEventCallback() failed: %v", err)
+	}
+
+	if len(mockSubmissionManager.CreatedSubmissions) != 0 {
+		t.Errorf("Expected no submission without an assignment, got %d", len(mockSubmissionManager.CreatedSubmissions))
+	}
+	if len(sent) != 1 {
+		t.Fatalf("Expected one help DM, got %d", len(sent))
+	}
+
+	help := sent[0]
+	for _, expected := range []string{
+		"don't have an active newsletter assignment",
+		"/pp submit [category]",
+		"`general` - Regular news",
+		"`body_mind` - Wellness content",
+		"stored anonymously",
+		"/pp help",
+	} {
+		if !strings.Contains(help, expected) {
+			t.Errorf("Expected help DM to contain %q, got: %s", expected, help)
+		}
+	}
+	if strings.Contains(help, "`feature`") {
+		t.Errorf("Expected disabled categories to be left out of the help, got: %s", help)
+	}
+}
+
+// TDD: Test users can edit their own submission, which supersedes old articles and re-processes it
+func TestEditSubmission(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	ctx := context.Background()
+	submissionManager := database.NewSubmissionManager(testDB.DB)
+	mockAIProcessor := &MockAIService{}
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token"},
+		&MockQuestionSelector{},
+		[]string{"U999999999"},
+		submissionManager,
+		mockAIProcessor,
+		testDB,
+	)
+	processed := make(chan int, 1)
+	bot.(*slackBot).onProcessed = func(submissionID int) { processed <- submissionID }
+
+	submission, err := submissionManager.CreateNewsSubmission(ctx, "U111111111", "Our team shipped teh dashboard")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	articleID, err := testDB.CreateProcessedArticle(database.ProcessedArticle{
+		SubmissionID:     submission.ID,
+		JournalistType:   "general",
+		ProcessedContent: `{"headline":"Old","body":"Old","byline":"Team"}`,
+		ProcessingStatus: database.ProcessingStatusSuccess,
+		TemplateFormat:   "column",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create processed article: %v", err)
+	}
+
+	t.Run("RejectsOtherUsersSubmission", func(t *testing.T) {
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   fmt.Sprintf(`edit %d "Hijacked content"`, submission.ID),
+			UserID: "U222222222",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "only edit your own submissions") {
+			t.Errorf("Expected ownership rejection, got: %s", response.Text)
+		}
+
+		stored, err := testDB.GetSubmission(submission.ID)
+		if err != nil {
+			t.Fatalf("Failed to get submission: %v", err)
+		}
+		if stored.Content != "Our team shipped teh dashboard" {
+			t.Errorf("Expected content to be unchanged, got: %s", stored.Content)
+		}
+
+		article, err := testDB.GetProcessedArticle(articleID)
+		if err != nil {
+			t.Fatalf("Failed to get article: %v", err)
+		}
+		if article.ProcessingStatus != database.ProcessingStatusSuccess {
+			t.Errorf("Expected article to stay %s, got %s", database.ProcessingStatusSuccess, article.ProcessingStatus)
+		}
+	})
+
+	t.Run("RejectsEditOutsideLengthLimits", func(t *testing.T) {
+		for _, content := range []string{"Too short", strings.Repeat("a", DefaultMaxSubmissionLength+1)} {
+			response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+				Text:   fmt.Sprintf(`edit %d "%s"`, submission.ID, content),
+				UserID: "U111111111",
+			})
+			if err != nil {
+				t.Fatalf("HandleSlashCommand failed: %v", err)
+			}
+			if !strings.Contains(response.Text, "please keep it between") {
+				t.Errorf("Expected length rejection for %d characters, got: %s", len(content), response.Text)
+			}
+		}
+
+		stored, err := testDB.GetSubmission(submission.ID)
+		if err != nil {
+			t.Fatalf("Failed to get submission: %v", err)
+		}
+		if stored.Content != "Our team shipped teh dashboard" {
+			t.Errorf("Expected content to be unchanged, got: %s", stored.Content)
+		}
+	})
+
+	t.Run("OwnerEditUpdatesAndReprocesses", func(t *testing.T) {
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   fmt.Sprintf(`edit %d "Our team shipped the dashboard"`, submission.ID),
+			UserID: "U111111111",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "updated") {
+			t.Errorf("Expected edit confirmation, got: %s", response.Text)
+		}
+
+		stored, err := testDB.GetSubmission(submission.ID)
+		if err != nil {
+			t.Fatalf("Failed to get submission: %v", err)
+		}
+		if stored.Content != "Our team shipped the dashboard" {
+			t.Errorf("Expected updated content, got: %s", stored.Content)
+		}
+
+		article, err := testDB.GetProcessedArticle(articleID)
+		if err != nil {
+			t.Fatalf("Failed to get article: %v", err)
+		}
+		if article.ProcessingStatus != database.ProcessingStatusSuperseded {
+			t.Errorf("Expected old article to be superseded, got %s", article.ProcessingStatus)
+		}
+
+		// Wait for async re-processing to pick up the new content
+		waitForProcessed(t, processed, submission.ID)
+		if len(mockAIProcessor.ProcessAndSaveCalls) != 1 {
+			t.Fatalf("Expected 1 re-processing call, got %d", len(mockAIProcessor.ProcessAndSaveCalls))
+		}
+		if got := mockAIProcessor.ProcessAndSaveCalls[0].Submission.Content; got != "Our team shipped the dashboard" {
+			t.Errorf("Expected re-processing of the edited content, got: %s", got)
+		}
+	})
+}
+
+// TDD: Test edits are moderated like new submissions and held submissions are not re-processed
+func TestEditSubmissionModeration(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	ctx := context.Background()
+	submissionManager := database.NewSubmissionManager(testDB.DB)
+	mockAIProcessor := &MockAIService{}
+	mockClient := &mockSlackClient{imChannelID: "D123"}
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token", BannedWords: []string{"frak"}},
+		&MockQuestionSelector{},
+		[]string{"U999999999"},
+		submissionManager,
+		mockAIProcessor,
+		testDB,
+	).(*slackBot)
+	bot.adminHandler = NewAdminHandler(&MockQuestionSelector{}, []string{"U999999999"})
+	bot.adminHandler.broadcastManager = &BroadcastManager{client: mockClient}
+
+	submission, err := submissionManager.CreateNewsSubmission(ctx, "U111111111", "Our team shipped the dashboard")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+
+	edit := func(content string) string {
+		t.Helper()
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   fmt.Sprintf(`edit %d "%s"`, submission.ID, content),
+			UserID: "U111111111",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		return response.Text
+	}
+
+	t.Run("BannedWordFlagsEdit", func(t *testing.T) {
+		text := edit("Our team shipped the frak dashboard")
+		if !strings.Contains(text, "needs a quick review") || strings.Contains(text, "Re-processing") {
+			t.Errorf("Expected the edit to be held for review, got: %s", text)
+		}
+
+		status, reason, err := testDB.GetSubmissionReview(submission.ID)
+		if err != nil {
+			t.Fatalf("GetSubmissionReview failed: %v", err)
+		}
+		if status != database.SubmissionReviewNeedsReview || !strings.Contains(reason, "frak") {
+			t.Errorf("Expected the submission to be flagged, got %q (%q)", status, reason)
+		}
+		if len(mockClient.postMessageCalls) != 1 {
+			t.Errorf("Expected the admin to be notified, got %d messages", len(mockClient.postMessageCalls))
+		}
+	})
+
+	t.Run("CleanEditStaysHeld", func(t *testing.T) {
+		text := edit("Our team shipped the new dashboard")
+		if !strings.Contains(text, "needs a quick review") || strings.Contains(text, "Re-processing") {
+			t.Errorf("Expected the flagged submission to stay held, got: %s", text)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		if len(mockAIProcessor.ProcessAndSaveCalls) != 0 {
+			t.Errorf("Expected no re-processing while under review, got %d calls", len(mockAIProcessor.ProcessAndSaveCalls))
+		}
+	})
+}
+
+// TDD: Test banned words hold a submission for review instead of sending it to the AI
+func TestModerationHoldsFlaggedSubmissions(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	ctx := context.Background()
+	submissionManager := database.NewSubmissionManager(testDB.DB)
+	mockAIProcessor := &MockAIService{}
+	mockClient := &mockSlackClient{imChannelID: "D123"}
+
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token", BannedWords: []string{"frak", "smeg head"}},
+		&MockQuestionSelector{},
+		[]string{"U999999999"},
+		submissionManager,
+		mockAIProcessor,
+		testDB,
+	).(*slackBot)
+	bot.adminHandler = NewAdminHandler(&MockQuestionSelector{}, []string{"U999999999"})
+	bot.adminHandler.broadcastManager = &BroadcastManager{client: mockClient}
+
+	t.Run("CleanContentIsProcessed", func(t *testing.T) {
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   "submit general The fraktal art exhibit opens on Friday",
+			UserID: "U111111111",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "Processing with AI") {
+			t.Errorf("Expected clean submission to be processed, got: %s", response.Text)
+		}
+
+		bot.jobs.Wait()
+		if len(mockAIProcessor.ProcessAndSaveCalls) != 1 {
+			t.Fatalf("Expected 1 AI call for clean content, got %d", len(mockAIProcessor.ProcessAndSaveCalls))
+		}
+
+		status, _, err := testDB.GetSubmissionReview(mockAIProcessor.ProcessAndSaveCalls[0].Submission.ID)
+		if err != nil {
+			t.Fatalf("GetSubmissionReview failed: %v", err)
+		}
+		if status != "" {
+			t.Errorf("Clean submission should not be flagged, got %q", status)
+		}
+		if len(mockClient.postMessageCalls) != 0 {
+			t.Errorf("Admins should not be notified about clean content, got %d messages", len(mockClient.postMessageCalls))
+		}
+	})
+
+	t.Run("FlaggedContentIsHeldForReview", func(t *testing.T) {
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   "submit feature That FRAK-ing printer jammed again",
+			UserID: "U222222222",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "needs a quick review") {
+			t.Errorf("Expected review message, got: %s", response.Text)
+		}
+
+		bot.jobs.Wait()
+		if len(mockAIProcessor.ProcessAndSaveCalls) != 1 {
+			t.Errorf("Flagged content must not reach the AI, got %d calls", len(mockAIProcessor.ProcessAndSaveCalls))
+		}
+
+		submissions, err := submissionManager.GetSubmissionsByUser(ctx, "U222222222")
+		if err != nil || len(submissions) != 1 {
+			t.Fatalf("Expected flagged submission to be stored, got %d (%v)", len(submissions), err)
+		}
+
+		status, reason, err := testDB.GetSubmissionReview(submissions[0].ID)
+		if err != nil {
+			t.Fatalf("GetSubmissionReview failed: %v", err)
+		}
+		if status != database.SubmissionReviewNeedsReview || !strings.Contains(reason, "frak") {
+			t.Errorf("Expected needs_review with reason, got %q / %q", status, reason)
+		}
+
+		if len(mockClient.postMessageCalls) != 1 {
+			t.Fatalf("Expected 1 admin notification, got %d", len(mockClient.postMessageCalls))
+		}
+		_, values, _ := slack.UnsafeApplyMsgOptions("", "D123", "", mockClient.postMessageCalls[0].options...)
+		if text := values.Get("text"); !strings.Contains(text, fmt.Sprintf("Submission #%d flagged", submissions[0].ID)) {
+			t.Errorf("Unexpected admin notification: %s", text)
+		}
+	})
+
+	t.Run("AdminRerunApprovesFlaggedSubmission", func(t *testing.T) {
+		submissions, err := submissionManager.GetSubmissionsByUser(ctx, "U222222222")
+		if err != nil || len(submissions) != 1 {
+			t.Fatalf("Expected flagged submission, got %d (%v)", len(submissions), err)
+		}
+		flaggedID := submissions[0].ID
+
+		bot.adminHandler.db = testDB
+		bot.adminHandler.aiProcessor = mockAIProcessor
+		response, err := bot.adminHandler.HandleAdminCommand(ctx, "U999999999", &AdminCommand{
+			Action: "rerun-submission",
+			Args:   []string{fmt.Sprint(flaggedID)},
+		})
+		if err != nil {
+			t.Fatalf("HandleAdminCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "Reprocessing started") {
+			t.Fatalf("Expected rerun to start, got: %s", response.Text)
+		}
+		bot.adminHandler.jobs.Wait()
+
+		if status, reason, _ := testDB.GetSubmissionReview(flaggedID); status != "" || reason != "" {
+			t.Errorf("Expected rerun to clear the review flag, got %q / %q", status, reason)
+		}
+	})
+
+	t.Run("FlaggedPhraseInAnonymousSubmission", func(t *testing.T) {
+		response, err := bot.HandleSlashCommand(ctx, SlashCommand{
+			Text:   "submit body_mind My manager is a total smeg  head",
+			UserID: "U333333333",
+		})
+		if err != nil {
+			t.Fatalf("HandleSlashCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "needs a quick review") {
+			t.Errorf("Expected review message, got: %s", response.Text)
+		}
+
+		anonymous, err := testDB.GetAnonymousSubmissionsByCategory("body_mind")
+		if err != nil || len(anonymous) != 1 {
+			t.Fatalf("Expected anonymous flagged submission, got %d (%v)", len(anonymous), err)
+		}
+		if status, _, _ := testDB.GetSubmissionReview(anonymous[0].ID); status != database.SubmissionReviewNeedsReview {
+			t.Errorf("Expected anonymous submission to be flagged, got %q", status)
+		}
+	})
+}
+
+func TestFindBannedWord(t *testing.T) {
+	banned := []string{"heck", "darn it"}
+	tests := []struct {
+		content string
+		match   string
+	}{
+		{"What the HECK happened", "heck"},
+		{"heck!", "heck"},
+		{"Darn   it, the build broke", "darn it"},
+		{"Checking the deck", ""},
+		{"darnit", ""},
+	}
+	for _, tt := range tests {
+		word, found := findBannedWord(tt.content, banned)
+		if found != (tt.match != "") || word != tt.match {
+			t.Errorf("findBannedWord(%q) = %q, %v; want %q", tt.content, word, found, tt.match)
+		}
+	}
+}
+
+// TDD: Test Slack's retried deliveries of the same DM event only create one submission
+func TestReplyToBotRetriedEventIsIgnored(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	userID := "U123456"
+	if _, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    userID,
+		ContentType: database.ContentTypeFeature,
+		AssignedAt:  time.Now(),
+	}); err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	mockSubmissionManager := &MockSubmissionManager{}
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, []string{}, mockSubmissionManager, nil, testDB)
+
+	event := SlackEvent{
+		Type:        "message",
+		User:        userID,
+		Text:        "Here's my feature story about our new dashboard",
+		Channel:     "D123456",
+		ClientMsgID: "5f1c7a9e-msg",
+		EventID:     "Ev0123ABCD",
+	}
+
+	// Deliver the event, then Slack's retry of it; sending the reply fails without a real Slack API
+	bot.HandleEventCallback(context.Background(), event)
+	bot.HandleEventCallback(context.Background(), event)
+
+	if len(mockSubmissionManager.CreatedSubmissions) != 1 {
+		t.Fatalf("Expected retried event to be ignored, got %d submissions", len(mockSubmissionManager.CreatedSubmissions))
+	}
+
+	// Without an envelope ID the client message ID still identifies the retry
+	retry := event
+	retry.EventID = ""
+	bot2 := NewBotWithDatabase(SlackConfig{Token: "test-token"}, nil, []string{}, mockSubmissionManager, nil, testDB)
+	bot2.HandleEventCallback(context.Background(), retry)
+	bot2.HandleEventCallback(context.Background(), retry)
+
+	if len(mockSubmissionManager.CreatedSubmissions) != 2 {
+		t.Errorf("Expected one more submission keyed by client_msg_id, got %d total", len(mockSubmissionManager.CreatedSubmissions))
+	}
+}
+
+// TDD: Test a retry of an event whose handler failed is handled again, while a retry of a handled one is not
+func TestRetriedEventAfterHandlerFailure(t *testing.T) {
+	bot := NewBot(SlackConfig{Token: "test-token"}, nil, []string{}).(*slackBot)
+
+	calls := 0
+	bot.RegisterEventHandler(EventTypeAppMention, func(ctx context.Context, event SlackEvent) error {
+		calls++
+		if calls == 1 {
+			return fmt.Errorf("temporary failure")
+		}
+		return nil
+	})
+
+	event := SlackEvent{Type: "app_mention", User: "U123456", Text: "hello", EventID: "Ev0456EFGH"}
+	if err := bot.HandleEventCallback(context.Background(), event); err == nil {
+		t.Fatal("Expected the first delivery to fail")
+	}
+	if err := bot.HandleEventCallback(context.Background(), event); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if err := bot.HandleEventCallback(context.Background(), event); err != nil {
+		t.Fatalf("Expected the duplicate to be ignored, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the handler to run for the first delivery and its retry only, got %d calls", calls)
+	}
+}
+
+func TestEventDeduplicatorExpiry(t *testing.T) {
+	var dedup eventDeduplicator
+	start := time.Now()
+
+	if dedup.seen("event:Ev1", start) {
+		t.Error("First delivery should not be a duplicate")
+	}
+	if !dedup.seen("event:Ev1", start.Add(time.Minute)) {
+		t.Error("Retry within the TTL should be a duplicate")
+	}
+	if dedup.seen("event:Ev1", start.Add(eventDedupTTL+time.Second)) {
+		t.Error("Delivery after the TTL should not be a duplicate")
+	}
+	if dedup.seen("", start) || dedup.seen("", start) {
+		t.Error("Events without an ID should never be treated as duplicates")
+	}
+}
+
+// TDD: submit-wellness parses quoted and unquoted questions with an optional pool category
+func TestParseWellnessSubmission(t *testing.T) {
+	tests := []struct {
+		input            string
+		expectedQuestion string
+		expectedCategory string
+		expectError      bool
+	}{
+		{`submit-wellness "How do you switch off?" work_life_balance`, "How do you switch off?", "work_life_balance", false},
+		{`submit-wellness "How do you switch off?"`, "How do you switch off?", "wellness", false},
+		{"submit-wellness How do you handle stress? mental_health", "How do you handle stress?", "mental_health", false},
+		{"submit-wellness How do you stay active at a desk job?", "How do you stay active at a desk job?", "wellness", false},
+		{`submit-wellness "How do you switch off?" gossip`, "", "", true},
+		{"submit-wellness", "", "", true},
+		{`submit-wellness ""`, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			question, category, err := parseWellnessSubmission(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, got question=%q category=%q", question, category)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseWellnessSubmission() failed: %v", err)
+			}
+			if question != tt.expectedQuestion || category != tt.expectedCategory {
+				t.Errorf("Expected (%q, %q), got (%q, %q)", tt.expectedQuestion, tt.expectedCategory, question, category)
+			}
+		})
+	}
+}
+
+// TDD: submit-wellness adds the question to the body/mind pool without storing who sent it
+func TestWellnessSubmissionGoesToPool(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	mockSubmissionManager := &MockSubmissionManager{}
+	bot := NewBotWithDatabase(
+		SlackConfig{Token: "test-token"},
+		&MockQuestionSelector{},
+		[]string{},
+		mockSubmissionManager,
+		nil,
+		testDB,
+	)
+
+	response, err := bot.HandleSlashCommand(context.Background(), SlashCommand{
+		Text:   `submit-wellness "How do you disconnect from work after hours?" work_life_balance`,
+		UserID: "U123WELLNESS",
+	})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand() failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "anonymously") || strings.Contains(response.Text, "U123WELLNESS") {
+		t.Errorf("Expected a reassuring anonymous confirmation, got: %s", response.Text)
+	}
+
+	questions, err := testDB.GetActiveBodyMindQuestions()
+	if err != nil {
+		t.Fatalf("Failed to get pool questions: %v", err)
+	}
+	if len(questions) != 1 {
+		t.Fatalf("Expected 1 pool question, got %d", len(questions))
+	}
+	if questions[0].QuestionText != "How do you disconnect from work after hours?" || questions[0].Category != "work_life_balance" {
+		t.Errorf("Unexpected pool question: %+v", questions[0])
+	}
+
+	// Nothing is stored as a regular submission, so no user ID is kept anywhere
+	if len(mockSubmissionManager.CreatedSubmissions) != 0 {
+		t.Errorf("Expected no regular submission, got %d", len(mockSubmissionManager.CreatedSubmissions))
+	}
+	var submissions int
+	if err := testDB.QueryRow("SELECT COUNT(*) FROM submissions").Scan(&submissions); err != nil {
+		t.Fatalf("Failed to count submissions: %v", err)
+	}
+	if submissions != 0 {
+		t.Errorf("Expected no rows in submissions, got %d", submissions)
+	}
+
+	response, err = bot.HandleSlashCommand(context.Background(), SlashCommand{
+		Text:   `submit-wellness "How do you disconnect from work after hours?" gossip`,
+		UserID: "U123WELLNESS",
+	})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand() failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "unknown wellness category") {
+		t.Errorf("Expected unknown category error, got: %s", response.Text)
+	}
+}
+
+// TDD: /pp reroll swaps the open assignment's question for another of the same category and DMs it
+func TestRerollAssignmentQuestion(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	ctx := context.Background()
+	questionSelector := database.NewQuestionSelector(testDB.DB)
+	first, err := questionSelector.AddQuestion(ctx, "What did your team ship this week?", "feature")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	second, err := questionSelector.AddQuestion(ctx, "What are you most proud of this month?", "feature")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	if err := questionSelector.MarkQuestionUsed(ctx, first.ID); err != nil {
+		t.Fatalf("Failed to mark question used: %v", err)
+	}
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentID, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U123456",
+		ContentType: database.ContentTypeFeature,
+		QuestionID:  &first.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	var sent []url.Values
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mu.Lock()
+		sent = append(sent, r.PostForm)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true, "channel": "D123456", "ts": "1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, questionSelector, []string{}, &MockSubmissionManager{}, nil, testDB).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	response, err := bot.HandleSlashCommand(ctx, SlashCommand{Command: "/pp", Text: "reroll", UserID: "U123456"})
+	if err != nil {
+		t.Fatalf("HandleSlashCommand failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "new question is waiting in your DMs") {
+		t.Errorf("Expected DM confirmation, got: %s", response.Text)
+	}
+
+	if len(sent) != 1 {
+		t.Fatalf("Expected one DM with the new question, got %d", len(sent))
+	}
+	if sent[0].Get("channel") != "U123456" || !strings.Contains(sent[0].Get("text"), second.Text) {
+		t.Errorf("Expected the new question DMed to U123456, got %v", sent[0])
+	}
+
+	assignment, err := testDB.GetPersonAssignmentByID(assignmentID)
+	if err != nil {
+		t.Fatalf("Failed to reload assignment: %v", err)
+	}
+	if assignment.QuestionID == nil || *assignment.QuestionID != second.ID {
+		t.Errorf("Expected assignment question %d, got %v", second.ID, assignment.QuestionID)
+	}
+}
+
+// TDD: Once a submission is linked, rerolling (here via a DM reply) leaves the question alone
+func TestRerollRejectedAfterSubmission(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+
+	ctx := context.Background()
+	questionSelector := database.NewQuestionSelector(testDB.DB)
+	first, err := questionSelector.AddQuestion(ctx, "What did your team ship this week?", "feature")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	if _, err := questionSelector.AddQuestion(ctx, "What are you most proud of this month?", "feature"); err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+
+	year, week := time.Now().ISOWeek()
+	issue, err := testDB.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	assignmentID, err := testDB.CreatePersonAssignment(database.PersonAssignment{
+		IssueID:     issue.ID,
+		PersonID:    "U123456",
+		ContentType: database.ContentTypeFeature,
+		QuestionID:  &first.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	submissionID, err := testDB.CreateNewsSubmission("U123456", "We shipped the new dashboard")
+	if err != nil {
+		t.Fatalf("Failed to create submission: %v", err)
+	}
+	if err := testDB.LinkSubmissionToAssignment(assignmentID, submissionID); err != nil {
+		t.Fatalf("Failed to link submission: %v", err)
+	}
+
+	var sent []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.FormValue("text"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true, "channel": "D123456", "ts": "1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	mockSubmissionManager := &MockSubmissionManager{}
+	bot := NewBotWithDatabase(SlackConfig{Token: "test-token"}, questionSelector, []string{}, mockSubmissionManager, nil, testDB).(*slackBot)
+	bot.clientOptions = []slack.Option{slack.OptionAPIURL(server.URL + "/")}
+
+	if err := bot.HandleEventCallback(ctx, SlackEvent{
+		Type:    "message",
+		User:    "U123456",
+		Text:    "reroll",
+		Channel: "D123456",
+	}); err != nil {
+		t.Fatalf("HandleEventCallback() failed: %v", err)
+	}
+
+	if len(sent) != 1 || !strings.Contains(sent[0], "already submitted") {
+		t.Errorf("Expected a single already-submitted reply, got %v", sent)
+	}
+	if len(mockSubmissionManager.CreatedSubmissions) != 0 {
+		t.Errorf("Expected \"reroll\" not to be stored as a submission, got %d", len(mockSubmissionManager.CreatedSubmissions))
+	}
+
+	assignment, err := testDB.GetPersonAssignmentByID(assignmentID)
+	if err != nil {
+		t.Fatalf("Failed to reload assignment: %v", err)
+	}
+	if assignment.QuestionID == nil || *assignment.QuestionID != first.ID {
+		t.Errorf("Expected assignment to keep question %d, got %v", first.ID, assignment.QuestionID)
+	}
+
+	if err := testDB.UpdateAssignmentQuestion(assignmentID, first.ID+1); err == nil {
+		t.Error("Expected UpdateAssignmentQuestion to refuse a submitted assignment")
+	}
+}
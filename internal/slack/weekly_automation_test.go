@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
 )
 
 // TestWeeklyAutomationAdminCommands tests the admin commands for weekly automation
@@ -30,12 +31,130 @@ func TestWeeklyAutomationAdminCommands(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("AdminPoolStatusCommand", testAdminPoolStatusCommand(ctx, db))
+	t.Run("AdminPoolStatusCategoryCommand", testAdminPoolStatusCategoryCommand(ctx, db))
 	t.Run("AdminWeekStatusCommand", testAdminWeekStatusCommand(ctx, db))
 	t.Run("AdminAssignQuestionCommand", testAdminAssignQuestionCommand(ctx, db))
 	t.Run("AdminBroadcastCommand", testAdminBroadcastCommand(ctx, db))
 	t.Run("AdminAuthorization", testAdminAuthorization(ctx, db))
 }
 
+// TDD: assign-question body_mind takes its question through the pool manager, so leaving the pool
+// below the threshold asks the team for more questions
+func TestAssignQuestionBodyMindLowPoolBroadcast(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	handler := NewAdminHandlerWithWeeklyAutomation(&mockQuestionSelector{}, []string{"U123ADMIN"}, &mockSubmissionManager{}, db, "fake-token")
+	mockClient := &mockSlackClient{
+		imChannelID: "D123456789",
+		users:       []slack.User{{ID: "U111111111", Name: "ada"}},
+	}
+	handler.broadcastManager = &BroadcastManager{client: mockClient}
+	handler.EnableLowPoolBroadcast(2)
+
+	for _, text := range []string{"How do you stay active?", "How do you unwind?"} {
+		if _, err := db.CreateBodyMindQuestion(text, "wellness"); err != nil {
+			t.Fatalf("Failed to create question: %v", err)
+		}
+	}
+
+	response, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{Action: "assign-question", Args: []string{"body_mind", "U111111111"}})
+	if err != nil {
+		t.Fatalf("HandleAdminCommand failed: %v", err)
+	}
+	if !strings.Contains(response.Text, "Successfully assigned") {
+		t.Fatalf("Expected the body/mind question to be assigned, got: %s", response.Text)
+	}
+
+	remaining, err := db.GetActiveBodyMindQuestions()
+	if err != nil {
+		t.Fatalf("Failed to get active questions: %v", err)
+	}
+	if len(remaining) != 1 || remaining[0].QuestionText != "How do you unwind?" {
+		t.Errorf("Expected the oldest question to be used, leaving 1, got %+v", remaining)
+	}
+
+	if mockClient.getUsersCalls != 1 {
+		t.Fatalf("Expected the low pool to trigger a broadcast, got %d user lookups", mockClient.getUsersCalls)
+	}
+	broadcast := false
+	for _, call := range mockClient.postMessageCalls {
+		_, values, err := slack.UnsafeApplyMsgOptions("", "D123", "", call.options...)
+		if err != nil {
+			t.Fatalf("Failed to apply message options: %v", err)
+		}
+		if strings.Contains(values.Get("text"), "Help us expand our wellness content pool") {
+			broadcast = true
+		}
+	}
+	if !broadcast {
+		t.Error("Expected the wellness broadcast to be sent")
+	}
+}
+
+// TDD: assign-question into a filled slot is turned away before a question or pool entry is used up
+func TestAssignQuestionFilledSlotKeepsQuestion(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	db.SetAssignmentSlots(map[database.ContentType]int{
+		database.ContentTypeFeature:  1,
+		database.ContentTypeBodyMind: 1,
+	})
+
+	ctx := context.Background()
+	questionSelector := database.NewQuestionSelector(db.DB)
+	handler := NewAdminHandlerWithWeeklyAutomation(questionSelector, []string{"U123ADMIN"}, &mockSubmissionManager{}, db, "fake-token")
+
+	year, week := time.Now().In(db.Location()).ISOWeek()
+	issue, err := db.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	for personID, contentType := range map[string]database.ContentType{
+		"U111111111": database.ContentTypeFeature,
+		"U222222222": database.ContentTypeBodyMind,
+	} {
+		if _, err := db.CreatePersonAssignment(database.PersonAssignment{
+			IssueID:     issue.ID,
+			PersonID:    personID,
+			ContentType: contentType,
+			AssignedAt:  time.Now(),
+		}); err != nil {
+			t.Fatalf("Failed to create assignment: %v", err)
+		}
+	}
+
+	question, err := questionSelector.AddQuestion(ctx, "What did your team ship?", "feature")
+	if err != nil {
+		t.Fatalf("Failed to add question: %v", err)
+	}
+	if _, err := db.CreateBodyMindQuestion("How do you unwind?", "wellness"); err != nil {
+		t.Fatalf("Failed to create body/mind question: %v", err)
+	}
+
+	for _, contentType := range []string{"feature", "body_mind"} {
+		response, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{Action: "assign-question", Args: []string{contentType, "U333333333"}})
+		if err != nil {
+			t.Fatalf("HandleAdminCommand failed: %v", err)
+		}
+		if !strings.Contains(response.Text, "slot already filled") {
+			t.Errorf("Expected the %s assignment to be rejected for a filled slot, got: %s", contentType, response.Text)
+		}
+	}
+
+	stored, err := questionSelector.GetQuestionByID(ctx, question.ID)
+	if err != nil {
+		t.Fatalf("Failed to get question: %v", err)
+	}
+	if stored.LastUsedAt != nil {
+		t.Errorf("Expected the feature question to stay unused, last used %v", stored.LastUsedAt)
+	}
+	if active, err := db.GetActiveBodyMindQuestions(); err != nil || len(active) != 1 {
+		t.Errorf("Expected the body/mind question to stay in the pool, got %d (err %v)", len(active), err)
+	}
+}
+
 func testAdminPoolStatusCommand(ctx context.Context, db *database.DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		// Create admin handler with weekly automation capabilities
@@ -97,6 +216,45 @@ func testAdminPoolStatusCommand(ctx context.Context, db *database.DB) func(t *te
 	}
 }
 
+// TDD: pool-status with a category shows only that category, and rejects unknown categories
+func testAdminPoolStatusCategoryCommand(ctx context.Context, db *database.DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		handler := NewAdminHandlerWithWeeklyAutomation(
+			&mockQuestionSelector{},
+			[]string{"U123ADMIN"},
+			&mockSubmissionManager{},
+			db,
+			"fake-token",
+		)
+
+		// The pool status test left one wellness and one mental health question
+		tests := []struct {
+			category string
+			expected []string
+		}{
+			{"wellness", []string{"Body/Mind Pool: Wellness", "Available Questions:* 1", "Oldest Question Added:* Today", "wellness category low (1 left)"}},
+			{"mental_health", []string{"Body/Mind Pool: Mental Health", "Available Questions:* 1"}},
+			{"work_life_balance", []string{"Body/Mind Pool: Work-Life Balance", "Available Questions:* 0", "work_life_balance category empty"}},
+			{"nutrition", []string{"Unknown body/mind category 'nutrition'", "Categories: wellness, mental_health, work_life_balance"}},
+		}
+
+		for _, tt := range tests {
+			response, err := handler.HandleAdminCommand(ctx, "U123ADMIN", &AdminCommand{Action: "pool-status", Args: []string{tt.category}})
+			if err != nil {
+				t.Fatalf("Failed to handle pool-status %s: %v", tt.category, err)
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(response.Text, expected) {
+					t.Errorf("Expected pool-status %s to contain %q, got: %s", tt.category, expected, response.Text)
+				}
+			}
+			if tt.category != "nutrition" && strings.Contains(response.Text, "Question Pool Status") {
+				t.Errorf("Expected pool-status %s to show only that category, got: %s", tt.category, response.Text)
+			}
+		}
+	}
+}
+
 func testAdminWeekStatusCommand(ctx context.Context, db *database.DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		adminUsers := []string{"U123ADMIN"}
@@ -252,6 +410,34 @@ func testAdminAssignQuestionCommand(ctx context.Context, db *database.DB) func(t
 			t.Errorf("Expected successful body_mind assignment, got: %s", bodyMindResponse.Text)
 		}
 
+		// Test interview category support
+		interviewCmd := &AdminCommand{
+			Action: "assign-question",
+			Args:   []string{"interview", "@U333INTERVIEW"},
+		}
+
+		interviewResponse, err := handler.HandleAdminCommand(ctx, "U123ADMIN", interviewCmd)
+		if err != nil {
+			t.Fatalf("Failed to handle interview assign-question command: %v", err)
+		}
+
+		if !strings.Contains(interviewResponse.Text, "Successfully assigned") {
+			t.Errorf("Expected successful interview assignment, got: %s", interviewResponse.Text)
+		}
+
+		if mockQuestionSel.lastCategory != "interview" {
+			t.Errorf("Expected category 'interview', got '%s'", mockQuestionSel.lastCategory)
+		}
+
+		interviewAssignment, err := db.GetActiveAssignmentByUser("U333INTERVIEW", database.ContentTypeInterview)
+		if err != nil {
+			t.Fatalf("Expected interview assignment to be created: %v", err)
+		}
+
+		if interviewAssignment.ContentType != database.ContentTypeInterview {
+			t.Errorf("Expected interview content type, got %s", interviewAssignment.ContentType)
+		}
+
 		// Test invalid content type
 		invalidCmd := &AdminCommand{
 			Action: "assign-question",
@@ -508,6 +694,14 @@ func (m *mockQuestionSelector) DeleteQuestion(ctx context.Context, questionID in
 	return nil
 }
 
+func (m *mockQuestionSelector) GetQuestionUsageStats(ctx context.Context, category string) ([]database.QuestionUsageStat, error) {
+	return nil, nil
+}
+
+func (m *mockQuestionSelector) ResetCategoryRotation(ctx context.Context, category string) error {
+	return nil
+}
+
 type mockSubmissionManager struct{}
 
 func (m *mockSubmissionManager) CreateNewsSubmission(ctx context.Context, userID, content string) (*database.Submission, error) {
@@ -524,17 +718,38 @@ func (m *mockSubmissionManager) GetSubmissionsByUser(ctx context.Context, userID
 	}, nil
 }
 
-func (m *mockSubmissionManager) GetAllSubmissions(ctx context.Context) ([]database.Submission, error) {
-	return []database.Submission{
+func (m *mockSubmissionManager) IterateSubmissions(ctx context.Context, fn func(database.Submission) error) error {
+	for _, submission := range []database.Submission{
 		{ID: 1, UserID: "U123", Content: "Mock submission 1"},
 		{ID: 2, UserID: "U456", Content: "Mock submission 2"},
-	}, nil
+	} {
+		if err := fn(submission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockSubmissionManager) IterateSubmissionsByDateRange(ctx context.Context, start, end time.Time, fn func(database.Submission) error) error {
+	return nil
 }
 
 func (m *mockSubmissionManager) DeleteSubmission(ctx context.Context, id int) error {
 	return nil // Mock implementation - always succeeds
 }
 
+func (m *mockSubmissionManager) UpdateSubmissionContent(ctx context.Context, id int, userID, content string) error {
+	return nil
+}
+
+func (m *mockSubmissionManager) GetUserSubmissionCounts(ctx context.Context, since time.Time) (map[string]int, error) {
+	return map[string]int{}, nil
+}
+
+func (m *mockSubmissionManager) GetSubmissionsWithoutProcessedArticle(ctx context.Context) ([]database.Submission, error) {
+	return nil, nil
+}
+
 type mockBroadcastManager struct {
 	sendDirectMessageCalled bool
 	lastUserID              string
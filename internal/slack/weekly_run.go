@@ -0,0 +1,173 @@
+package slack
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+)
+
+// WeeklyRunSummary reports what a weekly assignment run did
+type WeeklyRunSummary struct {
+	IssueID  int                   `json:"issue_id"`
+	Week     int                   `json:"week"`
+	Year     int                   `json:"year"`
+	Assigned []WeeklyRunAssignment `json:"assigned"`
+	Unfilled map[string]int        `json:"unfilled,omitempty"` // Open slots per content type no candidate was left for
+	Errors   []string              `json:"errors,omitempty"`
+}
+
+// WeeklyRunAssignment is one assignment created by a weekly run
+type WeeklyRunAssignment struct {
+	UserID      string               `json:"user_id"`
+	ContentType database.ContentType `json:"content_type"`
+	Question    string               `json:"question"`
+	Notified    bool                 `json:"notified"` // Whether the assignment DM was sent
+}
+
+// RunWeekly creates this week's issue, fills its open slots from the candidates using the same
+// rotation as plan-week, DMs everyone assigned their question and sends the admins the weekly
+// digest. Candidates are user IDs, usernames or @usergroups.
+func (ah *AdminHandler) RunWeekly(ctx context.Context, candidates []string) (*WeeklyRunSummary, error) {
+	if ah.db == nil {
+		return nil, fmt.Errorf("weekly automation is not available")
+	}
+	if len(ah.db.AssignmentSlots()) == 0 {
+		return nil, fmt.Errorf("no assignment slots are configured")
+	}
+
+	now := time.Now().In(ah.db.Location())
+	year, week := now.ISOWeek()
+	issue, err := ah.db.GetOrCreateWeeklyIssue(week, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get weekly issue: %w", err)
+	}
+
+	people, resolveErrors := ah.resolveCandidates(ctx, candidates)
+	plan, err := ah.planWeekSlots(people, week, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan week %d, %d: %w", week, year, err)
+	}
+
+	summary := &WeeklyRunSummary{
+		IssueID:  issue.ID,
+		Week:     week,
+		Year:     year,
+		Assigned: []WeeklyRunAssignment{},
+	}
+	for _, resolveErr := range resolveErrors {
+		summary.Errors = append(summary.Errors, "Could not resolve "+resolveErr)
+	}
+
+	for _, slot := range plan {
+		if slot.unfilled > 0 {
+			if summary.Unfilled == nil {
+				summary.Unfilled = make(map[string]int)
+			}
+			summary.Unfilled[string(slot.contentType)] = slot.unfilled
+		}
+
+		for _, userID := range slot.people {
+			assignmentID, questionText, err := ah.assignQuestion(ctx, issue, userID, slot.contentType, now)
+			if err != nil {
+				summary.Errors = append(summary.Errors, fmt.Sprintf("User %s: %v", userID, err))
+				continue
+			}
+
+			// The rotation picks whoever has waited longest, so it has to know about this week
+			if err := ah.db.AddPersonRotationHistory(userID, slot.contentType, week, year); err != nil {
+				slog.Warn("run-weekly: failed to record rotation history", "user", userID, "error", err)
+			}
+
+			assigned := WeeklyRunAssignment{UserID: userID, ContentType: slot.contentType, Question: questionText}
+			if ah.broadcastManager != nil {
+				message := ah.createQuestionMessage(questionText, string(slot.contentType), week, year)
+				if err := ah.sendAssignmentMessage(ctx, userID, message, assignmentID); err != nil {
+					summary.Errors = append(summary.Errors, fmt.Sprintf("User %s: Assignment created but message failed: %v", userID, err))
+				} else {
+					assigned.Notified = true
+				}
+			}
+			summary.Assigned = append(summary.Assigned, assigned)
+		}
+	}
+
+	// Let the admins know how the week looks now that it's assigned
+	if ah.broadcastManager != nil {
+		if digest, err := ah.SendWeeklyDigest(ctx); err != nil {
+			summary.Errors = append(summary.Errors, fmt.Sprintf("Weekly digest: %v", err))
+		} else {
+			for _, digestErr := range digest.Errors {
+				summary.Errors = append(summary.Errors, "Weekly digest: "+digestErr)
+			}
+		}
+	}
+
+	slog.Info("run-weekly: finished",
+		"issue_id", issue.ID, "week", week, "year", year,
+		"assigned", len(summary.Assigned), "errors", len(summary.Errors))
+	return summary, nil
+}
+
+// RunWeekly runs the weekly assignment job through the admin handler
+func (b *slackBot) RunWeekly(ctx context.Context, candidates []string) (*WeeklyRunSummary, error) {
+	if b.adminHandler == nil {
+		return nil, fmt.Errorf("weekly automation is not available")
+	}
+	return b.adminHandler.RunWeekly(ctx, candidates)
+}
+
+// WeeklyRunHandler triggers the weekly assignment job over HTTP for ops tooling. Requests must
+// carry the shared secret as a bearer token; without a configured secret every request is rejected.
+type WeeklyRunHandler struct {
+	bot        Bot
+	secret     string
+	candidates []string
+}
+
+func NewWeeklyRunHandler(bot Bot, secret string, candidates []string) *WeeklyRunHandler {
+	return &WeeklyRunHandler{
+		bot:        bot,
+		secret:     secret,
+		candidates: candidates,
+	}
+}
+
+func (h *WeeklyRunHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if h.secret == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(h.secret)) != 1 {
+		slog.Warn("Rejected unauthorized weekly run request", "remote_addr", r.RemoteAddr)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	runner, ok := h.bot.(WeeklyRunner)
+	if !ok || len(h.candidates) == 0 {
+		http.Error(w, "Weekly run is not configured", http.StatusServiceUnavailable)
+		return
+	}
+
+	summary, err := runner.RunWeekly(r.Context(), h.candidates)
+	if err != nil {
+		slog.Error("Weekly run failed", "error", err)
+		http.Error(w, "Weekly run failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		slog.Error("Failed to encode weekly run summary", "error", err)
+	}
+}
@@ -0,0 +1,137 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olle-forsslof/kumpan-newspaper/internal/database"
+	"github.com/slack-go/slack"
+)
+
+// TDD: POST /admin/run-weekly needs the shared secret, then fills this week's open slots
+// from the candidates and DMs everyone assigned
+func TestWeeklyRunHandler(t *testing.T) {
+	testDB := createTestDB(t)
+	defer testDB.Close()
+	testDB.SetAssignmentSlots(map[database.ContentType]int{
+		database.ContentTypeFeature: 1,
+		database.ContentTypeGeneral: 2,
+	})
+
+	bot := NewBotWithWeeklyAutomation(
+		SlackConfig{Token: "test-token"},
+		&MockQuestionSelector{},
+		[]string{"U999999999"},
+		database.NewSubmissionManager(testDB.DB),
+		nil,
+		testDB,
+	).(*slackBot)
+	mockClient := &mockSlackClient{imChannelID: "D123456789"}
+	bot.adminHandler.broadcastManager = &BroadcastManager{client: mockClient}
+
+	candidates := []string{"U111111111", "U222222222", "U333333333", "U444444444"}
+	handler := NewWeeklyRunHandler(bot, "run-secret", candidates)
+	run := func(method, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/run-weekly", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("RequiresSecret", func(t *testing.T) {
+		if rr := run("POST", ""); rr.Code != http.StatusUnauthorized {
+			t.Errorf("Expected 401 without a secret, got %d", rr.Code)
+		}
+		if rr := run("POST", "Bearer wrong-secret"); rr.Code != http.StatusUnauthorized {
+			t.Errorf("Expected 401 for a wrong secret, got %d", rr.Code)
+		}
+		if rr := run("GET", "Bearer run-secret"); rr.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Expected 405 for GET, got %d", rr.Code)
+		}
+
+		// Without a configured secret the endpoint stays closed
+		rr := httptest.NewRecorder()
+		NewWeeklyRunHandler(bot, "", candidates).ServeHTTP(rr, httptest.NewRequest("POST", "/admin/run-weekly", nil))
+		if rr.Code != http.StatusUnauthorized {
+			t.Errorf("Expected 401 when no secret is configured, got %d", rr.Code)
+		}
+
+		year, week := time.Now().In(testDB.Location()).ISOWeek()
+		assignments, err := testDB.GetAssignmentsByWeek(week, year)
+		if err != nil {
+			t.Fatalf("GetAssignmentsByWeek failed: %v", err)
+		}
+		if len(assignments) != 0 {
+			t.Errorf("Expected rejected requests to assign nobody, got %d assignments", len(assignments))
+		}
+	})
+
+	t.Run("AssignsOpenSlots", func(t *testing.T) {
+		rr := run("POST", "Bearer run-secret")
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d: %s", rr.Code, rr.Body.String())
+		}
+
+		var summary WeeklyRunSummary
+		if err := json.Unmarshal(rr.Body.Bytes(), &summary); err != nil {
+			t.Fatalf("Failed to decode summary %q: %v", rr.Body.String(), err)
+		}
+		if len(summary.Assigned) != 3 || len(summary.Errors) != 0 {
+			t.Fatalf("Expected one feature and two general assignments without errors, got %+v", summary)
+		}
+
+		counts := make(map[database.ContentType]int)
+		for _, assigned := range summary.Assigned {
+			counts[assigned.ContentType]++
+			if !assigned.Notified || assigned.Question == "" {
+				t.Errorf("Expected %s to be DMed their question, got %+v", assigned.UserID, assigned)
+			}
+		}
+		if counts[database.ContentTypeFeature] != 1 || counts[database.ContentTypeGeneral] != 2 {
+			t.Errorf("Expected 1 feature and 2 general assignments, got %v", counts)
+		}
+		// Three assignment DMs, then the digest to the admin
+		if len(mockClient.postMessageCalls) != 4 {
+			t.Fatalf("Expected 3 assignment DMs and the admin digest, got %d messages", len(mockClient.postMessageCalls))
+		}
+		if users := mockClient.openConversationCalls[3].params.Users; len(users) != 1 || users[0] != "U999999999" {
+			t.Errorf("Expected the digest to go to the admin, got %v", users)
+		}
+		_, values, err := slack.UnsafeApplyMsgOptions("", "D123", "", mockClient.postMessageCalls[3].options...)
+		if err != nil {
+			t.Fatalf("Failed to apply message options: %v", err)
+		}
+		if digest := values.Get("text"); !strings.Contains(digest, "Weekly digest") || !strings.Contains(digest, "• feature: <@") {
+			t.Errorf("Expected the admin digest to list this week's assignments, got: %s", digest)
+		}
+
+		assignments, err := testDB.GetPersonAssignmentsByIssue(summary.IssueID)
+		if err != nil {
+			t.Fatalf("GetPersonAssignmentsByIssue failed: %v", err)
+		}
+		if len(assignments) != 3 {
+			t.Errorf("Expected 3 assignments in the issue, got %d", len(assignments))
+		}
+	})
+
+	t.Run("SecondRunFindsSlotsFilled", func(t *testing.T) {
+		rr := run("POST", "Bearer run-secret")
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d: %s", rr.Code, rr.Body.String())
+		}
+		var summary WeeklyRunSummary
+		if err := json.Unmarshal(rr.Body.Bytes(), &summary); err != nil {
+			t.Fatalf("Failed to decode summary %q: %v", rr.Body.String(), err)
+		}
+		if len(summary.Assigned) != 0 {
+			t.Errorf("Expected nobody new to be assigned, got %+v", summary.Assigned)
+		}
+	})
+}
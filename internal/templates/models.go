@@ -17,10 +17,11 @@ type NewsletterPage struct {
 // ArticleData represents processed article data for template rendering
 type ArticleData struct {
 	*database.ProcessedArticle
-	FormattedContent interface{} `json:"formatted_content"` // Parsed JSON content for template use
-	AuthorInfo       *AuthorInfo `json:"author_info,omitempty"`
-	CategoryName     string      `json:"category_name"`
-	PublishDate      time.Time   `json:"publish_date"`
+	FormattedContent interface{}               `json:"formatted_content"` // Parsed JSON content for template use
+	AuthorInfo       *AuthorInfo               `json:"author_info,omitempty"`
+	CategoryName     string                    `json:"category_name"`
+	PublishDate      time.Time                 `json:"publish_date"`
+	Files            []database.SubmissionFile `json:"files,omitempty"` // Files shared with the submission
 }
 
 // AuthorInfo represents author information for articles
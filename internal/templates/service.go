@@ -51,8 +51,14 @@ func NewTemplateService(config *TemplateConfig) (*TemplateService, error) {
 
 // RenderNewsletter renders a complete newsletter page from weekly issue and articles
 func (ts *TemplateService) RenderNewsletter(ctx context.Context, issue *database.WeeklyNewsletterIssue, articles []database.ProcessedArticle) (string, error) {
+	return ts.RenderNewsletterWithFiles(ctx, issue, articles, nil)
+}
+
+// RenderNewsletterWithFiles renders a newsletter page, showing the files shared with each
+// article's submission below it. files is keyed by submission ID.
+func (ts *TemplateService) RenderNewsletterWithFiles(ctx context.Context, issue *database.WeeklyNewsletterIssue, articles []database.ProcessedArticle, files map[int][]database.SubmissionFile) (string, error) {
 	// Transform articles to template data
-	articleData, err := ts.prepareArticleData(articles)
+	articleData, err := ts.prepareArticleData(articles, files)
 	if err != nil {
 		return "", fmt.Errorf("failed to prepare article data: %w", err)
 	}
@@ -77,7 +83,7 @@ func (ts *TemplateService) RenderNewsletter(ctx context.Context, issue *database
 // RenderArticle renders a single article with appropriate template
 func (ts *TemplateService) RenderArticle(ctx context.Context, article database.ProcessedArticle) (string, error) {
 	// Prepare article data
-	articleData, err := ts.prepareArticleData([]database.ProcessedArticle{article})
+	articleData, err := ts.prepareArticleData([]database.ProcessedArticle{article}, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to prepare article data: %w", err)
 	}
@@ -99,8 +105,9 @@ func (ts *TemplateService) RenderArticle(ctx context.Context, article database.P
 	return buf.String(), nil
 }
 
-// prepareArticleData transforms processed articles to template-ready data
-func (ts *TemplateService) prepareArticleData(articles []database.ProcessedArticle) ([]ArticleData, error) {
+// prepareArticleData transforms processed articles to template-ready data, attaching each
+// submission's files from files when given
+func (ts *TemplateService) prepareArticleData(articles []database.ProcessedArticle, files map[int][]database.SubmissionFile) ([]ArticleData, error) {
 	var result []ArticleData
 
 	for _, article := range articles {
@@ -124,6 +131,7 @@ func (ts *TemplateService) prepareArticleData(articles []database.ProcessedArtic
 			FormattedContent: formattedContent,
 			CategoryName:     ts.formatCategoryName(article.JournalistType),
 			PublishDate:      publishDate,
+			Files:            files[article.SubmissionID],
 		}
 
 		result = append(result, data)
@@ -209,6 +209,103 @@ func TestTemplateService_EmptyNewsletter(t *testing.T) {
 	if !strings.Contains(html, "Draft Version") {
 		t.Error("Draft newsletter should show draft notice")
 	}
+
+	if strings.Contains(html, "issue-theme") {
+		t.Error("Newsletter without a theme should not render one")
+	}
+}
+
+// TDD: Test an issue's theme is shown in the masthead
+func TestTemplateService_RenderNewsletterTheme(t *testing.T) {
+	service, err := NewTemplateService(nil)
+	if err != nil {
+		t.Fatalf("Failed to create template service: %v", err)
+	}
+
+	issue := &database.WeeklyNewsletterIssue{
+		ID:              1,
+		WeekNumber:      27,
+		Year:            2025,
+		Title:           "Summer Newsletter",
+		Theme:           "Sommar & <sol>",
+		Status:          database.IssueStatusDraft,
+		PublicationDate: time.Now(),
+		CreatedAt:       time.Now(),
+	}
+
+	html, err := service.RenderNewsletter(context.Background(), issue, []database.ProcessedArticle{})
+	if err != nil {
+		t.Fatalf("Failed to render newsletter: %v", err)
+	}
+
+	if !strings.Contains(html, `<p class="issue-theme">Sommar &amp; &lt;sol&gt;</p>`) {
+		t.Error("Expected the escaped theme in the masthead")
+	}
+}
+
+// TDD: Test files shared with a submission are shown with its article: images inline, others as links
+func TestTemplateService_RenderNewsletterWithFiles(t *testing.T) {
+	service, err := NewTemplateService(nil)
+	if err != nil {
+		t.Fatalf("Failed to create template service: %v", err)
+	}
+
+	issue := &database.WeeklyNewsletterIssue{
+		ID:              1,
+		WeekNumber:      27,
+		Year:            2025,
+		Title:           "Summer Newsletter",
+		Status:          database.IssueStatusDraft,
+		PublicationDate: time.Now(),
+		CreatedAt:       time.Now(),
+	}
+	articles := []database.ProcessedArticle{
+		{
+			ID:               1,
+			SubmissionID:     10,
+			JournalistType:   "feature",
+			ProcessedContent: `{"headline":"New Dashboard","lead":"It shipped.","body":"<p>Details.</p>"}`,
+			WordCount:        50,
+			CreatedAt:        time.Now(),
+		},
+		{
+			ID:               2,
+			SubmissionID:     11,
+			JournalistType:   "general",
+			ProcessedContent: `{"headline":"Office News","content":"<p>Nothing attached.</p>"}`,
+			WordCount:        20,
+			CreatedAt:        time.Now(),
+		},
+	}
+	files := map[int][]database.SubmissionFile{
+		10: {
+			{SubmissionID: 10, FileID: "F0001", URL: "https://files.slack.com/files-pri/T1-F0001/dashboard.png", MimeType: "image/png"},
+			{SubmissionID: 10, FileID: "F0002", URL: "https://files.slack.com/files-pri/T1-F0002/notes.pdf", MimeType: "application/pdf"},
+		},
+	}
+
+	html, err := service.RenderNewsletterWithFiles(context.Background(), issue, articles, files)
+	if err != nil {
+		t.Fatalf("Failed to render newsletter: %v", err)
+	}
+
+	if !strings.Contains(html, `<img src="https://files.slack.com/files-pri/T1-F0001/dashboard.png"`) {
+		t.Error("Expected the image to be shown inline")
+	}
+	if !strings.Contains(html, `<a class="article-file" href="https://files.slack.com/files-pri/T1-F0002/notes.pdf">`) {
+		t.Error("Expected the PDF to be linked")
+	}
+	if strings.Count(html, `class="article-attachments"`) != 1 {
+		t.Error("Expected attachments only on the article whose submission has files")
+	}
+
+	html, err = service.RenderNewsletter(context.Background(), issue, articles)
+	if err != nil {
+		t.Fatalf("Failed to render newsletter: %v", err)
+	}
+	if strings.Contains(html, "article-attachments") {
+		t.Error("Expected no attachments when no files are given")
+	}
 }
 
 func TestTemplateService_InvalidJSON(t *testing.T) {